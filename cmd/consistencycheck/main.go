@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"school_project_grpc/internals/repositories"
+)
+
+// consistency check: reports classes whose students have no homeroom teacher or that do not exist
+// run from the project root: go run ./cmd/consistencycheck
+func main() {
+
+	issues, err := repositories.CheckClassConsistencyDBHandler(context.Background())
+	if err != nil {
+		log.Fatal("Failed to run consistency check: ", err)
+	}
+
+	if len(issues) == 0 {
+		log.Println("✅ All classes are consistent")
+		return
+	}
+
+	for _, issue := range issues {
+		log.Printf("class %q (id: %s): %s (students: %d, teacher: %s)\n", issue.Class, issue.ClassId, issue.Problem, issue.StudentCount, issue.TeacherId)
+	}
+	log.Printf("Found %d class issues\n", len(issues))
+
+	// non zero exit code so the check can be used in scripts
+	os.Exit(1)
+}
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
+	"strconv"
 	"time"
 
 	"school_project_grpc/internals/api/handlers"
 	itc "school_project_grpc/internals/api/interceptors"
+	"school_project_grpc/internals/mailer"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/internals/repositories/mongodb"
 	"school_project_grpc/pkg/utils"
 	pb "school_project_grpc/proto/gen"
 
@@ -43,18 +48,115 @@ func main() {
 
 	// registering grpcServer, this is essential to run the server
 	// grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(itc.NewRateLimiter(20, time.Second*10).RateLimitIntercepter, itc.ResponseTimeIntercepter, itc.Authentication_Intercepter), grpc.Creds(creds))
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(itc.NewRateLimiter(20, time.Second*10).RateLimitIntercepter, itc.ResponseTimeIntercepter, itc.Authentication_Intercepter))
+	// streaming rpcs only go through the token and scope checks
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(itc.NewRateLimiter(20, time.Second*10).RateLimitIntercepter, itc.ResponseTimeIntercepter, itc.Authentication_Intercepter, itc.ScopeIntercepter),
+		grpc.ChainStreamInterceptor(itc.Authentication_StreamIntercepter, itc.ScopeStreamIntercepter),
+	)
 
 	// registering rpcs
 	pb.RegisterExecsServiceServer(grpcServer, &handlers.Server{})
 	pb.RegisterStudentsServiceServer(grpcServer, &handlers.Server{})
 	pb.RegisterTeachersServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterClassesServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterCoursesServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterEnrollmentServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterAttendanceServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterGradesServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterReportsServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterAcademicCalendarServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterTimetableServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterGuardiansServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterNotificationServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterAnnouncementsServiceServer(grpcServer, &handlers.Server{})
+	pb.RegisterWebhooksServiceServer(grpcServer, &handlers.Server{})
 
 	// this function is responsible to skip the proto file when testing in postman, it is only used in production period to test
 	reflection.Register(grpcServer)
 
 	go utils.JwtStore.CleanUpExpiredTokens()
 
+	// the writes run in transactions, a standalone mongo server would refuse every one of them
+	err = mongodb.CheckReplicaSet(context.Background())
+	if err != nil {
+		log.Fatal("Mongo can not run transactions: ", err)
+	}
+
+	// enrollments, attendance, scores and admission numbers rely on unique indexes, without them concurrent writes would
+	// store duplicates, so the server does not start until they exist
+	err = repositories.EnsureIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatal("Failed to ensure mongo indexes: ", err)
+	}
+
+	// hard deleting soft deleted records once they are older than the retention period
+	retentionDays, err := strconv.Atoi(os.Getenv("SOFT_DELETE_RETENTION_DAYS"))
+	if err != nil {
+		log.Fatal("Failed to get soft delete retention period: ", err)
+	}
+	purgeInterval, err := time.ParseDuration(os.Getenv("SOFT_DELETE_PURGE_INTERVAL"))
+	if err != nil {
+		log.Fatal("Failed to get soft delete purge interval: ", err)
+	}
+	go repositories.PurgeDeletedRecords(purgeInterval, time.Duration(retentionDays)*24*time.Hour)
+
+	// letter grades of the gradebook, the default scale is used when GRADING_SCALE is empty
+	err = repositories.SetGradingScale(os.Getenv("GRADING_SCALE"))
+	if err != nil {
+		log.Fatal("Failed to parse grading scale: ", err)
+	}
+
+	// the times of the timetable periods, the default periods are used when TIMETABLE_PERIODS is empty
+	err = repositories.SetPeriods(os.Getenv("TIMETABLE_PERIODS"))
+	if err != nil {
+		log.Fatal("Failed to parse timetable periods: ", err)
+	}
+
+	// generated admission numbers of new students, the default pattern is used when ADMISSION_NUMBER_PATTERN is empty
+	err = repositories.SetAdmissionNumberPattern(os.Getenv("ADMISSION_NUMBER_PATTERN"))
+	if err != nil {
+		log.Fatal("Failed to parse admission number pattern: ", err)
+	}
+
+	// emails are rendered from the templates on disk and sent by the mail worker from the outbox
+	mailTemplates, err := mailer.LoadTemplates(os.Getenv("MAIL_TEMPLATES_DIR"))
+	if err != nil {
+		log.Fatal("Failed to load mail templates: ", err)
+	}
+	repositories.SetMailTemplates(mailTemplates)
+
+	mailSender, err := mailer.NewSenderFromEnv()
+	if err != nil {
+		log.Fatal("Failed to set up the mail sender: ", err)
+	}
+	mailInterval, err := time.ParseDuration(os.Getenv("MAIL_WORKER_INTERVAL"))
+	if err != nil {
+		log.Fatal("Failed to get mail worker interval: ", err)
+	}
+	mailMaxAttempts, err := strconv.Atoi(os.Getenv("MAIL_MAX_ATTEMPTS"))
+	if err != nil || mailMaxAttempts < 1 {
+		log.Fatal("Failed to get mail max attempts: ", err)
+	}
+	go repositories.RunMailWorker(mailSender, mailInterval, int32(mailMaxAttempts))
+
+	// scheduled announcements are published by the scheduler once their publish time has passed
+	announcementInterval, err := time.ParseDuration(os.Getenv("ANNOUNCEMENT_SCHEDULER_INTERVAL"))
+	if err != nil {
+		log.Fatal("Failed to get announcement scheduler interval: ", err)
+	}
+	go repositories.RunAnnouncementScheduler(announcementInterval)
+
+	// the changes recorded in the event outbox are posted to the webhook endpoints by the dispatcher
+	webhookInterval, err := time.ParseDuration(os.Getenv("WEBHOOK_DISPATCH_INTERVAL"))
+	if err != nil {
+		log.Fatal("Failed to get webhook dispatch interval: ", err)
+	}
+	webhookMaxAttempts, err := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS"))
+	if err != nil || webhookMaxAttempts < 1 {
+		log.Fatal("Failed to get webhook max attempts: ", err)
+	}
+	go repositories.RunWebhookDispatcher(webhookInterval, int32(webhookMaxAttempts))
+
 	log.Println("Server is running on port", port)
 	log.Println("--------------------------------------")
 	log.Print("--------------------------------------\n\n")
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "school_project_grpc/proto/gen"
+
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// bulk import: streams the rows of a csv file or a json array into ImportStudents or ImportTeachers and prints the report
+// run from the project root: go run ./cmd/importdata -entity students -file students.json [-map "e-mail=email,notes=-"] [-dry-run]
+// the token of an admin or manager is given with -token or IMPORT_TOKEN
+func main() {
+
+	entity := flag.String("entity", "students", "students or teachers")
+	file := flag.String("file", "", "csv file with a header row or json array of objects")
+	format := flag.String("format", "", "csv or json, taken from the file extension when empty")
+	columns := flag.String("map", "", "column mapping like \"e-mail=email,notes=-\", - drops the column")
+	dryRun := flag.Bool("dry-run", false, "validate the rows without writing anything")
+	addr := flag.String("addr", "", "address of the server, localhost and GRPC_SERVER_PORT when empty")
+	token := flag.String("token", "", "jwt of an admin or manager, IMPORT_TOKEN when empty")
+	flag.Parse()
+
+	err := godotenv.Load("./cmd/grpcapi/.env")
+	if err != nil {
+		log.Fatal("Failed to load .env: ", err)
+	}
+
+	if *file == "" {
+		log.Fatal("-file is required")
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*file), ".")
+	}
+	if *addr == "" {
+		*addr = "localhost" + os.Getenv("GRPC_SERVER_PORT")
+	}
+	if *token == "" {
+		*token = os.Getenv("IMPORT_TOKEN")
+	}
+
+	mapping, err := parseMapping(*columns)
+	if err != nil {
+		log.Fatal("Failed to parse -map: ", err)
+	}
+
+	rows, err := readRows(*file, *format)
+	if err != nil {
+		log.Fatal("Failed to read ", *file, ": ", err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to connect: ", err)
+	}
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+*token)
+
+	var stream grpc.ClientStreamingClient[pb.ImportRequest, pb.ImportReport]
+	switch *entity {
+	case "students":
+		stream, err = pb.NewStudentsServiceClient(conn).ImportStudents(ctx)
+	case "teachers":
+		stream, err = pb.NewTeachersServiceClient(conn).ImportTeachers(ctx)
+	default:
+		log.Fatalf("unknown entity %q, use students or teachers", *entity)
+	}
+	if err != nil {
+		log.Fatal("Failed to start the import: ", err)
+	}
+
+	for i, row := range rows {
+		req := &pb.ImportRequest{Row: row.values, RowNumber: row.number}
+		if i == 0 {
+			req.DryRun = *dryRun
+			req.ColumnMapping = mapping
+		}
+		err = stream.Send(req)
+		if err != nil {
+			break
+		}
+	}
+	// a failed send is reported by CloseAndRecv
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatal("Import failed: ", err)
+	}
+
+	for _, row := range report.GetRows() {
+		if row.GetError() != "" {
+			fmt.Printf("row %d\t%s\t%s\t%s\n", row.GetRowNumber(), row.GetStatus(), row.GetEmail(), row.GetError())
+		}
+	}
+
+	if report.GetDryRun() {
+		log.Printf("🎉 Dry run finished: %d rows, %d valid, %d rejected\n", report.GetTotal(), report.GetValid(), report.GetRejected())
+		return
+	}
+	log.Printf("🎉 Import finished: %d rows, %d imported, %d rejected\n", report.GetTotal(), report.GetImported(), report.GetRejected())
+}
+
+// fileRow is a row of the file with its line, the line is what the report refers to
+type fileRow struct {
+	number uint32
+	values map[string]string
+}
+
+// readRows reads the rows of a csv file with a header row or of a json array of objects
+func readRows(path, format string) ([]fileRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch format {
+	case "csv":
+		return readCSV(f)
+	case "json":
+		return readJSON(f)
+	}
+	return nil, fmt.Errorf("unknown format %q, use csv or json", format)
+}
+
+func readCSV(r io.Reader) ([]fileRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	// excel writes a byte order mark in front of the header
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	var rows []fileRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		values := make(map[string]string, len(header))
+		empty := true
+		for i, column := range header {
+			if i < len(record) {
+				values[column] = record[i]
+				empty = empty && strings.TrimSpace(record[i]) == ""
+			}
+		}
+		// blank lines at the end of a spreadsheet export
+		if empty {
+			continue
+		}
+		rows = append(rows, fileRow{number: uint32(line), values: values})
+	}
+}
+
+func readJSON(r io.Reader) ([]fileRow, error) {
+	var objects []map[string]any
+	err := json.NewDecoder(r).Decode(&objects)
+	if err != nil {
+		return nil, err
+	}
+
+	// json rows are numbered by their position in the array
+	rows := make([]fileRow, 0, len(objects))
+	for i, object := range objects {
+		values := make(map[string]string, len(object))
+		for key, value := range object {
+			if value != nil {
+				values[key] = fmt.Sprint(value)
+			}
+		}
+		rows = append(rows, fileRow{number: uint32(i + 1), values: values})
+	}
+	return rows, nil
+}
+
+// parseMapping reads "column=field,column=field"
+func parseMapping(s string) (map[string]string, error) {
+	mapping := map[string]string{}
+	if s == "" {
+		return mapping, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		column, field, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(column) == "" || strings.TrimSpace(field) == "" {
+			return nil, fmt.Errorf("%q is not column=field", pair)
+		}
+		mapping[strings.TrimSpace(column)] = strings.TrimSpace(field)
+	}
+	return mapping, nil
+}
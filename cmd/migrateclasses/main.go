@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"school_project_grpc/internals/repositories"
+)
+
+// class migration: creates class documents from the class strings of students and teachers
+// run from the project root: go run ./cmd/migrateclasses
+func main() {
+
+	created, err := repositories.MigrateClassesDBHandler(context.Background())
+	if err != nil {
+		log.Fatal("Failed to migrate classes: ", err)
+	}
+
+	log.Printf("🎉 Class migration finished, %d classes created\n", created)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"school_project_grpc/internals/repositories"
+
+	"github.com/joho/godotenv"
+)
+
+// student profile migration: fills in enrollment status, admission date and admission number of existing students
+// run from the project root: go run ./cmd/migratestudents
+func main() {
+
+	// the numbers are generated with the pattern the server uses
+	err := godotenv.Load("./cmd/grpcapi/.env")
+	if err != nil {
+		log.Fatal("Failed to load .env: ", err)
+	}
+
+	err = repositories.SetAdmissionNumberPattern(os.Getenv("ADMISSION_NUMBER_PATTERN"))
+	if err != nil {
+		log.Fatal("Failed to parse admission number pattern: ", err)
+	}
+
+	migrated, err := repositories.MigrateStudentProfilesDBHandler(context.Background())
+	if err != nil {
+		log.Fatal("Failed to migrate student profiles: ", err)
+	}
+
+	log.Printf("🎉 Student profile migration finished, %d students migrated\n", migrated)
+}
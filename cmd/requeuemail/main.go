@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"school_project_grpc/internals/repositories"
+
+	"github.com/joho/godotenv"
+)
+
+// dead letter requeue: gives dead mails of the outbox a new set of attempts, the mail worker of the server sends them
+// run from the project root: go run ./cmd/requeuemail [mail ids...], without ids every dead mail is requeued
+func main() {
+
+	err := godotenv.Load("./cmd/grpcapi/.env")
+	if err != nil {
+		log.Fatal("Failed to load .env: ", err)
+	}
+
+	requeued, err := repositories.RequeueDeadMailDBHandler(context.Background(), os.Args[1:])
+	if err != nil {
+		log.Fatal("Failed to requeue dead mails: ", err)
+	}
+
+	log.Printf("🎉 %d dead mails requeued\n", requeued)
+}
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add announcements, they are drafts until they are published
+func (s *Server) AddAnnouncements(ctx context.Context, req *pb.Announcements) (*pb.Announcements, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, announcement := range req.Announcements {
+		if announcement.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	createdBy, _ := ctx.Value("uid").(string)
+
+	addedAnnouncements, err := repositories.AddAnnouncementsDBHandler(ctx, req.GetAnnouncements(), createdBy)
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return &pb.Announcements{Announcements: addedAnnouncements}, nil
+}
+
+// Get announcements of every status with filter + sort, the audiences read theirs on the bulletin board
+func (s *Server) GetAnnouncements(ctx context.Context, req *pb.GetAnnouncementsRequest) (*pb.Announcements, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	filter, err := buildfilter(req.Announcement, &models.Announcement{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted announcements are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "announcements")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	announcements, err := repositories.GetAnnouncementsDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Announcements{Announcements: announcements}, nil
+}
+
+// Update announcements
+func (s *Server) UpdateAnnouncements(ctx context.Context, req *pb.Announcements) (*pb.Announcements, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedAnnouncements, err := repositories.UpdateAnnouncementsDBHandler(ctx, req.GetAnnouncements())
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return &pb.Announcements{Announcements: updatedAnnouncements}, nil
+}
+
+// Delete announcements by IDs (soft delete)
+func (s *Server) DeleteAnnouncements(ctx context.Context, req *pb.AnnouncementIds) (*pb.DeleteAnnouncementsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteAnnouncementsDBHandler(ctx, req.GetAnnouncementIds(), deletedBy)
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return &pb.DeleteAnnouncementsConfirm{
+		Status:     "Announcements successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// Publish an announcement now or schedule it, the audience is notified when it is published
+func (s *Server) PublishAnnouncement(ctx context.Context, req *pb.PublishAnnouncementRequest) (*pb.Announcement, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	announcement, err := repositories.PublishAnnouncementDBHandler(ctx, req.GetId(), req.GetPublishAt())
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	// scheduled announcements are announced by the scheduler
+	if announcement.GetStatus() == repositories.AnnouncementPublished {
+		event, err := repositories.AnnouncementEventDBHandler(ctx, announcement)
+		if err != nil {
+			log.Println("Failed to find the audience of announcement", announcement.GetId(), err)
+		} else {
+			notify(ctx, event)
+		}
+	}
+
+	return announcement, nil
+}
+
+// Pin or unpin an announcement
+func (s *Server) PinAnnouncement(ctx context.Context, req *pb.PinAnnouncementRequest) (*pb.Announcement, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	announcement, err := repositories.PinAnnouncementDBHandler(ctx, req.GetId(), req.GetPinned())
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return announcement, nil
+}
+
+// the published announcements addressed to the caller
+func (s *Server) GetBulletinBoard(ctx context.Context, req *pb.BulletinBoardRequest) (*pb.Announcements, error) {
+	uid, _ := ctx.Value("uid").(string)
+	role, _ := ctx.Value("role").(string)
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	announcements, err := repositories.BulletinBoardDBHandler(ctx, uid, role, req.GetUnreadOnly(), pageSize, pageNumber)
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return &pb.Announcements{Announcements: announcements}, nil
+}
+
+// leave read receipts of the caller
+func (s *Server) MarkAnnouncementsRead(ctx context.Context, req *pb.AnnouncementIds) (*pb.MarkAnnouncementsReadResponse, error) {
+	uid, _ := ctx.Value("uid").(string)
+	role, _ := ctx.Value("role").(string)
+
+	readIds, err := repositories.MarkAnnouncementsReadDBHandler(ctx, uid, role, req.GetAnnouncementIds())
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return &pb.MarkAnnouncementsReadResponse{ReadIds: readIds}, nil
+}
+
+// who read an announcement
+func (s *Server) GetReadReceipts(ctx context.Context, req *pb.ReadReceiptsRequest) (*pb.ReadReceipts, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	receipts, err := repositories.GetReadReceiptsDBHandler(ctx, req.GetId())
+	if err != nil {
+		return nil, announcementError(err)
+	}
+
+	return receipts, nil
+}
+
+func announcementError(err error) error {
+	if errors.Is(err, repositories.ErrAnnouncement) || errors.Is(err, repositories.ErrStudent) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// record attendance for single students
+func (s *Server) MarkAttendance(ctx context.Context, req *pb.AttendanceRecords) (*pb.MarkAttendanceResponse, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, record := range req.GetRecords() {
+		if record.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	markedBy, _ := ctx.Value("uid").(string)
+
+	response, err := repositories.MarkAttendanceDBHandler(ctx, req.GetRecords(), markedBy)
+	if err != nil {
+		return nil, attendanceError(err)
+	}
+
+	notify(ctx, absenceEvents(response.GetRecords())...)
+
+	return response, nil
+}
+
+// record attendance for the whole class of a class teacher
+func (s *Server) MarkClassAttendance(ctx context.Context, req *pb.ClassAttendanceRequest) (*pb.MarkAttendanceResponse, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	markedBy, _ := ctx.Value("uid").(string)
+
+	response, err := repositories.MarkClassAttendanceDBHandler(ctx, req, markedBy)
+	if err != nil {
+		return nil, attendanceError(err)
+	}
+
+	notify(ctx, absenceEvents(response.GetRecords())...)
+
+	return response, nil
+}
+
+// correct the status of a record, a reason is required
+func (s *Server) CorrectAttendance(ctx context.Context, req *pb.AttendanceCorrectionRequest) (*pb.AttendanceRecord, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required to correct attendance")
+	}
+
+	correctedBy, _ := ctx.Value("uid").(string)
+
+	record, err := repositories.CorrectAttendanceDBHandler(ctx, req.GetId(), req.GetStatus(), req.GetReason(), correctedBy)
+	if err != nil {
+		return nil, attendanceError(err)
+	}
+
+	notify(ctx, absenceEvents([]*pb.AttendanceRecord{record})...)
+
+	return record, nil
+}
+
+// get attendance records of a student or class over a date range
+func (s *Server) GetAttendance(ctx context.Context, req *pb.GetAttendanceRequest) (*pb.AttendanceRecords, error) {
+
+	// student accounts only read their own records
+	if ownID, own := ownStudentID(ctx); own {
+		if req.GetStudentId() != "" && req.GetStudentId() != ownID {
+			return nil, status.Error(codes.PermissionDenied, "students can only read their own records")
+		}
+		req.StudentId = ownID
+	}
+
+	if req.GetStudentId() == "" && req.GetClassId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id or class_id is required")
+	}
+
+	filter := bson.M{}
+	if req.GetStudentId() != "" {
+		filter["student_id"] = req.GetStudentId()
+	}
+	if req.GetClassId() != "" {
+		filter["class_id"] = req.GetClassId()
+	}
+	if req.GetStatus() != "" {
+		filter["status"] = req.GetStatus()
+	}
+	term, err := attendanceTerm(ctx, req.GetTerm(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+	if term != "" {
+		filter["term"] = term
+	}
+	filter = repositories.AttendanceDateFilter(filter, req.GetFromDate(), req.GetToDate())
+
+	records, err := repositories.GetAttendanceDBHandler(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.AttendanceRecords{Records: records}, nil
+}
+
+// attendance counts and rate of a student over a date range
+func (s *Server) GetStudentAttendanceSummary(ctx context.Context, req *pb.AttendanceSummaryRequest) (*pb.AttendanceSummary, error) {
+
+	err := checkOwnStudent(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := attendanceTerm(ctx, req.GetTerm(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := repositories.GetStudentAttendanceSummaryDBHandler(ctx, req.GetId(), term, req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return summary, nil
+}
+
+// attendance counts and rates of a class and each of its students over a date range
+func (s *Server) GetClassAttendanceSummary(ctx context.Context, req *pb.AttendanceSummaryRequest) (*pb.ClassAttendanceSummary, error) {
+
+	term, err := attendanceTerm(ctx, req.GetTerm(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := repositories.GetClassAttendanceSummaryDBHandler(ctx, req.GetId(), term, req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return summary, nil
+}
+
+// attendanceTerm is the term attendance is read for, a date range replaces the default of the current term
+func attendanceTerm(ctx context.Context, term, fromDate, toDate string) (string, error) {
+	if term == "" && (fromDate != "" || toDate != "") {
+		return "", nil
+	}
+	return defaultTerm(ctx, term)
+}
+
+// attendanceError maps refused attendance writes to FailedPrecondition
+// absenceEvents tells the students of the absent records, the other statuses are not notified
+func absenceEvents(records []*pb.AttendanceRecord) []repositories.NotificationEvent {
+	var events []repositories.NotificationEvent
+	for _, record := range records {
+		if record.GetStatus() != "absent" {
+			continue
+		}
+		body := fmt.Sprintf("You were marked absent on %s.", record.GetDate())
+		if record.GetPeriod() > 0 {
+			body = fmt.Sprintf("You were marked absent on %s in period %d.", record.GetDate(), record.GetPeriod())
+		}
+		events = append(events, repositories.NotificationEvent{
+			Event:     repositories.EventAttendanceAbsent,
+			Title:     "Absence recorded",
+			Body:      body,
+			SubjectId: record.GetId(),
+			UserIds:   []string{record.GetStudentId()},
+		})
+	}
+	return events
+}
+
+func attendanceError(err error) error {
+	if errors.Is(err, repositories.ErrAttendance) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,369 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add academic years
+func (s *Server) AddAcademicYears(ctx context.Context, req *pb.AcademicYears) (*pb.AcademicYears, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, year := range req.AcademicYears {
+		if year.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedYears, err := repositories.AddAcademicYearsDBHandler(ctx, req.GetAcademicYears())
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.AcademicYears{AcademicYears: addedYears}, nil
+}
+
+// Get academic years with filter + sort
+func (s *Server) GetAcademicYears(ctx context.Context, req *pb.GetAcademicYearRequest) (*pb.AcademicYears, error) {
+
+	filter, err := buildfilter(req.AcademicYear, &models.AcademicYear{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted years are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "academic_years")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	years, err := repositories.GetAcademicYearsDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.AcademicYears{AcademicYears: years}, nil
+}
+
+// Update academic years
+func (s *Server) UpdateAcademicYears(ctx context.Context, req *pb.AcademicYears) (*pb.AcademicYears, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedYears, err := repositories.UpdateAcademicYearsDBHandler(ctx, req.GetAcademicYears())
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.AcademicYears{AcademicYears: updatedYears}, nil
+}
+
+// Delete academic years by IDs (soft delete)
+func (s *Server) DeleteAcademicYears(ctx context.Context, req *pb.AcademicYearIds) (*pb.DeleteAcademicYearsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteAcademicYearsDBHandler(ctx, req.GetAcademicYearIds(), deletedBy)
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.DeleteAcademicYearsConfirm{
+		Status:     "Academic years successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// Add terms
+func (s *Server) AddTerms(ctx context.Context, req *pb.Terms) (*pb.Terms, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, term := range req.Terms {
+		if term.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedTerms, err := repositories.AddTermsDBHandler(ctx, req.GetTerms())
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.Terms{Terms: addedTerms}, nil
+}
+
+// Get terms with filter + sort
+func (s *Server) GetTerms(ctx context.Context, req *pb.GetTermRequest) (*pb.Terms, error) {
+
+	filter, err := buildfilter(req.Term, &models.Term{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted terms are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "terms")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	terms, err := repositories.GetTermsDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Terms{Terms: terms}, nil
+}
+
+// Update terms
+func (s *Server) UpdateTerms(ctx context.Context, req *pb.Terms) (*pb.Terms, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedTerms, err := repositories.UpdateTermsDBHandler(ctx, req.GetTerms())
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.Terms{Terms: updatedTerms}, nil
+}
+
+// Delete terms by IDs (soft delete)
+func (s *Server) DeleteTerms(ctx context.Context, req *pb.TermIds) (*pb.DeleteTermsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteTermsDBHandler(ctx, req.GetTermIds(), deletedBy)
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.DeleteTermsConfirm{
+		Status:     "Terms successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// Get the holidays of a year and/or a date range
+func (s *Server) GetHolidays(ctx context.Context, req *pb.GetHolidayRequest) (*pb.Holidays, error) {
+
+	holidays, err := repositories.GetHolidaysDBHandler(ctx, req.GetAcademicYearId(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Holidays{Holidays: holidays}, nil
+}
+
+// Add holidays
+func (s *Server) AddHolidays(ctx context.Context, req *pb.Holidays) (*pb.Holidays, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, holiday := range req.Holidays {
+		if holiday.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedHolidays, err := repositories.AddHolidaysDBHandler(ctx, req.GetHolidays())
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.Holidays{Holidays: addedHolidays}, nil
+}
+
+// Delete holidays by IDs (soft delete)
+func (s *Server) DeleteHolidays(ctx context.Context, req *pb.HolidayIds) (*pb.DeleteHolidaysConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteHolidaysDBHandler(ctx, req.GetHolidayIds(), deletedBy)
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	return &pb.DeleteHolidaysConfirm{
+		Status:     "Holidays successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// the term current on the requested date, today when no date is given
+func (s *Server) GetCurrentTerm(ctx context.Context, req *pb.CurrentTermRequest) (*pb.Term, error) {
+
+	term, err := repositories.CurrentTermDBHandler(ctx, req.GetDate())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if term == nil {
+		return nil, status.Error(codes.NotFound, "no term has started yet")
+	}
+
+	return repositories.MapModelToPbTerm(term), nil
+}
+
+// defaultTerm returns term, or the name of the current term when term is empty. It stays empty while no term has
+// started so the Get RPCs keep working on a school without a calendar
+func defaultTerm(ctx context.Context, term string) (string, error) {
+	if term != "" {
+		return term, nil
+	}
+
+	current, err := repositories.CurrentTermDBHandler(ctx, "")
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	if current == nil {
+		return "", nil
+	}
+	return current.Name, nil
+}
+
+// scopeToTerm narrows a student or teacher filter to the members of an earlier term. The class in the filter is
+// matched against the class they had in that term instead of the class they are in now. The memberships are
+// returned so the caller can show that class, nil when term is empty or the current term
+func scopeToTerm(ctx context.Context, filter bson.M, memberType, term string) (map[string]models.ClassMembership, error) {
+	if term == "" {
+		return nil, nil
+	}
+
+	current, err := repositories.CurrentTermDBHandler(ctx, "")
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if current != nil && current.Name == term {
+		return nil, nil
+	}
+
+	_, err = repositories.FindTermDBHandler(ctx, term)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCalendar) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	classID, _ := filter["class_id"].(string)
+	className, _ := filter["class"].(string)
+	delete(filter, "class_id")
+	delete(filter, "class")
+
+	memberships, err := repositories.ClassMembershipsDBHandler(ctx, memberType, term, classID, className)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// a filter on a single id only matches when that member was in a class in the term
+	if id, ok := filter["_id"].(primitive.ObjectID); ok {
+		if _, member := memberships[id.Hex()]; !member {
+			filter["_id"] = bson.M{"$in": []primitive.ObjectID{}}
+		}
+		return memberships, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(memberships))
+	for memberID := range memberships {
+		objectID, err := primitive.ObjectIDFromHex(memberID)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, objectID)
+	}
+	filter["_id"] = bson.M{"$in": ids}
+	return memberships, nil
+}
+
+// overlapping or inconsistent years, terms and holidays are the client's fault, everything else is internal
+func calendarError(err error) error {
+	if errors.Is(err, repositories.ErrCalendar) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
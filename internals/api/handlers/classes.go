@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add classes
+func (s *Server) AddClasses(ctx context.Context, req *pb.Classes) (*pb.Classes, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, class := range req.Classes {
+		if class.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedClasses, err := repositories.AddClassesDBHandler(ctx, req.GetClasses())
+	if err != nil {
+		if errors.Is(err, repositories.ErrClassIntegrity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Classes{Classes: addedClasses}, nil
+}
+
+// Get classes with filter + sort
+func (s *Server) GetClasses(ctx context.Context, req *pb.GetClassRequest) (*pb.Classes, error) {
+
+	// Build Mongo filter from request
+	filter, err := buildfilter(req.Class, &models.Class{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted classes are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	// classes of the current academic year, classes without a year are always listed
+	if req.GetClass().GetAcademicYear() == "" {
+		year, err := repositories.CurrentAcademicYearDBHandler(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if year != nil {
+			filter["academic_year"] = bson.M{"$in": bson.A{year.Name, nil}}
+		}
+	}
+
+	// Build sort options from request
+	sortOption, err := buildSortOptions(req.GetSortBy(), "classes")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	// Fetch from database
+	classes, err := repositories.GetClassesDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Classes{Classes: classes}, nil
+}
+
+// Update classes
+func (s *Server) UpdateClasses(ctx context.Context, req *pb.Classes) (*pb.Classes, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedClasses, err := repositories.UpdateClassesDBHandler(ctx, req.Classes)
+	if err != nil {
+		if errors.Is(err, repositories.ErrClassIntegrity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Classes{Classes: updatedClasses}, nil
+}
+
+// Delete classes by IDs (soft delete)
+func (s *Server) DeleteClasses(ctx context.Context, req *pb.ClassIds) (*pb.DeleteClassesConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteClassesDBHandler(ctx, req.GetClassIds(), deletedBy)
+	if err != nil {
+		if errors.Is(err, repositories.ErrClassIntegrity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.DeleteClassesConfirm{
+		Status:     "Classes successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// promote the classes of an academic year into the next one, with dry_run only the plan is returned
+func (s *Server) RolloverYear(ctx context.Context, req *pb.RolloverRequest) (*pb.RolloverPlan, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	rolledBy, _ := ctx.Value("uid").(string)
+
+	plan, err := repositories.RolloverYearDBHandler(ctx, req, rolledBy)
+	if err != nil {
+		if errors.Is(err, repositories.ErrClassIntegrity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, calendarError(err)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add subjects
+func (s *Server) AddSubjects(ctx context.Context, req *pb.Subjects) (*pb.Subjects, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, subject := range req.Subjects {
+		if subject.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedSubjects, err := repositories.AddSubjectsDBHandler(ctx, req.GetSubjects())
+	if err != nil {
+		return nil, courseError(err)
+	}
+
+	return &pb.Subjects{Subjects: addedSubjects}, nil
+}
+
+// Get subjects with filter + sort
+func (s *Server) GetSubjects(ctx context.Context, req *pb.GetSubjectRequest) (*pb.Subjects, error) {
+
+	filter, err := buildfilter(req.Subject, &models.Subject{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted subjects are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "subjects")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	subjects, err := repositories.GetSubjectsDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Subjects{Subjects: subjects}, nil
+}
+
+// Update subjects
+func (s *Server) UpdateSubjects(ctx context.Context, req *pb.Subjects) (*pb.Subjects, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedSubjects, err := repositories.UpdateSubjectsDBHandler(ctx, req.Subjects)
+	if err != nil {
+		return nil, courseError(err)
+	}
+
+	return &pb.Subjects{Subjects: updatedSubjects}, nil
+}
+
+// Delete subjects by IDs (soft delete)
+func (s *Server) DeleteSubjects(ctx context.Context, req *pb.SubjectIds) (*pb.DeleteSubjectsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteSubjectsDBHandler(ctx, req.GetSubjectIds(), deletedBy)
+	if err != nil {
+		return nil, courseError(err)
+	}
+
+	return &pb.DeleteSubjectsConfirm{
+		Status:     "Subjects successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// Add courses
+func (s *Server) AddCourses(ctx context.Context, req *pb.Courses) (*pb.Courses, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, course := range req.Courses {
+		if course.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedCourses, err := repositories.AddCoursesDBHandler(ctx, req.GetCourses())
+	if err != nil {
+		return nil, courseError(err)
+	}
+
+	return &pb.Courses{Courses: addedCourses}, nil
+}
+
+// Get courses with filter + sort
+func (s *Server) GetCourses(ctx context.Context, req *pb.GetCourseRequest) (*pb.Courses, error) {
+
+	filter, err := buildfilter(req.Course, &models.Course{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted courses are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	// courses of the current term unless another term is asked for
+	term, err := defaultTerm(ctx, req.GetCourse().GetTerm())
+	if err != nil {
+		return nil, err
+	}
+	if term != "" {
+		filter["term"] = term
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "courses")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	courses, err := repositories.GetCoursesDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Courses{Courses: courses}, nil
+}
+
+// Update courses
+func (s *Server) UpdateCourses(ctx context.Context, req *pb.Courses) (*pb.Courses, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedCourses, err := repositories.UpdateCoursesDBHandler(ctx, req.Courses)
+	if err != nil {
+		return nil, courseError(err)
+	}
+
+	return &pb.Courses{Courses: updatedCourses}, nil
+}
+
+// Delete courses by IDs (soft delete)
+func (s *Server) DeleteCourses(ctx context.Context, req *pb.CourseIds) (*pb.DeleteCoursesConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteCoursesDBHandler(ctx, req.GetCourseIds(), deletedBy)
+	if err != nil {
+		return nil, courseError(err)
+	}
+
+	return &pb.DeleteCoursesConfirm{
+		Status:     "Courses successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// list the courses a teacher teaches
+func (s *Server) ListCoursesByTeacher(ctx context.Context, req *pb.CoursesByTeacherRequest) (*pb.Courses, error) {
+
+	if req.GetTeacherId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "teacher_id is required")
+	}
+
+	term, err := defaultTerm(ctx, req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	courses, err := repositories.ListCoursesDBHandler(ctx, bson.M{"teacher_id": req.GetTeacherId()}, term)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Courses{Courses: courses}, nil
+}
+
+// list the courses a class takes
+func (s *Server) ListCoursesByClass(ctx context.Context, req *pb.CoursesByClassRequest) (*pb.Courses, error) {
+
+	if req.GetClassId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "class_id is required")
+	}
+
+	term, err := defaultTerm(ctx, req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	courses, err := repositories.ListCoursesDBHandler(ctx, bson.M{"class_id": req.GetClassId()}, term)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Courses{Courses: courses}, nil
+}
+
+// integrity errors of subjects and courses are the client's fault, everything else is internal
+func courseError(err error) error {
+	if errors.Is(err, repositories.ErrCourseIntegrity) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
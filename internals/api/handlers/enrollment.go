@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// enroll a student into a course, the student is waitlisted when the course is full
+func (s *Server) Enroll(ctx context.Context, req *pb.EnrollmentRequest) (*pb.Enrollment, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	enrollment, err := repositories.EnrollDBHandler(ctx, req.GetStudentId(), req.GetCourseId())
+	if err != nil {
+		return nil, enrollmentError(err)
+	}
+
+	return enrollment, nil
+}
+
+// drop a student from a course, the first waitlisted student takes the freed seat
+func (s *Server) Drop(ctx context.Context, req *pb.EnrollmentRequest) (*pb.Enrollment, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	enrollment, err := repositories.DropDBHandler(ctx, req.GetStudentId(), req.GetCourseId())
+	if err != nil {
+		return nil, enrollmentError(err)
+	}
+
+	return enrollment, nil
+}
+
+// list the enrollments of a student, optionally by status
+func (s *Server) ListEnrollmentsByStudent(ctx context.Context, req *pb.EnrollmentsByStudentRequest) (*pb.Enrollments, error) {
+
+	err := checkOwnStudent(ctx, req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"student_id": req.GetStudentId()}
+	if req.GetStatus() != "" {
+		filter["status"] = req.GetStatus()
+	}
+
+	enrollments, err := repositories.ListEnrollmentsDBHandler(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Enrollments{Enrollments: enrollments}, nil
+}
+
+// list the enrollments of a course, optionally by status
+func (s *Server) ListEnrollmentsByCourse(ctx context.Context, req *pb.EnrollmentsByCourseRequest) (*pb.Enrollments, error) {
+
+	filter := bson.M{"course_id": req.GetCourseId()}
+	if req.GetStatus() != "" {
+		filter["status"] = req.GetStatus()
+	}
+
+	enrollments, err := repositories.ListEnrollmentsDBHandler(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Enrollments{Enrollments: enrollments}, nil
+}
+
+// enrollmentError maps refused enrollments and missing students/courses to FailedPrecondition
+func enrollmentError(err error) error {
+	if errors.Is(err, repositories.ErrEnrollment) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return courseError(err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"school_project_grpc/internals/models"
 	"school_project_grpc/internals/repositories"
@@ -35,8 +36,14 @@ func (s *Server) AddExecs(ctx context.Context, req *pb.Execs) (*pb.Execs, error)
 		}
 	}
 
-	addedExec, err := repositories.AddExecsDBHandler(ctx, req.GetExecs())
+	// the new users get an invite to choose their password
+	invitedBy, _ := ctx.Value("uid").(string)
+
+	addedExec, err := repositories.AddExecsDBHandler(ctx, req.GetExecs(), invitedBy)
 	if err != nil {
+		if errors.Is(err, repositories.ErrAccount) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -57,16 +64,32 @@ func (s *Server) GetExecs(ctx context.Context, req *pb.GetExecRequset) (*pb.Exec
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "internal err")
 	}
+
+	err = applyFilterExpression(filter, req.GetFilter(), execFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	// soft deleted execs are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
 	// build sort options from the request
-	sortOption := buildSortOptions(req.GetSortBy())
+	sortOption, err := buildSortOptions(req.GetSortBy(), "execs")
+	if err != nil {
+		return nil, err
+	}
+	page := pageRequest(req.GetPageSize(), 1, req.GetPageToken(), req.GetIncludeTotalSize())
 	// Fetch from db
 
-	execs, err := repositories.GetExecsDBHandler(ctx, sortOption, filter)
+	execs, pageInfo, err := repositories.GetExecsDBHandler(ctx, sortOption, filter, page)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, pageError(err)
 	}
 
-	return &pb.Execs{Execs: execs}, nil
+	return &pb.Execs{Execs: execs, NextPageToken: pageInfo.NextPageToken, TotalSize: pageInfo.TotalSize}, nil
 }
 
 func (s *Server) UpdateExecs(ctx context.Context, req *pb.Execs) (*pb.Execs, error) {
@@ -86,7 +109,10 @@ func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteEx
 		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
 	}
 
-	deletedIds, err := repositories.DeleteExecsDBHandler(ctx, req.GetExecIds())
+	// the user who deleted the records is kept so the deletion can be traced
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteExecsDBHandler(ctx, req.GetExecIds(), deletedBy)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -97,6 +123,53 @@ func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteEx
 	}, nil
 }
 
+// Restore soft deleted execs by IDs
+func (s *Server) RestoreExecs(ctx context.Context, req *pb.ExecIds) (*pb.RestoreExecsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	restoredIds, err := repositories.RestoreExecsDBHandler(ctx, req.GetExecIds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.RestoreExecsConfirm{
+		Status:      "Execs successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
+// Watch execs, the changes of the execs matching the filter are streamed until the client disconnects
+func (s *Server) WatchExecs(req *pb.WatchExecsRequest, stream pb.ExecsService_WatchExecsServer) error {
+
+	// authorization
+	err := utils.Authorization(stream.Context(), "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// passwords and tokens can not be searched
+	if req.Exec != nil {
+		req.Exec.Password = ""
+		req.Exec.PasswordResetToken = ""
+	}
+
+	filter, err := buildfilter(req.Exec, &models.Exec{})
+	if err != nil {
+		return utils.ErrorHandler(err, "internal err")
+	}
+
+	err = repositories.WatchExecsDBHandler(stream.Context(), filter, req.GetResumeToken(), stream.Send)
+	if err != nil {
+		return watchError(err)
+	}
+	return nil
+}
+
 // login function
 func (s *Server) Login(ctx context.Context, req *pb.ExecLogInRequest) (*pb.ExecLogInResponse, error) {
 
@@ -111,14 +184,19 @@ func (s *Server) Login(ctx context.Context, req *pb.ExecLogInRequest) (*pb.ExecL
 		return nil, status.Error(codes.Unauthenticated, "Account is Inactive")
 	}
 
+	// users without a password have not accepted their invite yet
+	if exec.Password == "" {
+		return nil, status.Error(codes.Unauthenticated, "Account invite has not been accepted yet")
+	}
+
 	// verify password
 	err = utils.VerifyPassword(req.GetPassword(), exec.Password)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Incorrect Password")
 	}
 
-	// signing jwt
-	token, err := utils.SingingJWT(exec.Id, exec.Username, exec.Role)
+	// signing jwt, students and teachers get their profile id as uid
+	token, err := utils.SingingJWT(repositories.TokenSubject(exec), exec.Username, exec.Role)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Failed to created jwt token")
 	}
@@ -139,7 +217,7 @@ func (s *Server) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordReque
 	}
 
 	// signing token
-	token, err := utils.SingingJWT(user.Id, user.Username, user.Role)
+	token, err := utils.SingingJWT(repositories.TokenSubject(user), user.Username, user.Role)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
@@ -164,6 +242,13 @@ func (s *Server) DeactivateUser(ctx context.Context, req *pb.ExecIds) (*pb.Confi
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	notify(ctx, repositories.NotificationEvent{
+		Event:   repositories.EventAccountDeactivated,
+		Title:   "Your account was deactivated",
+		Body:    "Your school portal account was deactivated and can no longer be used to log in. Please contact the school office if you think this is a mistake.",
+		UserIds: req.GetExecIds(),
+	})
+
 	return &pb.Confirmation{
 		Confirmation: res.ModifiedCount > 0,
 	}, nil
@@ -187,6 +272,30 @@ func (s *Server) ReactivateUser(ctx context.Context, req *pb.ExecIds) (*pb.Confi
 	}, nil
 }
 
+// the caller's own account and profile
+func (s *Server) Me(ctx context.Context, req *pb.EmptyRequest) (*pb.MeResponse, error) {
+	uid, _ := ctx.Value("uid").(string)
+	role, _ := ctx.Value("role").(string)
+	if uid == "" {
+		return nil, status.Error(codes.Unauthenticated, "uid missing from token")
+	}
+
+	me, err := repositories.MeDBHandler(ctx, uid, role)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccount) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the own medical notes are as restricted as everybody else's
+	if me.Student != nil && !canReadMedicalNotes(ctx) {
+		me.Student.MedicalNotes = ""
+	}
+
+	return me, nil
+}
+
 // forgot passwor handler, sends token to the user's email throught which user can reset password
 func (s *Server) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequst) (*pb.ForgotPasswordResponse, error) {
 	email := req.GetEmail()
@@ -210,16 +319,11 @@ func (s *Server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequst)
 		return nil, status.Error(codes.InvalidArgument, "passwords do not match")
 	}
 
-	// decoding the tokne to check it with db token
-	bytes, err := hex.DecodeString(token)
+	hashedTokenString, err := hashEmailedToken(token)
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
+		return nil, err
 	}
 
-	// encoding the byte token in db token encription style to compare them
-	hashedToken := sha256.Sum256(bytes)
-	hashedTokenString := hex.EncodeToString(hashedToken[:])
-
 	err = repositories.ResetPasswordDBHandler(ctx, hashedTokenString, req.GetNewPassword())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -267,3 +371,106 @@ func (s *Server) Logout(ctx context.Context, req *pb.EmptyRequest) (*pb.ExecLogo
 	}, nil
 
 }
+
+// set the password of an invited user with the invite code from the email
+func (s *Server) AcceptInvite(ctx context.Context, req *pb.AcceptInviteRequest) (*pb.Confirmation, error) {
+
+	if req.GetNewPassword() == "" || req.GetNewPassword() != req.GetConfirmPassword() {
+		return nil, status.Error(codes.InvalidArgument, "passwords do not match")
+	}
+
+	hashedToken, err := hashEmailedToken(req.GetInviteCode())
+	if err != nil {
+		return nil, err
+	}
+
+	err = repositories.AcceptInviteDBHandler(ctx, hashedToken, req.GetNewPassword())
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccount) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Confirmation{
+		Confirmation: true,
+	}, nil
+}
+
+// list the users that have not accepted their invite
+func (s *Server) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.Invites, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	invites, err := repositories.ListInvitesDBHandler(ctx, req.GetIncludeExpired(), req.GetIncludeRevoked())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Invites{Invites: invites}, nil
+}
+
+// send a new invite code, the earlier code stops working
+func (s *Server) ResendInvite(ctx context.Context, req *pb.ExecIds) (*pb.InviteConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	invitedBy, _ := ctx.Value("uid").(string)
+
+	resent, err := repositories.ResendInviteDBHandler(ctx, req.GetExecIds(), invitedBy)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccount) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.InviteConfirm{
+		Status:  "Invites successfully sent",
+		ExecIds: resent,
+	}, nil
+}
+
+// make pending invite codes unusable
+func (s *Server) RevokeInvite(ctx context.Context, req *pb.ExecIds) (*pb.InviteConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	revoked, err := repositories.RevokeInviteDBHandler(ctx, req.GetExecIds())
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccount) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.InviteConfirm{
+		Status:  "Invites successfully revoked",
+		ExecIds: revoked,
+	}, nil
+}
+
+// hashEmailedToken hashes a reset or invite code the way it is stored in the db so they can be compared
+func hashEmailedToken(token string) (string, error) {
+	// decoding the tokne to check it with db token
+	bytes, err := hex.DecodeString(token)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	// encoding the byte token in db token encription style to compare them
+	hashedToken := sha256.Sum256(bytes)
+	return hex.EncodeToString(hashedToken[:]), nil
+}
@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"school_project_grpc/internals/export"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// the exported file is sent in pieces of this size
+const exportChunkSize = 64 << 10
+
+// Export the students matching the query, medical notes are only exported when asked for in the columns
+func (s *Server) ExportStudents(req *pb.ExportStudentsRequest, stream pb.StudentsService_ExportStudentsServer) error {
+	ctx := stream.Context()
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	query := req.GetQuery()
+	filter, memberships, err := studentQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	sortOption, err := buildSortOptions(query.GetSortBy(), "students")
+	if err != nil {
+		return err
+	}
+
+	exporter, err := newExporter(stream, req.GetOptions(), "students", (&pb.Student{}).ProtoReflect().Descriptor(),
+		[]string{"medical_notes"}, nil)
+	if err != nil {
+		return err
+	}
+
+	err = repositories.ExportStudentsDBHandler(ctx, sortOption, filter, func(student *pb.Student) error {
+		if memberships != nil {
+			student.ClassId = memberships[student.Id].ClassId
+			student.Class = memberships[student.Id].ClassName
+		}
+		return exporter.write(student)
+	})
+	if err != nil {
+		exporter.table.Discard()
+		return exportError(err)
+	}
+	return exporter.close()
+}
+
+// Export the teachers matching the query, with the class they had in the term of the query
+func (s *Server) ExportTeachers(req *pb.ExportTeachersRequest, stream pb.TeachersService_ExportTeachersServer) error {
+	ctx := stream.Context()
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	query := req.GetQuery()
+	filter, memberships, err := teacherQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	sortOption, err := buildSortOptions(query.GetSortBy(), "teachers")
+	if err != nil {
+		return err
+	}
+
+	exporter, err := newExporter(stream, req.GetOptions(), "teachers", (&pb.Teacher{}).ProtoReflect().Descriptor(), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	err = repositories.ExportTeachersDBHandler(ctx, sortOption, filter, func(teacher *pb.Teacher) error {
+		if memberships != nil {
+			teacher.ClassId = memberships[teacher.Id].ClassId
+			teacher.Class = memberships[teacher.Id].ClassName
+		}
+		return exporter.write(teacher)
+	})
+	if err != nil {
+		exporter.table.Discard()
+		return exportError(err)
+	}
+	return exporter.close()
+}
+
+// Export the execs matching the query, passwords and tokens can not be exported
+func (s *Server) ExportExecs(req *pb.ExportExecsRequest, stream pb.ExecsService_ExportExecsServer) error {
+	ctx := stream.Context()
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	query := req.GetQuery()
+	filter, err := buildfilter(query.GetExec(), &models.Exec{})
+	if err != nil {
+		return utils.ErrorHandler(err, "internal err")
+	}
+
+	// the password and the tokens can not be guessed through the filter
+	delete(filter, "password")
+	delete(filter, "password_reset_token")
+	delete(filter, "password_token_exp")
+
+	err = applyFilterExpression(filter, query.GetFilter(), execFilterFields)
+	if err != nil {
+		return err
+	}
+
+	err = applySoftDeleteFilter(ctx, filter, query.GetIncludeDeleted())
+	if err != nil {
+		return err
+	}
+
+	sortOption, err := buildSortOptions(query.GetSortBy(), "execs")
+	if err != nil {
+		return err
+	}
+
+	exporter, err := newExporter(stream, req.GetOptions(), "execs", (&pb.Exec{}).ProtoReflect().Descriptor(),
+		nil, []string{"password", "passwordResetToken", "passwordTokenExp"})
+	if err != nil {
+		return err
+	}
+
+	err = repositories.ExportExecsDBHandler(ctx, sortOption, filter, func(exec *pb.Exec) error {
+		return exporter.write(exec)
+	})
+	if err != nil {
+		exporter.table.Discard()
+		return exportError(err)
+	}
+	return exporter.close()
+}
+
+// exporter writes entities as the rows of the exported file, the file is sent in chunks as it grows
+type exporter struct {
+	columns []protoreflect.FieldDescriptor
+	table   export.Writer
+	out     *chunkWriter
+}
+
+// newExporter starts the file with the columns of the options, or every field of the entity that can be a column when
+// none are given. optIn fields are only exported when asked for, hidden fields never are
+func newExporter(stream grpc.ServerStreamingServer[pb.ExportChunk], opts *pb.ExportOptions, name string, descriptor protoreflect.MessageDescriptor, optIn, hidden []string) (*exporter, error) {
+	format := opts.GetFormat()
+	if format == "" {
+		format = export.CSV
+	}
+	contentType := export.ContentType(format)
+	if contentType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown export format %q, use csv, jsonl or xlsx", format)
+	}
+
+	var columns []protoreflect.FieldDescriptor
+	fields := descriptor.Fields()
+	for _, column := range opts.GetColumns() {
+		field := fields.ByName(protoreflect.Name(column))
+		if field == nil {
+			field = fields.ByJSONName(column)
+		}
+		if field == nil || !exportable(field) || slices.Contains(hidden, string(field.Name())) {
+			return nil, status.Errorf(codes.InvalidArgument, "column %q can not be exported", column)
+		}
+		columns = append(columns, field)
+	}
+	if len(columns) == 0 {
+		for i := range fields.Len() {
+			field := fields.Get(i)
+			name := string(field.Name())
+			if exportable(field) && !slices.Contains(optIn, name) && !slices.Contains(hidden, name) {
+				columns = append(columns, field)
+			}
+		}
+	}
+
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, string(column.Name()))
+	}
+
+	out := &chunkWriter{
+		stream: stream,
+		first: &pb.ExportChunk{
+			FileName:    fmt.Sprintf("%s-%s.%s", name, time.Now().Format("2006-01-02"), format),
+			ContentType: contentType,
+		},
+	}
+	table, err := export.NewWriter(format, out, name, header)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &exporter{columns: columns, table: table, out: out}, nil
+}
+
+// write adds the entity as a row
+func (e *exporter) write(entity protoreflect.ProtoMessage) error {
+	message := entity.ProtoReflect()
+	values := make([]string, 0, len(e.columns))
+	for _, column := range e.columns {
+		values = append(values, fmt.Sprint(message.Get(column).Interface()))
+	}
+	return e.table.WriteRow(values)
+}
+
+// close finishes the file and sends what is left of it
+func (e *exporter) close() error {
+	err := e.table.Close()
+	if err != nil {
+		return exportError(err)
+	}
+	return e.out.flush()
+}
+
+// fields holding a single scalar can be columns, lists and nested messages can not
+func exportable(field protoreflect.FieldDescriptor) bool {
+	return field.Cardinality() != protoreflect.Repeated && field.Kind() != protoreflect.MessageKind &&
+		field.Kind() != protoreflect.GroupKind && field.Kind() != protoreflect.BytesKind
+}
+
+// chunkWriter sends everything written to it as ExportChunks of exportChunkSize, the first chunk carries the file name
+// and content type
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[pb.ExportChunk]
+	first  *pb.ExportChunk
+	buf    []byte
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	for len(c.buf) >= exportChunkSize {
+		err := c.send(c.buf[:exportChunkSize])
+		if err != nil {
+			return 0, err
+		}
+		c.buf = c.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// flush sends the rest of the file, an empty file is still announced with its name
+func (c *chunkWriter) flush() error {
+	if len(c.buf) == 0 && c.first == nil {
+		return nil
+	}
+	err := c.send(c.buf)
+	c.buf = nil
+	return err
+}
+
+func (c *chunkWriter) send(data []byte) error {
+	chunk := &pb.ExportChunk{}
+	if c.first != nil {
+		chunk, c.first = c.first, nil
+	}
+	chunk.Data = slices.Clone(data)
+	return c.stream.Send(chunk)
+}
+
+// a client that went away ends the export, everything else is internal
+func exportError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
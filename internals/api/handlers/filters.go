@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+
+	"school_project_grpc/internals/filterexpr"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fields the filter expressions of the lists can name. Secrets are left out so they can not be guessed
+var (
+	studentFilterFields = filterexpr.Fields{
+		"id":                {Key: "_id", Kind: filterexpr.ID},
+		"first_name":        {Key: "first_name"},
+		"last_name":         {Key: "last_name"},
+		"email":             {Key: "email"},
+		"class":             {Key: "class"},
+		"class_id":          {Key: "class_id"},
+		"date_of_birth":     {Key: "date_of_birth", Kind: filterexpr.Date},
+		"gender":            {Key: "gender"},
+		"address":           {Key: "address"},
+		"admission_number":  {Key: "admission_number"},
+		"enrollment_status": {Key: "enrollment_status"},
+		"admission_date":    {Key: "admission_date", Kind: filterexpr.Date},
+		"graduated_at":      {Key: "graduated_at", Kind: filterexpr.Date},
+		"deleted_at":        {Key: "deleted_at", Kind: filterexpr.Date},
+	}
+
+	// medical notes can only be searched by the users who can read them
+	studentFilterFieldsWithNotes = withFilterField(studentFilterFields, "medical_notes", filterexpr.Field{Key: "medical_notes"})
+
+	teacherFilterFields = filterexpr.Fields{
+		"id":         {Key: "_id", Kind: filterexpr.ID},
+		"first_name": {Key: "first_name"},
+		"last_name":  {Key: "last_name"},
+		"email":      {Key: "email"},
+		"class":      {Key: "class"},
+		"class_id":   {Key: "class_id"},
+		"subject":    {Key: "subject"},
+		"deleted_at": {Key: "deleted_at", Kind: filterexpr.Date},
+	}
+
+	execFilterFields = filterexpr.Fields{
+		"id":                  {Key: "_id", Kind: filterexpr.ID},
+		"first_name":          {Key: "first_name"},
+		"last_name":           {Key: "last_name"},
+		"email":               {Key: "email"},
+		"username":            {Key: "username"},
+		"role":                {Key: "role"},
+		"inactive_status":     {Key: "inactive_status", Kind: filterexpr.Bool},
+		"user_created_at":     {Key: "user_created_at", Kind: filterexpr.Date},
+		"password_changed_at": {Key: "password_changed_at", Kind: filterexpr.Date},
+		"profile_id":          {Key: "profile_id"},
+		"deleted_at":          {Key: "deleted_at", Kind: filterexpr.Date},
+	}
+)
+
+// applyFilterExpression narrows the filter to the documents matching the filter expression of a list request
+func applyFilterExpression(filter bson.M, expr string, fields filterexpr.Fields) error {
+	matching, err := filterexpr.Parse(expr, fields)
+	if err != nil {
+		if errors.Is(err, filterexpr.ErrFilter) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	and, _ := filter["$and"].(bson.A)
+	filter["$and"] = append(and, matching)
+	return nil
+}
+
+func withFilterField(fields filterexpr.Fields, name string, field filterexpr.Field) filterexpr.Fields {
+	with := filterexpr.Fields{name: field}
+	for key, value := range fields {
+		with[key] = value
+	}
+	return with
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add assessments to a course
+func (s *Server) AddAssessments(ctx context.Context, req *pb.Assessments) (*pb.Assessments, error) {
+
+	teacherID, err := gradeWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate: ID must be empty on create
+	for _, assessment := range req.GetAssessments() {
+		if assessment.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	createdBy, _ := ctx.Value("uid").(string)
+
+	addedAssessments, err := repositories.AddAssessmentsDBHandler(ctx, req.GetAssessments(), teacherID, createdBy)
+	if err != nil {
+		return nil, gradesError(err)
+	}
+
+	return &pb.Assessments{Assessments: addedAssessments}, nil
+}
+
+// Get the assessments of a course
+func (s *Server) GetAssessments(ctx context.Context, req *pb.GetAssessmentsRequest) (*pb.Assessments, error) {
+
+	if req.GetCourseId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id is required")
+	}
+
+	filter := bson.M{"course_id": req.GetCourseId()}
+	if req.GetType() != "" {
+		filter["type"] = req.GetType()
+	}
+
+	assessments, err := repositories.GetAssessmentsDBHandler(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Assessments{Assessments: assessments}, nil
+}
+
+// Update assessments
+func (s *Server) UpdateAssessments(ctx context.Context, req *pb.Assessments) (*pb.Assessments, error) {
+
+	teacherID, err := gradeWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAssessments, err := repositories.UpdateAssessmentsDBHandler(ctx, req.GetAssessments(), teacherID)
+	if err != nil {
+		return nil, gradesError(err)
+	}
+
+	return &pb.Assessments{Assessments: updatedAssessments}, nil
+}
+
+// Delete assessments by IDs (soft delete)
+func (s *Server) DeleteAssessments(ctx context.Context, req *pb.AssessmentIds) (*pb.DeleteAssessmentsConfirm, error) {
+
+	teacherID, err := gradeWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteAssessmentsDBHandler(ctx, req.GetAssessmentIds(), teacherID, deletedBy)
+	if err != nil {
+		return nil, gradesError(err)
+	}
+
+	return &pb.DeleteAssessmentsConfirm{
+		Status:     "Assessments successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// record the scores of many students for one assessment
+func (s *Server) RecordScores(ctx context.Context, req *pb.RecordScoresRequest) (*pb.Scores, error) {
+
+	teacherID, err := gradeWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAssessmentId() == "" || len(req.GetScores()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "assessment_id and scores are required")
+	}
+
+	gradedBy, _ := ctx.Value("uid").(string)
+
+	scores, err := repositories.RecordScoresDBHandler(ctx, req.GetAssessmentId(), req.GetScores(), teacherID, gradedBy)
+	if err != nil {
+		return nil, gradesError(err)
+	}
+
+	notify(ctx, scoreEvents(ctx, req.GetAssessmentId(), scores)...)
+
+	return &pb.Scores{Scores: scores}, nil
+}
+
+// get scores by assessment, course and/or student
+func (s *Server) GetScores(ctx context.Context, req *pb.GetScoresRequest) (*pb.Scores, error) {
+
+	// student accounts only read their own scores
+	if ownID, own := ownStudentID(ctx); own {
+		if req.GetStudentId() != "" && req.GetStudentId() != ownID {
+			return nil, status.Error(codes.PermissionDenied, "students can only read their own records")
+		}
+		req.StudentId = ownID
+	}
+
+	filter := bson.M{}
+	if req.GetAssessmentId() != "" {
+		filter["assessment_id"] = req.GetAssessmentId()
+	}
+	if req.GetCourseId() != "" {
+		filter["course_id"] = req.GetCourseId()
+	}
+	if req.GetStudentId() != "" {
+		filter["student_id"] = req.GetStudentId()
+	}
+	if len(filter) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "assessment_id, course_id or student_id is required")
+	}
+
+	// an assessment or course already belongs to one term, the scores of a student default to the current term
+	term := req.GetTerm()
+	if term == "" && req.GetAssessmentId() == "" && req.GetCourseId() == "" {
+		var err error
+		term, err = defaultTerm(ctx, term)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if term != "" {
+		filter["term"] = term
+	}
+
+	scores, err := repositories.GetScoresDBHandler(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.Scores{Scores: scores}, nil
+}
+
+// weighted term averages and letter grades of a course
+func (s *Server) GetCourseGrades(ctx context.Context, req *pb.CourseGradesRequest) (*pb.CourseGrades, error) {
+
+	if req.GetCourseId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id is required")
+	}
+
+	// student accounts only read their own grade
+	if ownID, own := ownStudentID(ctx); own {
+		if req.GetStudentId() != "" && req.GetStudentId() != ownID {
+			return nil, status.Error(codes.PermissionDenied, "students can only read their own records")
+		}
+		req.StudentId = ownID
+	}
+
+	grades, err := repositories.GetCourseGradesDBHandler(ctx, req.GetCourseId(), req.GetStudentId())
+	if err != nil {
+		return nil, gradesError(err)
+	}
+
+	return grades, nil
+}
+
+// gradeWriter authorizes grade writes. admins and managers may write for every course and get an empty teacher id,
+// teachers get the id of their teacher profile so the repository only lets them write for courses they teach.
+func gradeWriter(ctx context.Context) (string, error) {
+	err := utils.Authorization(ctx, "admin", "manager", "teacher")
+	if err != nil {
+		return "", status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	teacherID, teacher := ownTeacherID(ctx)
+	if !teacher {
+		return "", nil
+	}
+	if teacherID == "" {
+		return "", status.Error(codes.Unauthenticated, "uid missing from token")
+	}
+	return teacherID, nil
+}
+
+// gradesError maps grade write errors to grpc status codes
+func gradesError(err error) error {
+	if errors.Is(err, repositories.ErrNotCourseTeacher) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, repositories.ErrGrades) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return courseError(err)
+}
+
+// scoreEvents tells the students that a score of theirs was recorded
+func scoreEvents(ctx context.Context, assessmentID string, scores []*pb.Score) []repositories.NotificationEvent {
+	name := "an assessment"
+	objectID, err := primitive.ObjectIDFromHex(assessmentID)
+	if err == nil {
+		assessments, err := repositories.GetAssessmentsDBHandler(ctx, bson.M{"_id": objectID})
+		if err == nil && len(assessments) == 1 {
+			name = assessments[0].GetName()
+		}
+	}
+
+	events := make([]repositories.NotificationEvent, 0, len(scores))
+	for _, score := range scores {
+		events = append(events, repositories.NotificationEvent{
+			Event:     repositories.EventGradeRecorded,
+			Title:     "New grade",
+			Body:      fmt.Sprintf("Your score for %s was recorded: %g.", name, score.GetScore()),
+			SubjectId: score.GetId(),
+			UserIds:   []string{score.GetStudentId()},
+		})
+	}
+	return events
+}
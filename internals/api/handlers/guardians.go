@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add guardians
+func (s *Server) AddGuardians(ctx context.Context, req *pb.Guardians) (*pb.Guardians, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, guardian := range req.Guardians {
+		if guardian.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedGuardians, err := repositories.AddGuardiansDBHandler(ctx, req.GetGuardians())
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &pb.Guardians{Guardians: addedGuardians}, nil
+}
+
+// Get guardians with filter + sort
+func (s *Server) GetGuardians(ctx context.Context, req *pb.GetGuardianRequest) (*pb.Guardians, error) {
+
+	filter, err := buildfilter(req.Guardian, &models.Guardian{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted guardians are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "guardians")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	guardians, err := repositories.GetGuardiansDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Guardians{Guardians: guardians}, nil
+}
+
+// Update guardians
+func (s *Server) UpdateGuardians(ctx context.Context, req *pb.Guardians) (*pb.Guardians, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedGuardians, err := repositories.UpdateGuardiansDBHandler(ctx, req.GetGuardians())
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &pb.Guardians{Guardians: updatedGuardians}, nil
+}
+
+// Delete guardians by IDs (soft delete)
+func (s *Server) DeleteGuardians(ctx context.Context, req *pb.GuardianIds) (*pb.DeleteGuardiansConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteGuardiansDBHandler(ctx, req.GetGuardianIds(), deletedBy)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &pb.DeleteGuardiansConfirm{
+		Status:     "Guardians successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// Link a guardian to a student at an emergency contact priority
+func (s *Server) LinkGuardian(ctx context.Context, req *pb.GuardianLink) (*pb.GuardianLink, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetStudentId() == "" || req.GetGuardianId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id and guardian_id are required")
+	}
+
+	link, err := repositories.LinkGuardianDBHandler(ctx, req.GetStudentId(), req.GetGuardianId(), req.GetPriority())
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return link, nil
+}
+
+// Unlink a guardian from a student
+func (s *Server) UnlinkGuardian(ctx context.Context, req *pb.GuardianLink) (*pb.UnlinkGuardianConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetStudentId() == "" || req.GetGuardianId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id and guardian_id are required")
+	}
+
+	err = repositories.UnlinkGuardianDBHandler(ctx, req.GetStudentId(), req.GetGuardianId())
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &pb.UnlinkGuardianConfirm{Status: "Guardian successfully unlinked"}, nil
+}
+
+// missing students or guardians are the client's fault, everything else is internal
+func guardianError(err error) error {
+	if errors.Is(err, repositories.ErrGuardian) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"reflect"
+	"school_project_grpc/internals/repositories"
 	"school_project_grpc/pkg/utils"
 	"strings"
 
@@ -9,6 +12,8 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // basicly these functions are used to control the out put of the monogdb request
@@ -36,7 +41,7 @@ func buildfilter(Obj interface{}, model interface{}) (bson.M, error) {
 
 		if fieldval.IsValid() && !fieldval.IsZero() {
 			mfield := mval.FieldByName(fieldname)
-			if mfield.IsValid() && mfield.CanSet() {
+			if mfield.IsValid() && mfield.CanSet() && fieldval.Type().AssignableTo(mfield.Type()) {
 				mfield.Set(fieldval)
 			}
 		}
@@ -58,7 +63,7 @@ func buildfilter(Obj interface{}, model interface{}) (bson.M, error) {
 				}
 				filter["_id"] = objid
 			} else {
-				filter[bsonTag] = fieldval.Interface().(string)
+				filter[bsonTag] = fieldval.Interface()
 			}
 		}
 	}
@@ -66,17 +71,92 @@ func buildfilter(Obj interface{}, model interface{}) (bson.M, error) {
 	return filter, nil
 }
 
-// Build Mongo sort options from gRPC request
-func buildSortOptions(sortFields []*pb.SortField) bson.D {
+// Build the MongoDB sort of a list from the request, the fields are checked against the sortable fields of the
+// collection. Only sorts an index serves are taken: one field, optionally followed by id in the same direction. _id is
+// added last so equal values always come in the same order
+func buildSortOptions(sortFields []*pb.SortField, collection string) (bson.D, error) {
 	var sortOptions bson.D
+	sorted := map[string]bool{}
+	order := 1
 
 	for _, field := range sortFields {
-		order := 1
+		key, err := repositories.SortKey(collection, field.GetField())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if sorted[key] {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is sorted by twice", field.GetField())
+		}
+		// _id is unique, nothing sorts after it
+		if sorted["_id"] {
+			return nil, status.Errorf(codes.InvalidArgument, "id has to be the last sort field, %s comes after it", field.GetField())
+		}
+		if key != "_id" && len(sortOptions) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "lists are sorted by one field, %s can not be added", field.GetField())
+		}
+		sorted[key] = true
+
+		direction := 1
 		if field.GetOrder() == pb.Order_DESC {
-			order = -1
+			direction = -1
+		}
+		if key == "_id" && len(sortOptions) > 0 && direction != order {
+			return nil, status.Error(codes.InvalidArgument, "id has to be sorted in the direction of the field before it")
 		}
-		sortOptions = append(sortOptions, bson.E{Key: field.Field, Value: order})
+		order = direction
+		sortOptions = append(sortOptions, bson.E{Key: key, Value: order})
+	}
+
+	// _id follows the direction of the last key so the sort index of that key serves it in either direction
+	if !sorted["_id"] {
+		sortOptions = append(sortOptions, bson.E{Key: "_id", Value: order})
+	}
+	return sortOptions, nil
+}
+
+// Hide soft deleted documents unless an admin asks for them with include_deleted
+func applySoftDeleteFilter(ctx context.Context, filter bson.M, includeDeleted bool) error {
+	if !includeDeleted {
+		filter["deleted_at"] = nil
+		return nil
+	}
+
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "only admins can view deleted records")
+	}
+	return nil
+}
+
+// watchError maps watches that can not be resumed to FailedPrecondition, the client starts a new watch
+func watchError(err error) error {
+	if errors.Is(err, repositories.ErrWatch) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// lists return at most maxPageSize records per page, whatever page_size asks for
+const maxPageSize = 100
+
+// pageRequest is the page a list request asks for, 10 records when page_size is 0
+func pageRequest(pageSize, pageNumber uint32, pageToken string, withTotal bool) repositories.PageRequest {
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
 	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return repositories.PageRequest{Size: pageSize, Number: pageNumber, Token: pageToken, WithTotal: withTotal}
+}
 
-	return sortOptions
+// pageError maps page tokens the server can not use to InvalidArgument
+func pageError(err error) error {
+	if errors.Is(err, repositories.ErrPageToken) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
 }
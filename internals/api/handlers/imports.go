@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rows one import may send, bigger files have to be split
+const maxImportRows = 10000
+
+// Import students from a file streamed row by row, the report has the result of every row
+func (s *Server) ImportStudents(stream pb.StudentsService_ImportStudentsServer) error {
+
+	// authorization
+	err := utils.Authorization(stream.Context(), "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	dryRun, rows, rejected, err := receiveImportRows(stream, func() *pb.Student { return &pb.Student{} })
+	if err != nil {
+		return err
+	}
+
+	results, err := repositories.ImportStudentsDBHandler(stream.Context(), rows, dryRun)
+	if err != nil {
+		return studentError(err)
+	}
+
+	return stream.SendAndClose(importReport(dryRun, append(results, rejected...)))
+}
+
+// Import teachers from a file streamed row by row, the report has the result of every row
+func (s *Server) ImportTeachers(stream pb.TeachersService_ImportTeachersServer) error {
+
+	// authorization
+	err := utils.Authorization(stream.Context(), "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	dryRun, rows, rejected, err := receiveImportRows(stream, func() *pb.Teacher { return &pb.Teacher{} })
+	if err != nil {
+		return err
+	}
+
+	results, err := repositories.ImportTeachersDBHandler(stream.Context(), rows, dryRun)
+	if err != nil {
+		return teacherError(err)
+	}
+
+	return stream.SendAndClose(importReport(dryRun, append(results, rejected...)))
+}
+
+// receiveImportRows reads the rows of an import until the client closes the stream. Rows that can not be turned into
+// a valid entity are returned as rejected results, the others as rows for the repository
+func receiveImportRows[T interface {
+	protoreflect.ProtoMessage
+	Validate() error
+}](stream grpc.ClientStreamingServer[pb.ImportRequest, pb.ImportReport], newEntity func() T) (bool, []repositories.ImportRow[T], []*pb.ImportRowResult, error) {
+
+	var (
+		dryRun   bool
+		mapping  map[string]string
+		rows     []repositories.ImportRow[T]
+		rejected []*pb.ImportRowResult
+		received uint32
+	)
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return false, nil, nil, err
+		}
+
+		// the options of the import come with the first row
+		if received == 0 {
+			dryRun = req.GetDryRun()
+			mapping = req.GetColumnMapping()
+		}
+		received++
+
+		if received > maxImportRows {
+			return false, nil, nil, status.Errorf(codes.InvalidArgument, "an import can have at most %d rows", maxImportRows)
+		}
+
+		number := req.GetRowNumber()
+		if number == 0 {
+			number = received
+		}
+
+		entity := newEntity()
+		err = rowToEntity(req.GetRow(), mapping, entity)
+		if err == nil {
+			err = entity.Validate()
+		}
+		if err != nil {
+			rejected = append(rejected, &pb.ImportRowResult{
+				RowNumber: number,
+				Status:    repositories.ImportInvalid,
+				Email:     req.GetRow()[importColumn(mapping, "email")],
+				Error:     err.Error(),
+			})
+			continue
+		}
+
+		rows = append(rows, repositories.ImportRow[T]{Number: number, Entity: entity})
+	}
+
+	if received == 0 {
+		return false, nil, nil, status.Error(codes.InvalidArgument, "the import has no rows")
+	}
+	return dryRun, rows, rejected, nil
+}
+
+// rowToEntity sets the fields of the entity from the columns of the row. A column names a field by its proto or json
+// name unless the mapping renames it, columns mapped to "-" are left out. Only text fields can be imported and the id
+// is always given by the server
+func rowToEntity(row, mapping map[string]string, entity protoreflect.ProtoMessage) error {
+	message := entity.ProtoReflect()
+	fields := message.Descriptor().Fields()
+
+	for column, value := range row {
+		name := strings.TrimSpace(column)
+		if mapped, ok := mapping[column]; ok {
+			name = mapped
+		}
+		if name == "-" {
+			continue
+		}
+
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			field = fields.ByJSONName(name)
+		}
+		if field == nil || field.Kind() != protoreflect.StringKind || field.Cardinality() == protoreflect.Repeated {
+			return fmt.Errorf("column %q is not a field that can be imported", column)
+		}
+		value = strings.TrimSpace(value)
+		if field.Name() == "id" && value != "" {
+			return fmt.Errorf("column %q: non-empty ID fields are not allowed", column)
+		}
+
+		message.Set(field, protoreflect.ValueOfString(value))
+	}
+	return nil
+}
+
+// importColumn is the column of the file that holds the field
+func importColumn(mapping map[string]string, field string) string {
+	for column, mapped := range mapping {
+		if mapped == field {
+			return column
+		}
+	}
+	return field
+}
+
+// importReport counts the results and puts them in the order of the file
+func importReport(dryRun bool, results []*pb.ImportRowResult) *pb.ImportReport {
+	slices.SortStableFunc(results, func(a, b *pb.ImportRowResult) int {
+		return int(a.RowNumber) - int(b.RowNumber)
+	})
+
+	report := &pb.ImportReport{DryRun: dryRun, Total: uint32(len(results)), Rows: results}
+	for _, result := range results {
+		switch result.Status {
+		case repositories.ImportImported:
+			report.Imported++
+		case repositories.ImportValid:
+			report.Valid++
+		default:
+			report.Rejected++
+		}
+	}
+	return report
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// list the notifications in the inbox of the caller, newest first
+func (s *Server) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.Notifications, error) {
+	uid, _ := ctx.Value("uid").(string)
+
+	pageNumber := req.GetPageNumber()
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	pageSize := req.GetPageSize()
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	notifications, err := repositories.ListNotificationsDBHandler(ctx, uid, req.GetUnreadOnly(), req.GetEvent(), pageSize, pageNumber)
+	if err != nil {
+		return nil, notificationError(err)
+	}
+
+	return notifications, nil
+}
+
+// mark notifications of the caller as read, all of them when no ids are given
+func (s *Server) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	uid, _ := ctx.Value("uid").(string)
+
+	marked, unread, err := repositories.MarkReadDBHandler(ctx, uid, req.GetIds())
+	if err != nil {
+		return nil, notificationError(err)
+	}
+
+	return &pb.MarkReadResponse{Marked: marked, UnreadCount: unread}, nil
+}
+
+// stream the new in_app notifications of the caller until the client goes away
+func (s *Server) SubscribeNotifications(req *pb.SubscribeNotificationsRequest, stream pb.NotificationService_SubscribeNotificationsServer) error {
+	ctx := stream.Context()
+	uid, _ := ctx.Value("uid").(string)
+	if uid == "" {
+		return status.Error(codes.Unauthenticated, "Unauthorized Access")
+	}
+
+	// subscribing first means nothing stored while the unread ones are sent gets lost, it can be sent twice
+	notifications, unsubscribe := repositories.SubscribeNotifications(uid)
+	defer unsubscribe()
+
+	if req.GetIncludeUnread() {
+		unread, err := repositories.ListNotificationsDBHandler(ctx, uid, true, "", 100, 1)
+		if err != nil {
+			return notificationError(err)
+		}
+		// oldest first, like the ones that follow
+		for _, notification := range slices.Backward(unread.GetNotifications()) {
+			err = stream.Send(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-notifications:
+			err := stream.Send(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// get the notification channels of the caller
+func (s *Server) GetNotificationPreferences(ctx context.Context, req *pb.EmptyRequest) (*pb.NotificationPreferences, error) {
+	uid, _ := ctx.Value("uid").(string)
+
+	preferences, err := repositories.GetNotificationPreferencesDBHandler(ctx, uid)
+	if err != nil {
+		return nil, notificationError(err)
+	}
+
+	return preferences, nil
+}
+
+// replace the notification channels of the caller
+func (s *Server) UpdateNotificationPreferences(ctx context.Context, req *pb.NotificationPreferences) (*pb.NotificationPreferences, error) {
+	uid, _ := ctx.Value("uid").(string)
+
+	// the server posts to the webhook from inside its own network, so only admins and managers can name one
+	if req.GetWebhookUrl() != "" {
+		err := utils.Authorization(ctx, "admin", "manager")
+		if err != nil {
+			return nil, status.Error(codes.PermissionDenied, "only admins and managers can set a webhook_url")
+		}
+	}
+
+	preferences, err := repositories.UpdateNotificationPreferencesDBHandler(ctx, uid, req)
+	if err != nil {
+		return nil, notificationError(err)
+	}
+
+	return preferences, nil
+}
+
+// notify delivers the events of a successful rpc, a failure is logged and does not fail the rpc
+func notify(ctx context.Context, events ...repositories.NotificationEvent) {
+	err := repositories.NotifyDBHandler(ctx, events)
+	if err != nil {
+		log.Println("Failed to deliver notifications: ", err)
+	}
+}
+
+func notificationError(err error) error {
+	if errors.Is(err, repositories.ErrNotification) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
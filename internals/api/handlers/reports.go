@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"school_project_grpc/internals/reports"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// render the report card of a student for a term
+func (s *Server) GenerateReportCard(ctx context.Context, req *pb.ReportCardRequest) (*pb.ReportDocument, error) {
+
+	// authorization, students can render their own
+	err := utils.Authorization(ctx, "admin", "manager", "student")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetStudentId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id is required")
+	}
+	err = checkOwnStudent(ctx, req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	term, fromDate, toDate, err := reportPeriod(ctx, req.GetTerm(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := repositories.GetReportCardDBHandler(ctx, req.GetStudentId(), term, fromDate, toDate)
+	if err != nil {
+		return nil, reportsError(err)
+	}
+
+	document, err := reports.RenderReportCard(card, req.GetFormat())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return toReportDocument(document), nil
+}
+
+// render the report cards of a whole class as one zip
+func (s *Server) GenerateClassReportCards(ctx context.Context, req *pb.ClassReportCardsRequest) (*pb.ReportDocument, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetClassId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "class_id is required")
+	}
+
+	term, fromDate, toDate, err := reportPeriod(ctx, req.GetTerm(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+
+	className, cards, err := repositories.GetClassReportCardsDBHandler(ctx, req.GetClassId(), term, fromDate, toDate)
+	if err != nil {
+		return nil, reportsError(err)
+	}
+
+	documents := make([]*reports.Document, 0, len(cards))
+	for _, card := range cards {
+		document, err := reports.RenderReportCard(card, req.GetFormat())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		documents = append(documents, document)
+	}
+
+	archive, err := reports.Zip(fmt.Sprintf("report_cards_%s_%s.zip", className, term), documents)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return toReportDocument(archive), nil
+}
+
+// render the cumulative transcript of a student
+func (s *Server) GenerateTranscript(ctx context.Context, req *pb.TranscriptRequest) (*pb.ReportDocument, error) {
+
+	// authorization, students can render their own
+	err := utils.Authorization(ctx, "admin", "manager", "student")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetStudentId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id is required")
+	}
+	err = checkOwnStudent(ctx, req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	transcript, err := repositories.GetTranscriptDBHandler(ctx, req.GetStudentId())
+	if err != nil {
+		return nil, reportsError(err)
+	}
+
+	document, err := reports.RenderTranscript(transcript, req.GetFormat())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return toReportDocument(document), nil
+}
+
+// write the comment printed on a report card, teachers only for their courses or their own class
+func (s *Server) SetReportCardComment(ctx context.Context, req *pb.ReportCardComment) (*pb.ReportCardComment, error) {
+
+	teacherID, err := gradeWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authorID, _ := ctx.Value("uid").(string)
+
+	comment, err := repositories.SetReportCardCommentDBHandler(ctx, req, teacherID, authorID)
+	if err != nil {
+		return nil, reportsError(err)
+	}
+
+	return comment, nil
+}
+
+// reportPeriod defaults the term of a report card to the current term and the attendance dates to the dates of the term
+func reportPeriod(ctx context.Context, term, fromDate, toDate string) (string, string, string, error) {
+	term, err := defaultTerm(ctx, term)
+	if err != nil {
+		return "", "", "", err
+	}
+	if term == "" {
+		return "", "", "", status.Error(codes.InvalidArgument, "term is required while no term has started")
+	}
+
+	// terms from before the academic calendar are only known by name and keep the open date range
+	calendarTerm, err := repositories.FindTermDBHandler(ctx, term)
+	if err != nil {
+		if errors.Is(err, repositories.ErrCalendar) {
+			return term, fromDate, toDate, nil
+		}
+		return "", "", "", status.Error(codes.Internal, err.Error())
+	}
+	if fromDate == "" {
+		fromDate = calendarTerm.StartDate
+	}
+	if toDate == "" {
+		toDate = calendarTerm.EndDate
+	}
+	return term, fromDate, toDate, nil
+}
+
+func toReportDocument(document *reports.Document) *pb.ReportDocument {
+	return &pb.ReportDocument{
+		FileName:    document.FileName,
+		ContentType: document.ContentType,
+		Content:     document.Content,
+	}
+}
+
+// reportsError maps report errors to grpc status codes
+func reportsError(err error) error {
+	if errors.Is(err, repositories.ErrReports) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return gradesError(err)
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"school_project_grpc/internals/repositories"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ownStudentID returns the student id of a student account, student accounts only read their own records
+func ownStudentID(ctx context.Context) (string, bool) {
+	if role, _ := ctx.Value("role").(string); role != repositories.RoleStudent {
+		return "", false
+	}
+	uid, _ := ctx.Value("uid").(string)
+	return uid, true
+}
+
+// ownTeacherID returns the teacher id of a teacher account, the uid of its token is the TokenSubject of the account
+func ownTeacherID(ctx context.Context) (string, bool) {
+	if role, _ := ctx.Value("role").(string); role != repositories.RoleTeacher {
+		return "", false
+	}
+	uid, _ := ctx.Value("uid").(string)
+	return uid, true
+}
+
+// checkOwnStudent refuses a student account asking for the records of another student
+func checkOwnStudent(ctx context.Context, studentID string) error {
+	own, ok := ownStudentID(ctx)
+	if ok && own != studentID {
+		return status.Error(codes.PermissionDenied, "students can only read their own records")
+	}
+	return nil
+}
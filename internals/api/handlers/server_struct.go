@@ -10,4 +10,16 @@ type Server struct {
 	pb.UnimplementedExecsServiceServer
 	pb.UnimplementedStudentsServiceServer
 	pb.UnimplementedTeachersServiceServer
+	pb.UnimplementedClassesServiceServer
+	pb.UnimplementedCoursesServiceServer
+	pb.UnimplementedEnrollmentServiceServer
+	pb.UnimplementedAttendanceServiceServer
+	pb.UnimplementedGradesServiceServer
+	pb.UnimplementedReportsServiceServer
+	pb.UnimplementedAcademicCalendarServiceServer
+	pb.UnimplementedTimetableServiceServer
+	pb.UnimplementedGuardiansServiceServer
+	pb.UnimplementedNotificationServiceServer
+	pb.UnimplementedAnnouncementsServiceServer
+	pb.UnimplementedWebhooksServiceServer
 }
@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"school_project_grpc/internals/filterexpr"
 	"school_project_grpc/internals/models"
 	"school_project_grpc/internals/repositories"
 	"school_project_grpc/pkg/utils"
 	pb "school_project_grpc/proto/gen"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,9 +26,14 @@ func (s *Server) AddStudents(ctx context.Context, req *pb.Students) (*pb.Student
 		}
 	}
 
+	err := checkMedicalNotesAccess(ctx, req.GetStudents())
+	if err != nil {
+		return nil, err
+	}
+
 	addedStudent, err := repositories.AddStudentsDBHandler(ctx, req.GetStudents())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, studentError(err)
 	}
 
 	return &pb.Students{Students: addedStudent}, nil
@@ -32,48 +41,75 @@ func (s *Server) AddStudents(ctx context.Context, req *pb.Students) (*pb.Student
 
 func (s *Server) GetStudents(ctx context.Context, req *pb.GetStudentRequset) (*pb.Students, error) {
 
-	// build filters
-	filter, err := buildfilter(req.Student, &models.Student{})
+	filter, memberships, err := studentQuery(ctx, req)
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
+		return nil, err
 	}
 
 	// build sortoptions
-	sortOptions := buildSortOptions(req.GetSortBy())
+	sortOptions, err := buildSortOptions(req.GetSortBy(), "students")
+	if err != nil {
+		return nil, err
+	}
 
 	// fetch data from data base
+	page := pageRequest(req.GetPageSize(), req.GetPageNum(), req.GetPageToken(), req.GetIncludeTotalSize())
 
-	pageNumber := req.GetPageNum()
-	pageSize := req.GetPageSize()
-
-	if pageNumber < 1 {
-		pageNumber = 1
+	students, pageInfo, err := repositories.GetStudentsDBHandler(ctx, sortOptions, filter, page)
+	if err != nil {
+		return nil, pageError(err)
 	}
-	if pageSize < 1 {
-		pageSize = 10
+	if memberships != nil {
+		for _, student := range students {
+			student.ClassId = memberships[student.Id].ClassId
+			student.Class = memberships[student.Id].ClassName
+		}
 	}
 
-	students, err := repositories.GetStudentsDBHandler(ctx, sortOptions, filter, pageSize, pageNumber)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if !canReadMedicalNotes(ctx) {
+		for _, student := range students {
+			student.MedicalNotes = ""
+		}
 	}
 
-	return &pb.Students{Students: students}, nil
+	if req.GetIncludeGuardians() && len(students) > 0 {
+		studentIDs := make([]string, 0, len(students))
+		for _, student := range students {
+			studentIDs = append(studentIDs, student.Id)
+		}
+		guardians, err := repositories.StudentGuardiansDBHandler(ctx, studentIDs)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, student := range students {
+			student.Guardians = guardians[student.Id]
+		}
+	}
+
+	return &pb.Students{Students: students, NextPageToken: pageInfo.NextPageToken, TotalSize: pageInfo.TotalSize}, nil
 }
 
 func (s *Server) UpdateStudents(ctx context.Context, req *pb.Students) (*pb.Students, error) {
+	err := checkMedicalNotesAccess(ctx, req.GetStudents())
+	if err != nil {
+		return nil, err
+	}
+
 	students, err := repositories.UpdateStudentsDBHandler(ctx, req.Students)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, studentError(err)
 	}
 
 	return &pb.Students{Students: students}, nil
 }
 
-// Delete teachers by IDs
+// Delete students by IDs (soft delete)
 func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.DeleteStudentsConfirm, error) {
 
-	deletedIds, err := repositories.DeleteStudentsDBHandler(ctx, req.GetStudentIds())
+	// the user who deleted the records is kept so the deletion can be traced
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteStudentsDBHandler(ctx, req.GetStudentIds(), deletedBy)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -83,3 +119,137 @@ func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.De
 		DeletedIds: deletedIds,
 	}, nil
 }
+
+// Restore soft deleted students by IDs
+func (s *Server) RestoreStudents(ctx context.Context, req *pb.StudentIds) (*pb.RestoreStudentsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	restoredIds, err := repositories.RestoreStudentsDBHandler(ctx, req.GetStudentIds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.RestoreStudentsConfirm{
+		Status:      "Students successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
+// studentQuery builds the filter of a GetStudents request, the memberships are those of the term asked for or nil
+// for the current term
+func studentQuery(ctx context.Context, req *pb.GetStudentRequset) (bson.M, map[string]models.ClassMembership, error) {
+
+	// build filters
+	filter, err := buildfilter(req.Student, &models.Student{})
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	// soft deleted students are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// medical notes can not be searched by users who can not read them
+	filterFields := studentFilterFieldsWithNotes
+	if !canReadMedicalNotes(ctx) {
+		delete(filter, "medical_notes")
+		filterFields = studentFilterFields
+	}
+
+	err = applyFilterExpression(filter, req.GetFilter(), filterFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// student accounts only see themselves
+	ownID, own := ownStudentID(ctx)
+	if own {
+		objectID, err := primitive.ObjectIDFromHex(ownID)
+		if err != nil {
+			return nil, nil, status.Error(codes.Unauthenticated, "invalid uid in token")
+		}
+		filter["_id"] = objectID
+	}
+
+	// students of an earlier term are listed with the class they had then
+	memberships, err := scopeToTerm(ctx, filter, repositories.MemberStudent, req.GetTerm())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// only active students are listed unless a status is asked for, an earlier term lists the students
+	// of that term whatever they are now. students from before the profile migration have no status yet
+	statusAsked := req.GetStudent().GetEnrollmentStatus() != "" || filterexpr.Mentions(req.GetFilter(), "enrollment_status") ||
+		filterexpr.Mentions(req.GetFilter(), "graduated_at")
+	if !statusAsked && memberships == nil && !own {
+		filter["enrollment_status"] = bson.M{"$in": bson.A{repositories.StudentActive, nil}}
+		filter["graduated_at"] = nil
+	}
+
+	return filter, memberships, nil
+}
+
+// medical notes are only read and written by admins and managers
+func canReadMedicalNotes(ctx context.Context) bool {
+	return utils.Authorization(ctx, "admin", "manager") == nil
+}
+
+func checkMedicalNotesAccess(ctx context.Context, students []*pb.Student) error {
+	if canReadMedicalNotes(ctx) {
+		return nil
+	}
+	for _, student := range students {
+		if student.GetMedicalNotes() != "" {
+			return status.Error(codes.PermissionDenied, "only admins and managers can write medical notes")
+		}
+	}
+	return nil
+}
+
+// invalid profiles and missing classes are the client's fault, everything else is internal
+func studentError(err error) error {
+	if errors.Is(err, repositories.ErrClassIntegrity) || errors.Is(err, repositories.ErrStudent) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Watch students, the changes of the students matching the filter are streamed until the client disconnects
+func (s *Server) WatchStudents(req *pb.WatchStudentsRequest, stream pb.StudentsService_WatchStudentsServer) error {
+	ctx := stream.Context()
+
+	// authorization, the stream is not narrowed to the records of an account like GetStudents
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	filter, err := buildfilter(req.Student, &models.Student{})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	// medical notes can not be searched by users who can not read them
+	readMedicalNotes := canReadMedicalNotes(ctx)
+	if !readMedicalNotes {
+		delete(filter, "medical_notes")
+	}
+
+	err = repositories.WatchStudentsDBHandler(ctx, filter, req.GetResumeToken(), func(event *pb.StudentEvent) error {
+		if !readMedicalNotes {
+			event.Student.MedicalNotes = ""
+		}
+		return stream.Send(event)
+	})
+	if err != nil {
+		return watchError(err)
+	}
+	return nil
+}
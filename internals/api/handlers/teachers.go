@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"context"
+	"errors"
 
 	"school_project_grpc/internals/models"
 	"school_project_grpc/internals/repositories"
 	"school_project_grpc/pkg/utils"
 	pb "school_project_grpc/proto/gen"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,7 +27,7 @@ func (s *Server) AddTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teacher
 
 	addedTeacher, err := repositories.AddTeachersDBHandler(ctx, req.GetTeachers())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, teacherError(err)
 	}
 
 	return &pb.Teachers{Teachers: addedTeacher}, nil
@@ -34,45 +36,93 @@ func (s *Server) AddTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teacher
 // Get teachers with filter + sort
 func (s *Server) GetTeachers(ctx context.Context, req *pb.GetTeacherRequset) (*pb.Teachers, error) {
 
-	// Build Mongo filter from request
-	filter, err := buildfilter(req.Teacher, &models.Teacher{})
+	filter, memberships, err := teacherQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	term, err := defaultTerm(ctx, req.GetTerm())
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal err")
+		return nil, err
 	}
 
 	// Build sort options from request
-	sortOption := buildSortOptions(req.GetSortBy())
+	sortOption, err := buildSortOptions(req.GetSortBy(), "teachers")
+	if err != nil {
+		return nil, err
+	}
 
-	pageNumber := req.GetPageNum()
-	pageSize := req.GetPageSize()
+	page := pageRequest(req.GetPageSize(), req.GetPageNum(), req.GetPageToken(), req.GetIncludeTotalSize())
 
-	if pageNumber < 1 {
-		pageNumber = 1
+	// Fetch from database
+	teachers, pageInfo, err := repositories.GetTeachersDBhandler(ctx, sortOption, filter, page)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if pageSize < 1 {
-		pageSize = 10
+
+	if memberships != nil {
+		for _, teacher := range teachers {
+			teacher.ClassId = memberships[teacher.Id].ClassId
+			teacher.Class = memberships[teacher.Id].ClassName
+		}
 	}
 
-	// Fetch from database
-	teachers, err := repositories.GetTeachersDBhandler(ctx, sortOption, filter, pageSize, pageNumber)
+	// attaching the courses every teacher teaches in the term
+	teacherIDs := make([]string, 0, len(teachers))
+	for _, teacher := range teachers {
+		teacherIDs = append(teacherIDs, teacher.Id)
+	}
+	assignments, err := repositories.GetCourseAssignmentsDBHandler(ctx, teacherIDs, term)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, teacher := range teachers {
+		teacher.Courses = assignments[teacher.Id]
 	}
 
-	return &pb.Teachers{Teachers: teachers}, nil
+	return &pb.Teachers{Teachers: teachers, NextPageToken: pageInfo.NextPageToken, TotalSize: pageInfo.TotalSize}, nil
+}
+
+// teacherQuery builds the filter of a GetTeachers request, the memberships are those of the term asked for or nil
+// for the current term
+func teacherQuery(ctx context.Context, req *pb.GetTeacherRequset) (bson.M, map[string]models.ClassMembership, error) {
+
+	// Build Mongo filter from request
+	filter, err := buildfilter(req.Teacher, &models.Teacher{})
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	err = applyFilterExpression(filter, req.GetFilter(), teacherFilterFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// soft deleted teachers are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// teachers of an earlier term are listed with the class and the courses they had then
+	memberships, err := scopeToTerm(ctx, filter, repositories.MemberTeacher, req.GetTerm())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return filter, memberships, nil
 }
 
 // Update teachers
 func (s *Server) UpdateTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teachers, error) {
 	updatedTeachers, err := repositories.UpdateTeachersDBHandler(ctx, req.Teachers)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, teacherError(err)
 	}
 
 	return &pb.Teachers{Teachers: updatedTeachers}, nil
 }
 
-// Delete teachers by IDs
+// Delete teachers by IDs (soft delete)
 func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.DeleteTeacherConfirm, error) {
 
 	ids := req.TeacherIds
@@ -83,8 +133,14 @@ func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.De
 		teacherIDsTODelete = append(teacherIDsTODelete, v.Id)
 	}
 
-	deletedIds, err := repositories.DeleteTeachersDBHandler(ctx, teacherIDsTODelete)
+	// the user who deleted the records is kept so the deletion can be traced
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteTeachersDBHandler(ctx, teacherIDsTODelete, deletedBy, req.GetReassignTo(), req.GetForce())
 	if err != nil {
+		if errors.Is(err, repositories.ErrClassIntegrity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -94,6 +150,31 @@ func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.De
 	}, nil
 }
 
+// Restore soft deleted teachers by IDs
+func (s *Server) RestoreTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.RestoreTeacherConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	var teacherIDsToRestore []string
+	for _, v := range req.GetTeacherIds() {
+		teacherIDsToRestore = append(teacherIDsToRestore, v.Id)
+	}
+
+	restoredIds, err := repositories.RestoreTeachersDBHandler(ctx, teacherIDsToRestore)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.RestoreTeacherConfirm{
+		Status:      "Teacher successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
 // get students that are asigned to a spacific id
 func (s *Server) GetStudentsByClassTeacher(ctx context.Context, req *pb.TeacherId) (*pb.Students, error) {
 
@@ -121,3 +202,57 @@ func (s *Server) GetStudentCountByClassTeacher(ctx context.Context, req *pb.Teac
 		StudentCount: int32(count),
 	}, nil
 }
+
+// move the students of a class and their class teacher to another class in one step
+func (s *Server) ReassignClass(ctx context.Context, req *pb.ReassignClassRequest) (*pb.ReassignClassResponse, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	if req.GetFromClass() == "" || req.GetToClass() == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_class and to_class are required")
+	}
+
+	moved, teacherID, err := repositories.ReassignClassDBHandler(ctx, req.GetFromClass(), req.GetToClass(), req.GetTeacherId())
+	if err != nil {
+		return nil, teacherError(err)
+	}
+
+	return &pb.ReassignClassResponse{
+		Status:        true,
+		StudentsMoved: int32(moved),
+		TeacherId:     teacherID,
+	}, nil
+}
+
+// classes that are missing or already have a homeroom teacher are the client's fault, everything else is internal
+func teacherError(err error) error {
+	if errors.Is(err, repositories.ErrClassIntegrity) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Watch teachers, the changes of the teachers matching the filter are streamed until the client disconnects
+func (s *Server) WatchTeachers(req *pb.WatchTeachersRequest, stream pb.TeachersService_WatchTeachersServer) error {
+
+	// authorization, the stream is not narrowed to the records of an account like GetTeachers
+	err := utils.Authorization(stream.Context(), "admin", "manager")
+	if err != nil {
+		return status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	filter, err := buildfilter(req.Teacher, &models.Teacher{})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal err")
+	}
+
+	err = repositories.WatchTeachersDBHandler(stream.Context(), filter, req.GetResumeToken(), stream.Send)
+	if err != nil {
+		return watchError(err)
+	}
+	return nil
+}
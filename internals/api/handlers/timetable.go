@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/ical"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add sessions to the timetable
+func (s *Server) AddSessions(ctx context.Context, req *pb.Sessions) (*pb.Sessions, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, session := range req.Sessions {
+		if session.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	addedSessions, err := repositories.AddSessionsDBHandler(ctx, req.GetSessions())
+	if err != nil {
+		return nil, timetableError(err)
+	}
+
+	return &pb.Sessions{Sessions: addedSessions}, nil
+}
+
+// Get sessions with filter + sort
+func (s *Server) GetSessions(ctx context.Context, req *pb.GetSessionRequest) (*pb.Sessions, error) {
+
+	filter, err := buildfilter(req.Session, &models.Session{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted sessions are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	// sessions of the current term unless another term is asked for
+	term, err := defaultTerm(ctx, req.GetSession().GetTerm())
+	if err != nil {
+		return nil, err
+	}
+	if term != "" {
+		filter["term"] = term
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "sessions")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	sessions, err := repositories.GetSessionsDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.Sessions{Sessions: sessions}, nil
+}
+
+// Update sessions
+func (s *Server) UpdateSessions(ctx context.Context, req *pb.Sessions) (*pb.Sessions, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedSessions, err := repositories.UpdateSessionsDBHandler(ctx, req.GetSessions())
+	if err != nil {
+		return nil, timetableError(err)
+	}
+
+	return &pb.Sessions{Sessions: updatedSessions}, nil
+}
+
+// Delete sessions by IDs (soft delete)
+func (s *Server) DeleteSessions(ctx context.Context, req *pb.SessionIds) (*pb.DeleteSessionsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin", "manager")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteSessionsDBHandler(ctx, req.GetSessionIds(), deletedBy)
+	if err != nil {
+		return nil, timetableError(err)
+	}
+
+	return &pb.DeleteSessionsConfirm{
+		Status:     "Sessions successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// the week of a teacher
+func (s *Server) GetTeacherWeek(ctx context.Context, req *pb.WeekRequest) (*pb.Week, error) {
+	return week(ctx, "teacher_id", req)
+}
+
+// the week of a class
+func (s *Server) GetClassWeek(ctx context.Context, req *pb.WeekRequest) (*pb.Week, error) {
+	return week(ctx, "class_id", req)
+}
+
+// rooms that are free at a time of the week
+func (s *Server) GetFreeRooms(ctx context.Context, req *pb.FreeRoomsRequest) (*pb.FreeRooms, error) {
+
+	if req.GetDay() < 1 || req.GetDay() > 7 {
+		return nil, status.Error(codes.InvalidArgument, "day has to be 1 (monday) to 7 (sunday)")
+	}
+
+	term, err := timetableTerm(ctx, req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := repositories.FreeRoomsDBHandler(ctx, term, req.GetDay(), req.GetPeriod(), req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return nil, timetableError(err)
+	}
+
+	return &pb.FreeRooms{Rooms: rooms}, nil
+}
+
+// export the timetable of a teacher, class or room as an iCalendar file
+func (s *Server) ExportCalendar(ctx context.Context, req *pb.CalendarExportRequest) (*pb.CalendarFile, error) {
+
+	var key, value string
+	given := 0
+	if req.GetTeacherId() != "" {
+		key, value = "teacher_id", req.GetTeacherId()
+		given++
+	}
+	if req.GetClassId() != "" {
+		key, value = "class_id", req.GetClassId()
+		given++
+	}
+	if req.GetRoom() != "" {
+		key, value = "room", req.GetRoom()
+		given++
+	}
+	if given != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of teacher_id, class_id or room is required")
+	}
+
+	term, err := timetableTerm(ctx, req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	fileName, content, err := repositories.CalendarDBHandler(ctx, key, value, term)
+	if err != nil {
+		return nil, timetableError(err)
+	}
+
+	return &pb.CalendarFile{
+		FileName:    fileName,
+		ContentType: ical.ContentType,
+		Content:     content,
+	}, nil
+}
+
+func week(ctx context.Context, key string, req *pb.WeekRequest) (*pb.Week, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	term, err := timetableTerm(ctx, req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	week, err := repositories.WeekDBHandler(ctx, key, req.GetId(), term)
+	if err != nil {
+		return nil, timetableError(err)
+	}
+
+	return week, nil
+}
+
+// timetableTerm is the term a timetable query reads, the current term when none is given
+func timetableTerm(ctx context.Context, term string) (string, error) {
+	term, err := defaultTerm(ctx, term)
+	if err != nil {
+		return "", err
+	}
+	if term == "" {
+		return "", status.Error(codes.InvalidArgument, "term is required while no term has started")
+	}
+	return term, nil
+}
+
+// conflicting sessions are the client's fault, everything else is internal
+func timetableError(err error) error {
+	if errors.Is(err, repositories.ErrTimetable) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
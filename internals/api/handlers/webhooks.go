@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Add webhook endpoints, the response carries the secret of each endpoint
+func (s *Server) AddWebhookEndpoints(ctx context.Context, req *pb.WebhookEndpoints) (*pb.WebhookEndpoints, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// Validate: ID must be empty on create
+	for _, endpoint := range req.Endpoints {
+		if endpoint.Id != "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"request is incorrect format: non-empty ID fields are not allowed.")
+		}
+	}
+
+	createdBy, _ := ctx.Value("uid").(string)
+
+	addedEndpoints, err := repositories.AddWebhookEndpointsDBHandler(ctx, req.GetEndpoints(), createdBy)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return &pb.WebhookEndpoints{Endpoints: addedEndpoints}, nil
+}
+
+// Get webhook endpoints with filter + sort, secrets are never returned
+func (s *Server) GetWebhookEndpoints(ctx context.Context, req *pb.GetWebhookEndpointsRequest) (*pb.WebhookEndpoints, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	// the secret can not be guessed through the filter
+	if req.Endpoint != nil {
+		req.Endpoint.Secret = ""
+	}
+
+	filter, err := buildfilter(req.Endpoint, &models.WebhookEndpoint{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal err")
+	}
+
+	// soft deleted endpoints are excluded by default
+	err = applySoftDeleteFilter(ctx, filter, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOption, err := buildSortOptions(req.GetSortBy(), "webhook_endpoints")
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	endpoints, err := repositories.GetWebhookEndpointsDBHandler(ctx, sortOption, filter, pageSize, pageNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.WebhookEndpoints{Endpoints: endpoints}, nil
+}
+
+// Update webhook endpoints, events given in the request replace the subscribed events
+func (s *Server) UpdateWebhookEndpoints(ctx context.Context, req *pb.WebhookEndpoints) (*pb.WebhookEndpoints, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	updatedEndpoints, err := repositories.UpdateWebhookEndpointsDBHandler(ctx, req.GetEndpoints())
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return &pb.WebhookEndpoints{Endpoints: updatedEndpoints}, nil
+}
+
+// Delete webhook endpoints by IDs (soft delete)
+func (s *Server) DeleteWebhookEndpoints(ctx context.Context, req *pb.WebhookEndpointIds) (*pb.DeleteWebhookEndpointsConfirm, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	deletedBy, _ := ctx.Value("uid").(string)
+
+	deletedIds, err := repositories.DeleteWebhookEndpointsDBHandler(ctx, req.GetEndpointIds(), deletedBy)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return &pb.DeleteWebhookEndpointsConfirm{
+		Status:     "Webhook endpoints successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// Rotate the secret of a webhook endpoint, the new secret is returned once
+func (s *Server) RotateWebhookSecret(ctx context.Context, req *pb.RotateWebhookSecretRequest) (*pb.WebhookEndpoint, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	endpoint, err := repositories.RotateWebhookSecretDBHandler(ctx, req.GetId())
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return endpoint, nil
+}
+
+// List the delivery log of the webhooks, newest first
+func (s *Server) ListDeliveries(ctx context.Context, req *pb.ListDeliveriesRequest) (*pb.WebhookDeliveries, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	pageNumber := req.GetPageNum()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	deliveries, err := repositories.ListDeliveriesDBHandler(ctx, req.GetEndpointId(), req.GetStatus(), req.GetEvent(), pageSize, pageNumber)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return &pb.WebhookDeliveries{Deliveries: deliveries}, nil
+}
+
+// Replay a delivered or failed delivery, it is sent again as a new delivery
+func (s *Server) ReplayDelivery(ctx context.Context, req *pb.ReplayDeliveryRequest) (*pb.WebhookDelivery, error) {
+
+	// authorization
+	err := utils.Authorization(ctx, "admin")
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "user is not authorized for this function")
+	}
+
+	delivery, err := repositories.ReplayDeliveryDBHandler(ctx, req.GetId())
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return delivery, nil
+}
+
+// webhookError maps invalid endpoints and deliveries that can not be replayed to FailedPrecondition
+func webhookError(err error) error {
+	if errors.Is(err, repositories.ErrWebhook) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -14,6 +14,34 @@ import (
 )
 
 func Authentication_Intercepter(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	newCtx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(newCtx, req)
+}
+
+// Authentication_StreamIntercepter does the token check of Authentication_Intercepter for streaming rpcs
+func Authentication_StreamIntercepter(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	newCtx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: newCtx})
+}
+
+// contextStream replaces the context of a stream so the handler sees the values of the token
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate checks the token of the request and returns the context with the token's values
+func authenticate(ctx context.Context, method string) (context.Context, error) {
 	// getting the token from metadata
 
 	// skip spacific rpc that do not need authentication check
@@ -21,10 +49,11 @@ func Authentication_Intercepter(ctx context.Context, req interface{}, info *grpc
 		"/main.ExecsService/Login":          true,
 		"/main.ExecsService/ForgotPassword": true,
 		"/main.ExecsService/ResetPassword":  true,
+		"/main.ExecsService/AcceptInvite":   true,
 	}
 
-	if skipMethods[info.FullMethod] {
-		return handler(ctx, req)
+	if skipMethods[method] {
+		return ctx, nil
 	}
 
 	m, ok := metadata.FromIncomingContext(ctx)
@@ -87,6 +116,5 @@ func Authentication_Intercepter(ctx context.Context, req interface{}, info *grpc
 	newCtx = context.WithValue(newCtx, "username", username)
 	newCtx = context.WithValue(newCtx, "exp", expTimeInt64)
 
-	return handler(newCtx, req)
-
+	return newCtx, nil
 }
@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rpcs a student account may call, the handlers narrow them to the student's own records
+var studentMethods = map[string]bool{
+	"/main.ExecsService/Logout":                               true,
+	"/main.ExecsService/UpdatePassword":                       true,
+	"/main.ExecsService/Me":                                   true,
+	"/main.StudentsService/GetStudents":                       true,
+	"/main.AttendanceService/GetAttendance":                   true,
+	"/main.AttendanceService/GetStudentAttendanceSummary":     true,
+	"/main.GradesService/GetAssessments":                      true,
+	"/main.GradesService/GetScores":                           true,
+	"/main.GradesService/GetCourseGrades":                     true,
+	"/main.EnrollmentService/ListEnrollmentsByStudent":        true,
+	"/main.ReportsService/GenerateReportCard":                 true,
+	"/main.ReportsService/GenerateTranscript":                 true,
+	"/main.ClassesService/GetClasses":                         true,
+	"/main.CoursesService/GetSubjects":                        true,
+	"/main.CoursesService/GetCourses":                         true,
+	"/main.CoursesService/ListCoursesByClass":                 true,
+	"/main.CoursesService/ListCoursesByTeacher":               true,
+	"/main.AcademicCalendarService/GetAcademicYears":          true,
+	"/main.AcademicCalendarService/GetTerms":                  true,
+	"/main.AcademicCalendarService/GetHolidays":               true,
+	"/main.AcademicCalendarService/GetCurrentTerm":            true,
+	"/main.TimetableService/GetSessions":                      true,
+	"/main.TimetableService/GetTeacherWeek":                   true,
+	"/main.TimetableService/GetClassWeek":                     true,
+	"/main.TimetableService/ExportCalendar":                   true,
+	"/main.NotificationService/ListNotifications":             true,
+	"/main.NotificationService/MarkRead":                      true,
+	"/main.NotificationService/SubscribeNotifications":        true,
+	"/main.NotificationService/GetNotificationPreferences":    true,
+	"/main.NotificationService/UpdateNotificationPreferences": true,
+	"/main.AnnouncementsService/GetBulletinBoard":             true,
+	"/main.AnnouncementsService/MarkAnnouncementsRead":        true,
+}
+
+// rpcs a teacher account may call on top of the student ones, grade writes are limited to their courses by the handlers
+var teacherMethods = map[string]bool{
+	"/main.TeachersService/GetTeachers":                   true,
+	"/main.TeachersService/GetStudentsByClassTeacher":     true,
+	"/main.TeachersService/GetStudentCountByClassTeacher": true,
+	"/main.GuardiansService/GetGuardians":                 true,
+	"/main.AttendanceService/GetClassAttendanceSummary":   true,
+	"/main.EnrollmentService/ListEnrollmentsByCourse":     true,
+	"/main.GradesService/AddAssessments":                  true,
+	"/main.GradesService/UpdateAssessments":               true,
+	"/main.GradesService/DeleteAssessments":               true,
+	"/main.GradesService/RecordScores":                    true,
+	"/main.ReportsService/SetReportCardComment":           true,
+	"/main.TimetableService/GetFreeRooms":                 true,
+}
+
+// ScopeIntercepter keeps student and teacher accounts to the rpcs of their self-service, staff roles are left to the
+// handlers. It runs after the authentication intercepter, which puts the role into the context
+func ScopeIntercepter(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	err := checkScope(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// ScopeStreamIntercepter applies the rules of ScopeIntercepter to streaming rpcs
+func ScopeStreamIntercepter(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := checkScope(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func checkScope(ctx context.Context, method string) error {
+	role, _ := ctx.Value("role").(string)
+
+	switch role {
+	case "student":
+		if !studentMethods[method] {
+			return status.Error(codes.PermissionDenied, "students can not call "+method)
+		}
+	case "teacher":
+		if !studentMethods[method] && !teacherMethods[method] {
+			return status.Error(codes.PermissionDenied, "teachers can not call "+method)
+		}
+	}
+	return nil
+}
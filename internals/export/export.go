@@ -0,0 +1,164 @@
+// Package export writes tables row by row as csv, json lines or xlsx files for the bulk exports.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	CSV   = "csv"
+	JSONL = "jsonl"
+	XLSX  = "xlsx"
+)
+
+// content types of the formats
+var contentTypes = map[string]string{
+	CSV:   "text/csv; charset=utf-8",
+	JSONL: "application/x-ndjson",
+	XLSX:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// Writer writes the rows of a table, every row has a value for each column. Close has to be called to finish the file,
+// Discard instead when the export is given up
+type Writer interface {
+	WriteRow(values []string) error
+	Close() error
+	Discard()
+}
+
+// ContentType is the content type of a format, empty for unknown formats
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+// NewWriter starts a table with the columns in the format. csv and xlsx files start with a header row, json lines are
+// objects with the columns as keys
+func NewWriter(format string, w io.Writer, sheet string, columns []string) (Writer, error) {
+	switch format {
+	case CSV:
+		return newCSVWriter(w, columns)
+	case JSONL:
+		return &jsonlWriter{w: w, columns: columns}, nil
+	case XLSX:
+		return newXLSXWriter(w, sheet, columns)
+	}
+	return nil, fmt.Errorf("unknown export format %q", format)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	writer := &csvWriter{w: csv.NewWriter(w)}
+	return writer, writer.WriteRow(columns)
+}
+
+func (c *csvWriter) WriteRow(values []string) error {
+	return c.w.Write(values)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Discard() {}
+
+type jsonlWriter struct {
+	w       io.Writer
+	columns []string
+}
+
+// WriteRow writes the row as one object, the keys are in the order of the columns
+func (j *jsonlWriter) WriteRow(values []string) error {
+	line := []byte{'{'}
+	for i, column := range j.columns {
+		if i > 0 {
+			line = append(line, ',')
+		}
+		key, _ := json.Marshal(column)
+		value, _ := json.Marshal(values[i])
+		line = append(line, key...)
+		line = append(line, ':')
+		line = append(line, value...)
+	}
+	line = append(line, '}', '\n')
+
+	_, err := j.w.Write(line)
+	return err
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+func (j *jsonlWriter) Discard() {}
+
+// xlsxWriter uses the stream writer of excelize, the rows are kept in a temporary file instead of memory until the
+// workbook is written out on Close
+type xlsxWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func newXLSXWriter(w io.Writer, sheet string, columns []string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	err := file.SetSheetName("Sheet1", sheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	stream, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	writer := &xlsxWriter{w: w, file: file, stream: stream}
+	err = writer.WriteRow(columns)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (x *xlsxWriter) WriteRow(values []string) error {
+	x.row++
+	if x.row > excelize.TotalRows {
+		return fmt.Errorf("xlsx sheets have at most %d rows, export as csv or jsonl", excelize.TotalRows)
+	}
+
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	row := make([]any, len(values))
+	for i, value := range values {
+		row[i] = value
+	}
+	return x.stream.SetRow(cell, row)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	err := x.stream.Flush()
+	if err != nil {
+		return err
+	}
+	return x.file.Write(x.w)
+}
+
+// Discard removes the temporary file of the rows
+func (x *xlsxWriter) Discard() {
+	x.file.Close()
+}
@@ -0,0 +1,406 @@
+// Package filterexpr turns the filter expressions of the list rpcs into mongo queries.
+//
+// The syntax follows AIP-160:
+//
+//	last_name = "Sm*" AND class IN ("9A", "9B")
+//	admission_date >= 2024-09-01 AND NOT enrollment_status = withdrawn
+//	email : "@school" OR (first_name = Ann AND last_name != Smith)
+//
+// = is equality, or a prefix match when the value ends in an unescaped *. : matches values containing the text,
+// ignoring case. != < <= > >= and IN (a, b) compare as usual, ranges are two comparisons joined by AND. Like in
+// AIP-160, OR binds tighter than AND: a AND b OR c is a AND (b OR c). Values are quoted with " or ' when they hold
+// spaces or symbols. Only the fields of the allowlist can be named, and values are always compared as values, so an
+// expression can never add a mongo operator of its own.
+package filterexpr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrFilter is returned for expressions that can not be parsed or name fields that are not allowed
+var ErrFilter = errors.New("invalid filter")
+
+// limits keeping an expression from turning into an expensive query
+const (
+	maxLength      = 2000
+	maxComparisons = 50
+	maxDepth       = 10
+)
+
+// Kind is how the values of a field are read from the expression
+type Kind int
+
+const (
+	// String values are compared as they are
+	String Kind = iota
+	// Date values are dates like 2024-09-01 or RFC 3339 times, stored as text they compare in time order
+	Date
+	// Bool values are true or false
+	Bool
+	// ID values are the hex ids of the documents, only = != and IN work on them
+	ID
+)
+
+// Field is a field an expression may name, stored under Key in mongo
+type Field struct {
+	Key  string
+	Kind Kind
+}
+
+// Fields is the allowlist of an entity, by the name used in the expressions
+type Fields map[string]Field
+
+var dateValue = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}([T ][0-9:.]+(Z|[+-][0-9]{2}:[0-9]{2})?)?$`)
+
+// Parse turns the expression into a mongo filter, an empty expression is an empty filter
+func Parse(expr string, fields Fields) (bson.M, error) {
+	if strings.TrimSpace(expr) == "" {
+		return bson.M{}, nil
+	}
+	if len(expr) > maxLength {
+		return nil, fmt.Errorf("%w: the expression is longer than %d characters", ErrFilter, maxLength)
+	}
+
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, fields: fields}
+	filter, err := p.and(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEnd {
+		return nil, p.errorf(tok, "unexpected %q", tok.text)
+	}
+	return filter, nil
+}
+
+// Mentions tells whether the expression compares the field, false for expressions that can not be parsed
+func Mentions(expr, name string) bool {
+	tokens, err := lex(expr)
+	if err != nil {
+		return false
+	}
+	for i, tok := range tokens[:len(tokens)-1] {
+		next := tokens[i+1]
+		if tok.kind == tokWord && tok.text == name && (next.kind == tokOperator || next.kind == tokWord && next.text == "IN") {
+			return true
+		}
+	}
+	return false
+}
+
+type tokenKind int
+
+const (
+	tokEnd tokenKind = iota
+	tokWord
+	tokString
+	tokOperator
+	tokOpen
+	tokClose
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+	// the value ended in an unescaped *
+	wildcard bool
+}
+
+// lex splits the expression into words, quoted strings, operators, parentheses and commas
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokOpen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokClose, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case strings.ContainsRune("=!<>:", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' && r != '=' && r != ':' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unexpected \"!\" at %d, use != or NOT", ErrFilter, i)
+			}
+			tokens = append(tokens, token{kind: tokOperator, text: op, pos: i})
+			i += len(op)
+		case r == '"' || r == '\'':
+			start := i
+			var text strings.Builder
+			wildcard := false
+			closed := false
+			for i++; i < len(runes); i++ {
+				c := runes[i]
+				if c == r {
+					closed = true
+					i++
+					break
+				}
+				wildcard = false
+				if c == '\\' && i+1 < len(runes) {
+					i++
+					text.WriteRune(runes[i])
+					continue
+				}
+				if c == '*' {
+					wildcard = true
+				}
+				text.WriteRune(c)
+			}
+			if !closed {
+				return nil, fmt.Errorf("%w: unterminated string at %d", ErrFilter, start)
+			}
+			tokens = append(tokens, token{kind: tokString, text: text.String(), pos: start, wildcard: wildcard})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()\",'=!<>", runes[i]) &&
+				!(runes[i] == ':' && !isTimeColon(runes, i, start)) {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", ErrFilter, runes[i], i)
+			}
+			text := string(runes[start:i])
+			tokens = append(tokens, token{kind: tokWord, text: text, pos: start, wildcard: strings.HasSuffix(text, "*")})
+		}
+	}
+
+	return append(tokens, token{kind: tokEnd, pos: len(runes)}), nil
+}
+
+// isTimeColon tells the colons of an unquoted time like 2024-09-01T08:00:00Z from the has operator
+func isTimeColon(runes []rune, i, start int) bool {
+	return i > start && unicode.IsDigit(runes[i-1]) && i+1 < len(runes) && unicode.IsDigit(runes[i+1]) &&
+		strings.ContainsRune(string(runes[start:i]), 'T')
+}
+
+type parser struct {
+	tokens      []token
+	next        int
+	fields      Fields
+	comparisons int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) take() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokEnd {
+		p.next++
+	}
+	return tok
+}
+
+func (p *parser) keyword(word string) bool {
+	tok := p.peek()
+	if tok.kind == tokWord && tok.text == word {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *parser) errorf(tok token, format string, args ...any) error {
+	return fmt.Errorf("%w: %s at %d", ErrFilter, fmt.Sprintf(format, args...), tok.pos)
+}
+
+// and := or { AND or }
+func (p *parser) and(depth int) (bson.M, error) {
+	return p.joined(depth, "AND", "$and", p.or)
+}
+
+// or := unary { OR unary }
+func (p *parser) or(depth int) (bson.M, error) {
+	return p.joined(depth, "OR", "$or", p.unary)
+}
+
+func (p *parser) joined(depth int, keyword, operator string, operand func(int) (bson.M, error)) (bson.M, error) {
+	first, err := operand(depth)
+	if err != nil {
+		return nil, err
+	}
+	operands := bson.A{first}
+	for p.keyword(keyword) {
+		next, err := operand(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return bson.M{operator: operands}, nil
+}
+
+// unary := [NOT] ( "(" and ")" | comparison )
+func (p *parser) unary(depth int) (bson.M, error) {
+	if p.keyword("NOT") {
+		operand, err := p.unary(depth)
+		if err != nil {
+			return nil, err
+		}
+		return bson.M{"$nor": bson.A{operand}}, nil
+	}
+
+	tok := p.peek()
+	if tok.kind == tokOpen {
+		if depth == maxDepth {
+			return nil, p.errorf(tok, "parentheses nest deeper than %d", maxDepth)
+		}
+		p.take()
+		inner, err := p.and(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.take(); closing.kind != tokClose {
+			return nil, p.errorf(closing, "missing )")
+		}
+		return inner, nil
+	}
+	return p.comparison()
+}
+
+// comparison := field operator value | field IN "(" value { "," value } ")"
+func (p *parser) comparison() (bson.M, error) {
+	name := p.take()
+	if name.kind != tokWord {
+		return nil, p.errorf(name, "expected a field name, got %q", name.text)
+	}
+	field, ok := p.fields[name.text]
+	if !ok {
+		return nil, p.errorf(name, "%q can not be filtered on", name.text)
+	}
+
+	p.comparisons++
+	if p.comparisons > maxComparisons {
+		return nil, p.errorf(name, "more than %d comparisons", maxComparisons)
+	}
+
+	if p.keyword("IN") {
+		return p.in(name.text, field)
+	}
+
+	op := p.take()
+	if op.kind != tokOperator {
+		return nil, p.errorf(op, "expected an operator after %s", name.text)
+	}
+	valueToken := p.take()
+	if valueToken.kind != tokWord && valueToken.kind != tokString {
+		return nil, p.errorf(valueToken, "expected a value after %s %s", name.text, op.text)
+	}
+
+	switch op.text {
+	case "=":
+		if valueToken.wildcard && field.Kind == String {
+			prefix := strings.TrimSuffix(valueToken.text, "*")
+			return bson.M{field.Key: bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)}}, nil
+		}
+		value, err := p.value(name.text, field, valueToken)
+		if err != nil {
+			return nil, err
+		}
+		return bson.M{field.Key: value}, nil
+	case "!=":
+		value, err := p.value(name.text, field, valueToken)
+		if err != nil {
+			return nil, err
+		}
+		return bson.M{field.Key: bson.M{"$ne": value}}, nil
+	case ":":
+		if field.Kind != String {
+			return nil, p.errorf(op, "%s can not be searched with :", name.text)
+		}
+		return bson.M{field.Key: bson.M{"$regex": regexp.QuoteMeta(valueToken.text), "$options": "i"}}, nil
+	}
+
+	// ranges
+	if field.Kind == ID || field.Kind == Bool {
+		return nil, p.errorf(op, "%s can not be compared with %s", name.text, op.text)
+	}
+	value, err := p.value(name.text, field, valueToken)
+	if err != nil {
+		return nil, err
+	}
+	operators := map[string]string{"<": "$lt", "<=": "$lte", ">": "$gt", ">=": "$gte"}
+	return bson.M{field.Key: bson.M{operators[op.text]: value}}, nil
+}
+
+func (p *parser) in(name string, field Field) (bson.M, error) {
+	if open := p.take(); open.kind != tokOpen {
+		return nil, p.errorf(open, "expected ( after %s IN", name)
+	}
+
+	values := bson.A{}
+	for {
+		tok := p.take()
+		if tok.kind != tokWord && tok.kind != tokString {
+			return nil, p.errorf(tok, "expected a value in the list of %s", name)
+		}
+		value, err := p.value(name, field, tok)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		sep := p.take()
+		if sep.kind == tokClose {
+			break
+		}
+		if sep.kind != tokComma {
+			return nil, p.errorf(sep, "expected , or ) in the list of %s", name)
+		}
+	}
+	return bson.M{field.Key: bson.M{"$in": values}}, nil
+}
+
+// value reads the value of a comparison as the kind of the field
+func (p *parser) value(name string, field Field, tok token) (any, error) {
+	switch field.Kind {
+	case Date:
+		if !dateValue.MatchString(tok.text) {
+			return nil, p.errorf(tok, "%s takes a date like 2024-09-01, got %q", name, tok.text)
+		}
+	case Bool:
+		switch tok.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, p.errorf(tok, "%s takes true or false, got %q", name, tok.text)
+	case ID:
+		id, err := primitive.ObjectIDFromHex(tok.text)
+		if err != nil {
+			return nil, p.errorf(tok, "%s takes an id, got %q", name, tok.text)
+		}
+		return id, nil
+	}
+	return tok.text, nil
+}
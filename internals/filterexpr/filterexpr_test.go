@@ -0,0 +1,195 @@
+package filterexpr
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var testFields = Fields{
+	"id":             {Key: "_id", Kind: ID},
+	"first_name":     {Key: "first_name"},
+	"last_name":      {Key: "last_name"},
+	"email":          {Key: "email"},
+	"class":          {Key: "class"},
+	"address":        {Key: "address"},
+	"admission_date": {Key: "admission_date", Kind: Date},
+	"active":         {Key: "active", Kind: Bool},
+}
+
+func TestParse(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	tests := []struct {
+		name string
+		expr string
+		want bson.M
+	}{
+		{"empty", "  ", bson.M{}},
+		{"equal", "last_name = Smith", bson.M{"last_name": "Smith"}},
+		{"not equal", "last_name != Smith", bson.M{"last_name": bson.M{"$ne": "Smith"}}},
+		{"no spaces", "last_name=Smith", bson.M{"last_name": "Smith"}},
+		{"prefix", `last_name = "Sm*"`, bson.M{"last_name": bson.M{"$regex": "^Sm"}}},
+		{"unquoted prefix", "last_name = Sm*", bson.M{"last_name": bson.M{"$regex": "^Sm"}}},
+		{"prefix is quoted for the regex", `email = "a.b+*"`, bson.M{"email": bson.M{"$regex": `^a\.b\+`}}},
+		{"escaped star is no prefix", `last_name = "Sm\*"`, bson.M{"last_name": "Sm*"}},
+		{"star inside is no prefix", `last_name = "S*m"`, bson.M{"last_name": "S*m"}},
+		{"double quotes with spaces", `address = "1 Main St"`, bson.M{"address": "1 Main St"}},
+		{"single quotes", `last_name = 'Van Dyke'`, bson.M{"last_name": "Van Dyke"}},
+		{"escaped quote", `last_name = 'O\'Brien'`, bson.M{"last_name": "O'Brien"}},
+		{"other quote inside", `last_name = "O'Brien"`, bson.M{"last_name": "O'Brien"}},
+		{"has ignores case and is quoted", `email : "a.b"`, bson.M{"email": bson.M{"$regex": `a\.b`, "$options": "i"}}},
+		{"operators are values", `first_name = "$ne"`, bson.M{"first_name": "$ne"}},
+		{"less", "admission_date < 2024-09-01", bson.M{"admission_date": bson.M{"$lt": "2024-09-01"}}},
+		{"less or equal", "admission_date <= 2024-09-01", bson.M{"admission_date": bson.M{"$lte": "2024-09-01"}}},
+		{"greater", "admission_date > 2024-09-01", bson.M{"admission_date": bson.M{"$gt": "2024-09-01"}}},
+		{"greater or equal", "admission_date >= 2024-09-01", bson.M{"admission_date": bson.M{"$gte": "2024-09-01"}}},
+		{"unquoted time", "admission_date >= 2024-09-01T08:00:00Z", bson.M{"admission_date": bson.M{"$gte": "2024-09-01T08:00:00Z"}}},
+		{"string range", "last_name >= M", bson.M{"last_name": bson.M{"$gte": "M"}}},
+		{"bool", "active = false", bson.M{"active": false}},
+		{"id", "id = " + id.Hex(), bson.M{"_id": id}},
+		{"in", `class IN ("9A", 9B)`, bson.M{"class": bson.M{"$in": bson.A{"9A", "9B"}}}},
+		{"in ids", "id IN (" + id.Hex() + ")", bson.M{"_id": bson.M{"$in": bson.A{id}}}},
+		{"and", "first_name = Ann AND last_name = Smith",
+			bson.M{"$and": bson.A{bson.M{"first_name": "Ann"}, bson.M{"last_name": "Smith"}}}},
+		{"or", "first_name = Ann OR first_name = Bob",
+			bson.M{"$or": bson.A{bson.M{"first_name": "Ann"}, bson.M{"first_name": "Bob"}}}},
+		{"or binds tighter than and", "class = 9A AND first_name = Ann OR first_name = Bob",
+			bson.M{"$and": bson.A{
+				bson.M{"class": "9A"},
+				bson.M{"$or": bson.A{bson.M{"first_name": "Ann"}, bson.M{"first_name": "Bob"}}},
+			}}},
+		{"or binds tighter than and on the left", "first_name = Ann OR first_name = Bob AND class = 9A",
+			bson.M{"$and": bson.A{
+				bson.M{"$or": bson.A{bson.M{"first_name": "Ann"}, bson.M{"first_name": "Bob"}}},
+				bson.M{"class": "9A"},
+			}}},
+		{"parentheses", "(class = 9A AND first_name = Ann) OR first_name = Bob",
+			bson.M{"$or": bson.A{
+				bson.M{"$and": bson.A{bson.M{"class": "9A"}, bson.M{"first_name": "Ann"}}},
+				bson.M{"first_name": "Bob"},
+			}}},
+		{"not", "NOT class = 9A", bson.M{"$nor": bson.A{bson.M{"class": "9A"}}}},
+		{"not binds to one comparison", "NOT class = 9A AND active = true",
+			bson.M{"$and": bson.A{bson.M{"$nor": bson.A{bson.M{"class": "9A"}}}, bson.M{"active": true}}}},
+		{"not of parentheses", "NOT (class = 9A OR class = 9B)",
+			bson.M{"$nor": bson.A{bson.M{"$or": bson.A{bson.M{"class": "9A"}, bson.M{"class": "9B"}}}}}},
+		{"keywords are quoted as values", `last_name = "AND"`, bson.M{"last_name": "AND"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.expr, testFields)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"unknown field", "password = secret"},
+		{"mongo operator as field", "$where = 1"},
+		{"missing value", "first_name ="},
+		{"missing operator", "first_name Ann"},
+		{"missing field", "= Ann"},
+		{"bang", "first_name ! Ann"},
+		{"unterminated string", `first_name = "Ann`},
+		{"missing close", "(first_name = Ann"},
+		{"extra close", "first_name = Ann)"},
+		{"empty parentheses", "()"},
+		{"dangling and", "first_name = Ann AND"},
+		{"dangling not", "NOT"},
+		{"lower case keyword", "first_name = Ann and last_name = Smith"},
+		{"two values", "first_name = Ann Bob"},
+		{"has on a date", "admission_date : 2024"},
+		{"has on a bool", "active : true"},
+		{"range on an id", "id > 64b000000000000000000000"},
+		{"range on a bool", "active < true"},
+		{"bad date", "admission_date >= yesterday"},
+		{"bad bool", "active = yes"},
+		{"bad id", "id = 123"},
+		{"bad id in list", "id IN (123)"},
+		{"in without parentheses", "class IN 9A"},
+		{"unterminated in", "class IN (9A, 9B"},
+		{"empty in", "class IN ()"},
+		{"in without comma", "class IN (9A 9B)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.expr, testFields)
+			if !errors.Is(err, ErrFilter) {
+				t.Errorf("Parse(%q) = %v, %v, want an ErrFilter", tt.expr, got, err)
+			}
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	nested := func(depth int) string {
+		return strings.Repeat("(", depth) + "first_name = Ann" + strings.Repeat(")", depth)
+	}
+	comparisons := func(n int) string {
+		return strings.Repeat("first_name = Ann AND ", n-1) + "first_name = Ann"
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		ok   bool
+	}{
+		{"longest expression", `first_name = "` + strings.Repeat("a", maxLength-15) + `"`, true},
+		{"too long", `first_name = "` + strings.Repeat("a", maxLength-14) + `"`, false},
+		{"most comparisons", comparisons(maxComparisons), true},
+		{"too many comparisons", comparisons(maxComparisons + 1), false},
+		{"too many comparisons in lists", strings.Repeat("class IN (a) OR ", maxComparisons) + "class IN (a)", false},
+		{"deepest parentheses", nested(maxDepth), true},
+		{"too deep", nested(maxDepth + 1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expr, testFields)
+			if tt.ok && err != nil {
+				t.Errorf("Parse failed: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrFilter) {
+				t.Errorf("Parse = %v, want an ErrFilter", err)
+			}
+		})
+	}
+}
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"enrollment_status = withdrawn", true},
+		{"NOT enrollment_status IN (active, withdrawn)", true},
+		{"first_name = Ann AND (enrollment_status != active)", true},
+		{"first_name = enrollment_status", false},
+		{`first_name = "enrollment_status = x"`, false},
+		{"enrollment_status_note = x", false},
+		{`enrollment_status = "unterminated`, false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := Mentions(tt.expr, "enrollment_status"); got != tt.want {
+			t.Errorf("Mentions(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
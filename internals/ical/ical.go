@@ -0,0 +1,93 @@
+// Package ical writes iCalendar (RFC 5545) files for the timetable export.
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+const ContentType = "text/calendar; charset=utf-8"
+
+// times without a zone are floating, they are shown at the same clock time wherever the calendar is opened
+const (
+	localLayout = "20060102T150405"
+	utcLayout   = "20060102T150405Z"
+)
+
+// Event is a single or weekly repeating event. A weekly event repeats on the weekday of Start until Until,
+// the occurrences starting at one of the Except times are left out.
+type Event struct {
+	UID         string
+	Summary     string
+	Location    string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Weekly      bool
+	Until       time.Time
+	Except      []time.Time
+}
+
+// Calendar writes the events as one VCALENDAR
+func Calendar(name string, events []Event) []byte {
+	var buf bytes.Buffer
+	stamp := time.Now().UTC().Format(utcLayout)
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:-//school_project_grpc//timetable//EN")
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "X-WR-CALNAME:"+escape(name))
+
+	for _, event := range events {
+		writeLine(&buf, "BEGIN:VEVENT")
+		writeLine(&buf, "UID:"+event.UID)
+		writeLine(&buf, "DTSTAMP:"+stamp)
+		writeLine(&buf, "DTSTART:"+event.Start.Format(localLayout))
+		writeLine(&buf, "DTEND:"+event.End.Format(localLayout))
+		if event.Weekly {
+			writeLine(&buf, "RRULE:FREQ=WEEKLY;UNTIL="+event.Until.Format(localLayout))
+			for _, except := range event.Except {
+				writeLine(&buf, "EXDATE:"+except.Format(localLayout))
+			}
+		}
+		writeLine(&buf, "SUMMARY:"+escape(event.Summary))
+		if event.Location != "" {
+			writeLine(&buf, "LOCATION:"+escape(event.Location))
+		}
+		if event.Description != "" {
+			writeLine(&buf, "DESCRIPTION:"+escape(event.Description))
+		}
+		writeLine(&buf, "END:VEVENT")
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// writeLine ends the line with CRLF and folds it after 75 octets as the RFC asks, without splitting a utf-8 character
+func writeLine(buf *bytes.Buffer, line string) {
+	for len(line) > 75 {
+		cut := 75
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// escape escapes the characters that have a meaning in TEXT values
+func escape(text string) string {
+	return textEscaper.Replace(text)
+}
@@ -0,0 +1,51 @@
+// Package mailer sends the emails of the server. Messages are rendered from the templates in a directory on disk
+// (a plain text and an optional HTML template per email) and handed to a Sender, which delivers them over SMTP or
+// writes them to a sink directory for development.
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Message is a rendered email, HTML is optional
+type Message struct {
+	ID      string
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender delivers a message, an error means the message can be tried again later
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// NewSenderFromEnv builds the sender chosen by MAIL_SENDER: smtp (the default) or file
+func NewSenderFromEnv() (Sender, error) {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		return nil, fmt.Errorf("MAIL_FROM is required")
+	}
+
+	switch os.Getenv("MAIL_SENDER") {
+	case "smtp", "":
+		port, err := strconv.Atoi(os.Getenv("SMTP_PORT"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SMTP_PORT: %w", err)
+		}
+		return &SMTPSender{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		}, nil
+	case "file":
+		return &FileSender{Dir: os.Getenv("MAIL_SINK_DIR"), From: from}, nil
+	}
+	return nil, fmt.Errorf("unknown MAIL_SENDER %q, use smtp or file", os.Getenv("MAIL_SENDER"))
+}
@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-mail/mail"
+)
+
+// SMTPSender delivers messages through an SMTP server
+type SMTPSender struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+func (s *SMTPSender) Send(ctx context.Context, message Message) error {
+	m := mail.NewMessage()
+	m.SetHeader("From", s.From)
+	m.SetHeader("To", message.To)
+	m.SetHeader("Subject", message.Subject)
+	m.SetBody("text/plain", message.Text)
+	if message.HTML != "" {
+		m.AddAlternative("text/html", message.HTML)
+	}
+
+	d := mail.NewDialer(s.Host, s.Port, s.Username, s.Password)
+	return d.DialAndSend(m)
+}
+
+// FileSender writes every message as an .eml file into Dir and logs it, nothing leaves the machine
+type FileSender struct {
+	Dir  string
+	From string
+}
+
+func (s *FileSender) Send(ctx context.Context, message Message) error {
+	m := mail.NewMessage()
+	m.SetHeader("From", s.From)
+	m.SetHeader("To", message.To)
+	m.SetHeader("Subject", message.Subject)
+	m.SetBody("text/plain", message.Text)
+	if message.HTML != "" {
+		m.AddAlternative("text/html", message.HTML)
+	}
+
+	err := os.MkdirAll(s.Dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	name := message.ID
+	if name == "" {
+		name = fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	file, err := os.Create(filepath.Join(s.Dir, name+".eml"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = m.WriteTo(file)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Mail %q to %s written to %s\n", message.Subject, message.To, file.Name())
+	return nil
+}
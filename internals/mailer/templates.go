@@ -0,0 +1,88 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+)
+
+/*
+Every email NAME has a plain text template NAME.txt.tmpl and optionally an HTML template NAME.html.tmpl in the
+template directory. The text template defines the subject in a {{define "subject"}} block, the rest of it is the body.
+The templates are read when the server starts, changing them needs a restart but no new build.
+*/
+
+// Templates are the parsed email templates by name
+type Templates struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+// LoadTemplates parses the email templates in dir
+func LoadTemplates(dir string) (*Templates, error) {
+	textFiles, err := filepath.Glob(filepath.Join(dir, "*.txt.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	if len(textFiles) == 0 {
+		return nil, fmt.Errorf("no email templates (*.txt.tmpl) in %q", dir)
+	}
+
+	templates := &Templates{text: map[string]*texttemplate.Template{}, html: map[string]*htmltemplate.Template{}}
+	for _, file := range textFiles {
+		name := strings.TrimSuffix(filepath.Base(file), ".txt.tmpl")
+
+		text, err := texttemplate.ParseFiles(file)
+		if err != nil {
+			return nil, err
+		}
+		if text.Lookup("subject") == nil {
+			return nil, fmt.Errorf("email template %s has no subject block", file)
+		}
+		templates.text[name] = text
+
+		htmlFile := filepath.Join(dir, name+".html.tmpl")
+		if _, err := os.Stat(htmlFile); err == nil {
+			html, err := htmltemplate.ParseFiles(htmlFile)
+			if err != nil {
+				return nil, err
+			}
+			templates.html[name] = html
+		}
+	}
+	return templates, nil
+}
+
+// Render renders the email name for the recipient to
+func (t *Templates) Render(name, to string, data any) (Message, error) {
+	text, ok := t.text[name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown email template %q", name)
+	}
+
+	var subject, body bytes.Buffer
+	err := text.ExecuteTemplate(&subject, "subject", data)
+	if err != nil {
+		return Message{}, err
+	}
+	err = text.Execute(&body, data)
+	if err != nil {
+		return Message{}, err
+	}
+
+	message := Message{To: to, Subject: strings.TrimSpace(subject.String()), Text: strings.TrimSpace(body.String()) + "\n"}
+
+	if html, ok := t.html[name]; ok {
+		var buf bytes.Buffer
+		err = html.Execute(&buf, data)
+		if err != nil {
+			return Message{}, err
+		}
+		message.HTML = buf.String()
+	}
+	return message, nil
+}
@@ -0,0 +1,36 @@
+package models
+
+type Announcement struct {
+	Id          string                   `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Title       string                   `protobuf:"title,omitempty" bson:"title,omitempty"`
+	Body        string                   `protobuf:"body,omitempty" bson:"body,omitempty"`
+	Audience    string                   `protobuf:"audience,omitempty" bson:"audience,omitempty"`
+	GradeLevel  int32                    `protobuf:"grade_level,omitempty" bson:"grade_level,omitempty"`
+	Class       string                   `protobuf:"class,omitempty" bson:"class,omitempty"`
+	Role        string                   `protobuf:"role,omitempty" bson:"role,omitempty"`
+	Status      string                   `protobuf:"status,omitempty" bson:"status,omitempty"`
+	PublishAt   string                   `protobuf:"publish_at,omitempty" bson:"publish_at,omitempty"`
+	PublishedAt string                   `protobuf:"published_at,omitempty" bson:"published_at,omitempty"`
+	ExpiresAt   string                   `protobuf:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	Pinned      bool                     `protobuf:"pinned,omitempty" bson:"pinned,omitempty"`
+	Attachments []AnnouncementAttachment `protobuf:"attachments,omitempty" bson:"attachments,omitempty"`
+	CreatedBy   string                   `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	CreatedAt   string                   `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	DeletedAt   string                   `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy   string                   `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+type AnnouncementAttachment struct {
+	Name        string `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Url         string `protobuf:"url,omitempty" bson:"url,omitempty"`
+	ContentType string `protobuf:"content_type,omitempty" bson:"content_type,omitempty"`
+	SizeBytes   int64  `protobuf:"size_bytes,omitempty" bson:"size_bytes,omitempty"`
+}
+
+// AnnouncementRead is the read receipt of one account, UserId is the uid of the account's token
+type AnnouncementRead struct {
+	Id             string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AnnouncementId string `protobuf:"announcement_id,omitempty" bson:"announcement_id,omitempty"`
+	UserId         string `protobuf:"user_id,omitempty" bson:"user_id,omitempty"`
+	ReadAt         string `protobuf:"read_at,omitempty" bson:"read_at,omitempty"`
+}
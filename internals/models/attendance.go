@@ -0,0 +1,23 @@
+package models
+
+type AttendanceRecord struct {
+	Id            string                 `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId     string                 `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	ClassId       string                 `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	Date          string                 `protobuf:"date,omitempty" bson:"date,omitempty"`
+	Period        int32                  `protobuf:"period,omitempty" bson:"period"`
+	Status        string                 `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Note          string                 `protobuf:"note,omitempty" bson:"note,omitempty"`
+	MarkedBy      string                 `protobuf:"marked_by,omitempty" bson:"marked_by,omitempty"`
+	MarkedAt      string                 `protobuf:"marked_at,omitempty" bson:"marked_at,omitempty"`
+	CorrectionLog []AttendanceCorrection `bson:"corrections,omitempty"` // mapped by hand, the pb field is a list of pointers
+	Term          string                 `protobuf:"term,omitempty" bson:"term,omitempty"`
+}
+
+type AttendanceCorrection struct {
+	PreviousStatus string `protobuf:"previous_status,omitempty" bson:"previous_status,omitempty"`
+	Status         string `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Reason         string `protobuf:"reason,omitempty" bson:"reason,omitempty"`
+	CorrectedBy    string `protobuf:"corrected_by,omitempty" bson:"corrected_by,omitempty"`
+	CorrectedAt    string `protobuf:"corrected_at,omitempty" bson:"corrected_at,omitempty"`
+}
@@ -0,0 +1,43 @@
+package models
+
+type AcademicYear struct {
+	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Name      string `protobuf:"name,omitempty" bson:"name,omitempty"`
+	StartDate string `protobuf:"start_date,omitempty" bson:"start_date,omitempty"`
+	EndDate   string `protobuf:"end_date,omitempty" bson:"end_date,omitempty"`
+	DeletedAt string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+type Term struct {
+	Id             string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AcademicYearId string `protobuf:"academic_year_id,omitempty" bson:"academic_year_id,omitempty"`
+	Name           string `protobuf:"name,omitempty" bson:"name,omitempty"`
+	StartDate      string `protobuf:"start_date,omitempty" bson:"start_date,omitempty"`
+	EndDate        string `protobuf:"end_date,omitempty" bson:"end_date,omitempty"`
+	DeletedAt      string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy      string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	ClosedAt       string `protobuf:"closed_at,omitempty" bson:"closed_at,omitempty"`
+}
+
+type Holiday struct {
+	Id             string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AcademicYearId string `protobuf:"academic_year_id,omitempty" bson:"academic_year_id,omitempty"`
+	Name           string `protobuf:"name,omitempty" bson:"name,omitempty"`
+	StartDate      string `protobuf:"start_date,omitempty" bson:"start_date,omitempty"`
+	EndDate        string `protobuf:"end_date,omitempty" bson:"end_date,omitempty"`
+	DeletedAt      string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy      string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+// ClassMembership records which class a student or homeroom teacher was in during a term, so changing
+// the class on the student or teacher keeps the earlier terms
+type ClassMembership struct {
+	Id         string `bson:"_id,omitempty"`
+	MemberType string `bson:"member_type,omitempty"` // student or teacher
+	MemberId   string `bson:"member_id,omitempty"`
+	Term       string `bson:"term,omitempty"`
+	ClassId    string `bson:"class_id,omitempty"`
+	ClassName  string `bson:"class_name,omitempty"`
+	UpdatedAt  string `bson:"updated_at,omitempty"`
+}
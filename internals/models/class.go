@@ -0,0 +1,14 @@
+package models
+
+type Class struct {
+	Id                string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Name              string `protobuf:"name,omitempty" bson:"name,omitempty"`
+	GradeLevel        int32  `protobuf:"grade_level,omitempty" bson:"grade_level,omitempty"`
+	Section           string `protobuf:"section,omitempty" bson:"section,omitempty"`
+	HomeroomTeacherId string `protobuf:"homeroom_teacher_id,omitempty" bson:"homeroom_teacher_id,omitempty"`
+	Room              string `protobuf:"room,omitempty" bson:"room,omitempty"`
+	Capacity          int32  `protobuf:"capacity,omitempty" bson:"capacity,omitempty"`
+	AcademicYear      string `protobuf:"academic_year,omitempty" bson:"academic_year,omitempty"`
+	DeletedAt         string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy         string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
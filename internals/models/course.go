@@ -0,0 +1,15 @@
+package models
+
+type Course struct {
+	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	SubjectId string `protobuf:"subject_id,omitempty" bson:"subject_id,omitempty"`
+	ClassId   string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	TeacherId string `protobuf:"teacher_id,omitempty" bson:"teacher_id,omitempty"`
+	Term      string `protobuf:"term,omitempty" bson:"term,omitempty"`
+	DeletedAt string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+
+	Capacity      int32 `protobuf:"capacity,omitempty" bson:"capacity,omitempty"`
+	EnrolledCount int32 `protobuf:"enrolled_count,omitempty" bson:"enrolled_count"`
+	WaitlistSeq   int32 `bson:"waitlist_seq"` // last waitlist position handed out, not exposed over grpc
+}
@@ -0,0 +1,12 @@
+package models
+
+type Enrollment struct {
+	Id               string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId        string `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	CourseId         string `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	Status           string `protobuf:"status,omitempty" bson:"status,omitempty"`
+	WaitlistPosition int32  `protobuf:"waitlist_position,omitempty" bson:"waitlist_position,omitempty"`
+	RequestedAt      string `protobuf:"requested_at,omitempty" bson:"requested_at,omitempty"`
+	EnrolledAt       string `protobuf:"enrolled_at,omitempty" bson:"enrolled_at,omitempty"`
+	DroppedAt        string `protobuf:"dropped_at,omitempty" bson:"dropped_at,omitempty"`
+}
@@ -13,6 +13,14 @@ type Exec struct {
 	PasswordResetToken string `protobuf:"password_reset_token,omitmepty" bson:"password_reset_token,omitempty"`
 	PasswordTokenExp   string `protobuf:"password_token_exp,omitmepty" bson:"password_token_exp,omitempty"`
 	InactiveStatus     bool `protobuf:"inactive_status" bson:"inactive_status"`
+	DeletedAt          string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy          string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	ProfileId          string `protobuf:"profile_id,omitempty" bson:"profile_id,omitempty"`
+	InviteToken        string `protobuf:"invite_token,omitempty" bson:"invite_token,omitempty"`
+	InviteTokenExp     string `protobuf:"invite_token_exp,omitempty" bson:"invite_token_exp,omitempty"`
+	InvitedAt          string `protobuf:"invited_at,omitempty" bson:"invited_at,omitempty"`
+	InvitedBy          string `protobuf:"invited_by,omitempty" bson:"invited_by,omitempty"`
+	InviteRevokedAt    string `protobuf:"invite_revoked_at,omitempty" bson:"invite_revoked_at,omitempty"`
 }
 
 
@@ -0,0 +1,26 @@
+package models
+
+type Assessment struct {
+	Id        string  `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	CourseId  string  `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	Name      string  `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Type      string  `protobuf:"type,omitempty" bson:"type,omitempty"`
+	Weight    float64 `protobuf:"weight,omitempty" bson:"weight,omitempty"`
+	MaxScore  float64 `protobuf:"max_score,omitempty" bson:"max_score,omitempty"`
+	DueDate   string  `protobuf:"due_date,omitempty" bson:"due_date,omitempty"`
+	CreatedBy string  `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	DeletedAt string  `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string  `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+type Score struct {
+	Id           string  `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AssessmentId string  `protobuf:"assessment_id,omitempty" bson:"assessment_id,omitempty"`
+	CourseId     string  `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	StudentId    string  `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	Score        float64 `protobuf:"score,omitempty" bson:"score"`
+	Comment      string  `protobuf:"comment,omitempty" bson:"comment,omitempty"`
+	GradedBy     string  `protobuf:"graded_by,omitempty" bson:"graded_by,omitempty"`
+	GradedAt     string  `protobuf:"graded_at,omitempty" bson:"graded_at,omitempty"`
+	Term         string  `protobuf:"term,omitempty" bson:"term,omitempty"`
+}
@@ -0,0 +1,22 @@
+package models
+
+type Guardian struct {
+	Id           string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	FirstName    string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
+	LastName     string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
+	Relationship string `protobuf:"relationship,omitempty" bson:"relationship,omitempty"`
+	Phone        string `protobuf:"phone,omitempty" bson:"phone,omitempty"`
+	Email        string `protobuf:"email,omitempty" bson:"email,omitempty"`
+	Address      string `protobuf:"address,omitempty" bson:"address,omitempty"`
+	DeletedAt    string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy    string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+// GuardianLink links a guardian to a student, priority is the emergency contact order of the student's guardians
+type GuardianLink struct {
+	Id         string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId  string `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	GuardianId string `protobuf:"guardian_id,omitempty" bson:"guardian_id,omitempty"`
+	Priority   int32  `protobuf:"priority,omitempty" bson:"priority,omitempty"`
+	LinkedAt   string `protobuf:"linked_at,omitempty" bson:"linked_at,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+// OutboxMail is a rendered email in the mail outbox, the mail worker sends it and retries it until it is sent or dead
+type OutboxMail struct {
+	Id            string `bson:"_id,omitempty"`
+	Template      string `bson:"template,omitempty"`
+	To            string `bson:"to,omitempty"`
+	Subject       string `bson:"subject,omitempty"`
+	Text          string `bson:"text,omitempty"`
+	HTML          string `bson:"html,omitempty"`
+	Status        string `bson:"status,omitempty"`
+	Attempts      int32  `bson:"attempts,omitempty"`
+	NextAttemptAt string `bson:"next_attempt_at,omitempty"`
+	LockedUntil   string `bson:"locked_until,omitempty"`
+	LastError     string `bson:"last_error,omitempty"`
+	CreatedAt     string `bson:"created_at,omitempty"`
+	SentAt        string `bson:"sent_at,omitempty"`
+}
@@ -0,0 +1,26 @@
+package models
+
+// Notification is an event in the inbox of one account, UserId is the uid of the account's token
+type Notification struct {
+	Id            string   `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	UserId        string   `protobuf:"user_id,omitempty" bson:"user_id,omitempty"`
+	Event         string   `protobuf:"event,omitempty" bson:"event,omitempty"`
+	Title         string   `protobuf:"title,omitempty" bson:"title,omitempty"`
+	Body          string   `protobuf:"body,omitempty" bson:"body,omitempty"`
+	SubjectId     string   `protobuf:"subject_id,omitempty" bson:"subject_id,omitempty"`
+	Channels      []string `protobuf:"channels,omitempty" bson:"channels,omitempty"`
+	CreatedAt     string   `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	ReadAt        string   `protobuf:"read_at,omitempty" bson:"read_at,omitempty"`
+	WebhookSentAt string   `protobuf:"webhook_sent_at,omitempty" bson:"webhook_sent_at,omitempty"`
+	WebhookError  string   `protobuf:"webhook_error,omitempty" bson:"webhook_error,omitempty"`
+}
+
+// NotificationPreferences are the delivery channels of one account, the _id is the uid of the account's token
+type NotificationPreferences struct {
+	Id            string   `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Channels      []string `protobuf:"channels,omitempty" bson:"channels"`
+	WebhookUrl    string   `protobuf:"webhook_url,omitempty" bson:"webhook_url,omitempty"`
+	MutedEvents   []string `protobuf:"muted_events,omitempty" bson:"muted_events"`
+	UpdatedAt     string   `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	WebhookSecret string   `protobuf:"webhook_secret,omitempty" bson:"webhook_secret,omitempty"`
+}
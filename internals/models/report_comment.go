@@ -0,0 +1,11 @@
+package models
+
+type ReportCardComment struct {
+	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId string `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	Term      string `protobuf:"term,omitempty" bson:"term,omitempty"`
+	CourseId  string `protobuf:"course_id,omitempty" bson:"course_id"`
+	Comment   string `protobuf:"comment,omitempty" bson:"comment,omitempty"`
+	AuthorId  string `protobuf:"author_id,omitempty" bson:"author_id,omitempty"`
+	UpdatedAt string `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
@@ -1,9 +1,20 @@
 package models
 
 type Student struct {
-	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
-	FirstName string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
-	LastName  string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
-	Email     string `protobuf:"email,omitempty" bson:"email,omitempty"`
-	Class     string `protobuf:"class,omitempty" bson:"class,omitempty"`
+	Id               string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	FirstName        string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
+	LastName         string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
+	Email            string `protobuf:"email,omitempty" bson:"email,omitempty"`
+	Class            string `protobuf:"class,omitempty" bson:"class,omitempty"`
+	DeletedAt        string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy        string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	ClassId          string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	GraduatedAt      string `protobuf:"graduated_at,omitempty" bson:"graduated_at,omitempty"`
+	DateOfBirth      string `protobuf:"date_of_birth,omitempty" bson:"date_of_birth,omitempty"`
+	Gender           string `protobuf:"gender,omitempty" bson:"gender,omitempty"`
+	Address          string `protobuf:"address,omitempty" bson:"address,omitempty"`
+	AdmissionNumber  string `protobuf:"admission_number,omitempty" bson:"admission_number,omitempty"`
+	EnrollmentStatus string `protobuf:"enrollment_status,omitempty" bson:"enrollment_status,omitempty"`
+	AdmissionDate    string `protobuf:"admission_date,omitempty" bson:"admission_date,omitempty"`
+	MedicalNotes     string `protobuf:"medical_notes,omitempty" bson:"medical_notes,omitempty"`
 }
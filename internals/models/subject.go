@@ -0,0 +1,13 @@
+package models
+
+type Subject struct {
+	Id         string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Code       string `protobuf:"code,omitempty" bson:"code,omitempty"`
+	Name       string `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Credits    int32  `protobuf:"credits,omitempty" bson:"credits,omitempty"`
+	Department string `protobuf:"department,omitempty" bson:"department,omitempty"`
+	DeletedAt  string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy  string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+
+	PrerequisiteSubjectIds []string `protobuf:"prerequisite_subject_ids,omitempty" bson:"prerequisite_subject_ids,omitempty"`
+}
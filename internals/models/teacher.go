@@ -7,4 +7,7 @@ type Teacher struct {
 	Email     string `protobuf:"email,omitempty" bson:"email,omitempty"`
 	Class     string `protobuf:"class,omitempty" bson:"class,omitempty"`
 	Subject   string `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	DeletedAt string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	ClassId   string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
 }
@@ -0,0 +1,16 @@
+package models
+
+type Session struct {
+	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	CourseId  string `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	Day       int32  `protobuf:"day,omitempty" bson:"day,omitempty"`
+	Period    int32  `protobuf:"period,omitempty" bson:"period,omitempty"`
+	StartTime string `protobuf:"start_time,omitempty" bson:"start_time,omitempty"`
+	EndTime   string `protobuf:"end_time,omitempty" bson:"end_time,omitempty"`
+	Room      string `protobuf:"room,omitempty" bson:"room,omitempty"`
+	TeacherId string `protobuf:"teacher_id,omitempty" bson:"teacher_id,omitempty"`
+	ClassId   string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	Term      string `protobuf:"term,omitempty" bson:"term,omitempty"`
+	DeletedAt string `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
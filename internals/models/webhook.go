@@ -0,0 +1,49 @@
+package models
+
+type WebhookEndpoint struct {
+	Id        string   `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Name      string   `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Url       string   `protobuf:"url,omitempty" bson:"url,omitempty"`
+	Events    []string `protobuf:"events,omitempty" bson:"events,omitempty"`
+	Secret    string   `protobuf:"secret,omitempty" bson:"secret,omitempty"`
+	CreatedBy string   `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	CreatedAt string   `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	DeletedAt string   `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string   `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+}
+
+// OutboxEvent is a change of an entity, written in the transaction of the change and fanned out to the webhook
+// endpoints by the webhook dispatcher
+type OutboxEvent struct {
+	Id           string `bson:"_id,omitempty"`
+	Event        string `bson:"event,omitempty"`
+	EntityId     string `bson:"entity_id,omitempty"`
+	Payload      string `bson:"payload,omitempty"`
+	Status       string `bson:"status,omitempty"`
+	CreatedAt    string `bson:"created_at,omitempty"`
+	DispatchedAt string `bson:"dispatched_at,omitempty"`
+}
+
+type WebhookDelivery struct {
+	Id            string           `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	EndpointId    string           `protobuf:"endpoint_id,omitempty" bson:"endpoint_id,omitempty"`
+	EventId       string           `protobuf:"event_id,omitempty" bson:"event_id,omitempty"`
+	Event         string           `protobuf:"event,omitempty" bson:"event,omitempty"`
+	Payload       string           `protobuf:"payload,omitempty" bson:"payload,omitempty"`
+	Status        string           `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Attempts      int32            `protobuf:"attempts,omitempty" bson:"attempts,omitempty"`
+	NextAttemptAt string           `protobuf:"next_attempt_at,omitempty" bson:"next_attempt_at,omitempty"`
+	LockedUntil   string           `protobuf:"locked_until,omitempty" bson:"locked_until,omitempty"`
+	LastError     string           `protobuf:"last_error,omitempty" bson:"last_error,omitempty"`
+	DeliveredAt   string           `protobuf:"delivered_at,omitempty" bson:"delivered_at,omitempty"`
+	CreatedAt     string           `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	ReplayOf      string           `protobuf:"replay_of,omitempty" bson:"replay_of,omitempty"`
+	AttemptLog    []WebhookAttempt `protobuf:"attempt_log,omitempty" bson:"attempt_log,omitempty"`
+}
+
+type WebhookAttempt struct {
+	AttemptedAt string `protobuf:"attempted_at,omitempty" bson:"attempted_at,omitempty"`
+	StatusCode  int32  `protobuf:"status_code,omitempty" bson:"status_code,omitempty"`
+	Error       string `protobuf:"error,omitempty" bson:"error,omitempty"`
+	DurationMs  int64  `protobuf:"duration_ms,omitempty" bson:"duration_ms,omitempty"`
+}
@@ -0,0 +1,76 @@
+package reports
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+/*
+PDF templates are plain text, one element per line:
+
+	# text        title
+	## text       section heading
+	|! a | b |    table header row
+	| a | b |     table row, the columns share the page width
+	(empty)       vertical space
+	text          paragraph, wrapped to the page width
+*/
+func writePDF(markup string) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.SetFillColor(230, 230, 230)
+	pdf.AddPage()
+
+	// the core fonts are cp1252, names with accents are translated instead of printed as garbage
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	width := pageWidth - left - right
+
+	for _, line := range strings.Split(markup, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			pdf.Ln(3)
+
+		case strings.HasPrefix(trimmed, "## "):
+			pdf.Ln(2)
+			pdf.SetFont("Helvetica", "B", 12)
+			pdf.CellFormat(width, 7, tr(strings.TrimPrefix(trimmed, "## ")), "B", 1, "L", false, 0, "")
+
+		case strings.HasPrefix(trimmed, "# "):
+			pdf.SetFont("Helvetica", "B", 16)
+			pdf.CellFormat(width, 10, tr(strings.TrimPrefix(trimmed, "# ")), "", 1, "C", false, 0, "")
+
+		case strings.HasPrefix(trimmed, "|"):
+			header := strings.HasPrefix(trimmed, "|!")
+			cells := strings.Split(strings.Trim(strings.TrimPrefix(trimmed, "|!"), "|"), "|")
+			if header {
+				pdf.SetFont("Helvetica", "B", 10)
+			} else {
+				pdf.SetFont("Helvetica", "", 10)
+			}
+			cellWidth := width / float64(len(cells))
+			for _, cell := range cells {
+				pdf.CellFormat(cellWidth, 6, tr(strings.TrimSpace(cell)), "1", 0, "L", header, 0, "")
+			}
+			pdf.Ln(-1)
+
+		default:
+			pdf.SetFont("Helvetica", "", 10)
+			pdf.MultiCell(width, 5, tr(trimmed), "", "L", false)
+		}
+	}
+
+	var buf bytes.Buffer
+	err := pdf.Output(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
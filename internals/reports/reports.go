@@ -0,0 +1,176 @@
+// Package reports renders report cards and transcripts from the templates in ./templates.
+// every document has an HTML template (html/template) and a PDF template (text/template written in the
+// small line format described in pdf.go).
+package reports
+
+import (
+	"archive/zip"
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+const (
+	FormatPDF  = "pdf"
+	FormatHTML = "html"
+)
+
+// CourseResult is one course line of a report card or transcript
+type CourseResult struct {
+	SubjectCode     string
+	SubjectName     string
+	Teacher         string
+	Credits         int32
+	WeightedAverage float64
+	LetterGrade     string
+	Graded          bool
+	Comment         string
+}
+
+// AttendanceResult is the attendance summary printed on a report card
+type AttendanceResult struct {
+	Present        int32
+	Absent         int32
+	Late           int32
+	Excused        int32
+	Total          int32
+	AttendanceRate float64
+}
+
+// StudentInfo is the header of every document
+type StudentInfo struct {
+	Id        string
+	FirstName string
+	LastName  string
+	Class     string
+}
+
+// ReportCard is everything printed on the report card of one student for one term
+type ReportCard struct {
+	Student     StudentInfo
+	Term        string
+	FromDate    string
+	ToDate      string
+	Courses     []CourseResult
+	GPA         float64
+	Attendance  AttendanceResult
+	Comment     string
+	GeneratedAt string
+}
+
+// TermResult is one term of a transcript
+type TermResult struct {
+	Term    string
+	Courses []CourseResult
+	GPA     float64
+	Credits int32
+}
+
+// Transcript is the cumulative record of a student over every term
+type Transcript struct {
+	Student       StudentInfo
+	Terms         []TermResult
+	CumulativeGPA float64
+	TotalCredits  int32
+	GeneratedAt   string
+}
+
+// Document is a rendered report ready to be sent to the client or put into a zip
+type Document struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+var funcs = map[string]any{
+	"num": func(value float64) string { return fmt.Sprintf("%.2f", value) },
+}
+
+// RenderReportCard renders the report card in the given format
+func RenderReportCard(card *ReportCard, format string) (*Document, error) {
+	name := fmt.Sprintf("report_card_%s_%s_%s", card.Student.LastName, card.Student.FirstName, card.Term)
+	return render("report_card", name, format, card)
+}
+
+// RenderTranscript renders the transcript in the given format
+func RenderTranscript(transcript *Transcript, format string) (*Document, error) {
+	name := fmt.Sprintf("transcript_%s_%s", transcript.Student.LastName, transcript.Student.FirstName)
+	return render("transcript", name, format, transcript)
+}
+
+// Zip packs the documents into one zip archive
+func Zip(fileName string, documents []*Document) (*Document, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	for _, document := range documents {
+		file, err := archive.Create(document.FileName)
+		if err != nil {
+			return nil, err
+		}
+		_, err = file.Write(document.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := archive.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{FileName: fileName, ContentType: "application/zip", Content: buf.Bytes()}, nil
+}
+
+func render(templateName, fileName, format string, data any) (*Document, error) {
+	fileName = safeFileName(fileName)
+
+	switch format {
+	case FormatHTML, "":
+		tmpl, err := htmltemplate.New(templateName+".html.tmpl").Funcs(funcs).ParseFS(templateFiles, "templates/"+templateName+".html.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		err = tmpl.Execute(&buf, data)
+		if err != nil {
+			return nil, err
+		}
+		return &Document{FileName: fileName + ".html", ContentType: "text/html; charset=utf-8", Content: buf.Bytes()}, nil
+
+	case FormatPDF:
+		tmpl, err := texttemplate.New(templateName+".pdf.tmpl").Funcs(funcs).ParseFS(templateFiles, "templates/"+templateName+".pdf.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		err = tmpl.Execute(&buf, data)
+		if err != nil {
+			return nil, err
+		}
+		content, err := writePDF(buf.String())
+		if err != nil {
+			return nil, err
+		}
+		return &Document{FileName: fileName + ".pdf", ContentType: "application/pdf", Content: content}, nil
+	}
+
+	return nil, fmt.Errorf("unknown report format %q", format)
+}
+
+// safeFileName keeps names inside a zip readable on every system
+func safeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrAccount is returned when a login account does not fit the student or teacher it belongs to
+var ErrAccount = errors.New("account invalid")
+
+// roles of the self-service accounts of students and teachers, every other role is a staff account
+const (
+	RoleStudent = "student"
+	RoleTeacher = "teacher"
+)
+
+/*
+Students and teachers log in with an exec document like the staff does, so login, passwords and the reset flow are
+shared. Their role is student or teacher and profile_id points to their student or teacher document. The token of such
+an account carries the profile id as uid, the handlers use it to limit the caller to their own records.
+*/
+
+// IsProfileRole reports whether accounts with the role belong to a student or teacher
+func IsProfileRole(role string) bool {
+	return role == RoleStudent || role == RoleTeacher
+}
+
+// TokenSubject is the uid put into the token of the account, the profile id for students and teachers
+func TokenSubject(exec models.Exec) string {
+	if IsProfileRole(exec.Role) {
+		return exec.ProfileId
+	}
+	return exec.Id
+}
+
+// checkAccountProfile checks that a student or teacher account points to an existing profile without another account,
+// staff accounts have no profile
+func checkAccountProfile(ctx context.Context, db *mongo.Database, exec *models.Exec) error {
+	if !IsProfileRole(exec.Role) {
+		if exec.ProfileId != "" {
+			return fmt.Errorf("%w: only student and teacher accounts have a profile_id", ErrAccount)
+		}
+		return nil
+	}
+
+	if exec.ProfileId == "" {
+		return fmt.Errorf("%w: %s accounts need the profile_id of their %s", ErrAccount, exec.Role, exec.Role)
+	}
+	err := checkProfileExists(ctx, db, exec.Role, exec.ProfileId)
+	if err != nil {
+		return err
+	}
+
+	count, err := db.Collection("execs").CountDocuments(ctx, bson.M{"profile_id": exec.ProfileId, "deleted_at": nil})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s %s already has an account", ErrAccount, exec.Role, exec.ProfileId)
+	}
+	return nil
+}
+
+// checkProfileExists checks that the student or teacher of an account is not deleted
+func checkProfileExists(ctx context.Context, db *mongo.Database, role, profileID string) error {
+	collection := "students"
+	if role == RoleTeacher {
+		collection = "teachers"
+	}
+
+	count, err := db.Collection(collection).CountDocuments(ctx, bson.M{"_id": mustObjectID(profileID), "deleted_at": nil})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %s %s does not exist", ErrAccount, role, profileID)
+	}
+	return nil
+}
+
+// MeDBHandler loads the account of the caller and, for students and teachers, their profile. uid is the uid of the token
+func MeDBHandler(ctx context.Context, uid, role string) (*pb.MeResponse, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	filter := bson.M{"_id": mustObjectID(uid), "deleted_at": nil}
+	if IsProfileRole(role) {
+		filter = bson.M{"profile_id": uid, "role": role, "deleted_at": nil}
+	}
+
+	var account models.Exec
+	err = db.Collection("execs").FindOne(ctx, filter).Decode(&account)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: the account of the token no longer exists", ErrAccount)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	// secrets never leave the server
+	account.Password = ""
+	account.PasswordResetToken = ""
+	account.PasswordTokenExp = ""
+
+	me := &pb.MeResponse{Role: account.Role, Account: MapModelToPbExec(&account)}
+
+	switch account.Role {
+	case RoleStudent:
+		student, err := findStudent(ctx, db, account.ProfileId)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAccount, err)
+		}
+		me.Student = MapModelToPbStudent(student)
+	case RoleTeacher:
+		var teacher models.Teacher
+		err = db.Collection("teachers").FindOne(ctx, bson.M{"_id": mustObjectID(account.ProfileId), "deleted_at": nil}).Decode(&teacher)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, fmt.Errorf("%w: teacher %s does not exist", ErrAccount, account.ProfileId)
+			}
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		me.Teacher = MapModelToPbTeacher(&teacher)
+	}
+
+	return me, nil
+}
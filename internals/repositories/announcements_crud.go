@@ -0,0 +1,723 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrAnnouncement is returned when an announcement is invalid or its status does not allow the change
+var ErrAnnouncement = errors.New("announcement invalid")
+
+const (
+	AnnouncementDraft     = "draft"
+	AnnouncementScheduled = "scheduled"
+	AnnouncementPublished = "published"
+)
+
+const (
+	AudienceSchool = "school"
+	AudienceGrade  = "grade"
+	AudienceClass  = "class"
+	AudienceRole   = "role"
+)
+
+/*
+Announcements are written as drafts and become visible once they are published, right away or at publish_at by the
+announcement scheduler. The audience is the whole school, the classes of a grade level, one class or the accounts of one
+role. Class audiences use the class names on students and teachers, so they reach the students and teachers of the class
+with an account. Every account that opens an announcement on the bulletin board leaves a read receipt in
+announcement_reads, keyed by the uid of its token like the notifications.
+*/
+
+// Add announcements as drafts to MongoDB
+func AddAnnouncementsDBHandler(ctx context.Context, announcementsFromReq []*pb.Announcement, createdBy string) ([]*pb.Announcement, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedAnnouncements []*pb.Announcement
+
+	for _, pbAnnouncement := range announcementsFromReq {
+		announcement := MapPBToModelAnnouncement(pbAnnouncement)
+
+		// publishing is done by PublishAnnouncement
+		announcement.Status = AnnouncementDraft
+		announcement.PublishAt = ""
+		announcement.PublishedAt = ""
+		announcement.CreatedBy = createdBy
+		announcement.CreatedAt = time.Now().Format(time.RFC3339)
+		if announcement.Audience == "" {
+			announcement.Audience = AudienceSchool
+		}
+
+		err = checkAnnouncement(ctx, db, announcement)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := db.Collection("announcements").InsertOne(ctx, announcement)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding value into database")
+		}
+
+		objectID, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			announcement.Id = objectID.Hex()
+		}
+
+		addedAnnouncements = append(addedAnnouncements, MapModelToPbAnnouncement(announcement))
+	}
+
+	return addedAnnouncements, nil
+}
+
+// Get announcements from MongoDB with optional sorting
+func GetAnnouncementsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Announcement, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findAnnouncements(ctx, client.Database("school"), filter, sortOption, pageSize, pageNumber)
+}
+
+// Update announcements in MongoDB, the audience of a published announcement can not change
+func UpdateAnnouncementsDBHandler(ctx context.Context, pbAnnouncements []*pb.Announcement) ([]*pb.Announcement, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedAnnouncements []*pb.Announcement
+
+	for _, pbAnnouncement := range pbAnnouncements {
+
+		// Validate ID
+		if pbAnnouncement.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findAnnouncement(ctx, db, pbAnnouncement.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		// status, publishing and pinning have their own rpcs
+		modelAnnouncement := MapPBToModelAnnouncement(pbAnnouncement)
+		modelAnnouncement.Status = ""
+		modelAnnouncement.PublishAt = ""
+		modelAnnouncement.PublishedAt = ""
+		modelAnnouncement.Pinned = false
+		modelAnnouncement.CreatedBy = ""
+		modelAnnouncement.CreatedAt = ""
+
+		updateDoc, err := updateDocFromModel(modelAnnouncement)
+		if err != nil {
+			return nil, err
+		}
+
+		// an audience change replaces the whole audience
+		unset := bson.M{}
+		if modelAnnouncement.Audience != "" {
+			if modelAnnouncement.GradeLevel == 0 {
+				unset["grade_level"] = ""
+			}
+			if modelAnnouncement.Class == "" {
+				unset["class"] = ""
+			}
+			if modelAnnouncement.Role == "" {
+				unset["role"] = ""
+			}
+		}
+
+		merged := *current
+		raw, err := bson.Marshal(updateDoc)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		if modelAnnouncement.Audience != "" {
+			merged.GradeLevel, merged.Class, merged.Role = 0, "", ""
+		}
+		if len(modelAnnouncement.Attachments) > 0 {
+			merged.Attachments = nil
+		}
+		err = bson.Unmarshal(raw, &merged)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+
+		if current.Status == AnnouncementPublished && !sameAudience(current, &merged) {
+			return nil, fmt.Errorf("%w: the audience of published announcement %s can not change", ErrAnnouncement, current.Id)
+		}
+		err = checkAnnouncement(ctx, db, &merged)
+		if err != nil {
+			return nil, err
+		}
+		if modelAnnouncement.ExpiresAt != "" {
+			updateDoc["expires_at"] = merged.ExpiresAt
+		}
+
+		update := bson.M{"$set": updateDoc}
+		if len(unset) > 0 {
+			update["$unset"] = unset
+		}
+		_, err = db.Collection("announcements").UpdateOne(ctx, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, update)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating announcement id: %s", pbAnnouncement.Id))
+		}
+
+		updatedAnnouncements = append(updatedAnnouncements, MapModelToPbAnnouncement(&merged))
+	}
+
+	return updatedAnnouncements, nil
+}
+
+// delete announcements in mongoDB by id (soft delete), their read receipts are kept
+func DeleteAnnouncementsDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	return softDeleteByIDs(ctx, client.Database("school").Collection("announcements"), objectIds, deletedBy, "announcements")
+}
+
+// PublishAnnouncementDBHandler publishes a draft or scheduled announcement now, or schedules it when publishAt
+// (RFC3339) is in the future
+func PublishAnnouncementDBHandler(ctx context.Context, id, publishAt string) (*pb.Announcement, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	announcement, err := findAnnouncement(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	if announcement.Status == AnnouncementPublished {
+		return nil, fmt.Errorf("%w: announcement %s is already published", ErrAnnouncement, id)
+	}
+
+	now := time.Now()
+	at := now
+	if publishAt != "" {
+		at, err = time.Parse(time.RFC3339, publishAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: publish_at %q is not an RFC3339 time", ErrAnnouncement, publishAt)
+		}
+		at = at.Local()
+	}
+	if announcement.ExpiresAt != "" {
+		expires, _ := time.Parse(time.RFC3339, announcement.ExpiresAt)
+		if !expires.After(at) {
+			return nil, fmt.Errorf("%w: announcement %s expires before it would be published", ErrAnnouncement, id)
+		}
+	}
+
+	set := bson.M{"status": AnnouncementScheduled, "publish_at": at.Format(time.RFC3339)}
+	if !at.After(now) {
+		set = bson.M{"status": AnnouncementPublished, "publish_at": now.Format(time.RFC3339), "published_at": now.Format(time.RFC3339)}
+	}
+
+	// the status in the filter keeps the scheduler from publishing it a second time
+	res, err := db.Collection("announcements").UpdateOne(ctx,
+		bson.M{"_id": mustObjectID(id), "deleted_at": nil, "status": announcement.Status},
+		bson.M{"$set": set})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to publish announcement")
+	}
+	if res.ModifiedCount == 0 {
+		return nil, fmt.Errorf("%w: announcement %s was changed meanwhile, try again", ErrAnnouncement, id)
+	}
+
+	announcement.Status, _ = set["status"].(string)
+	announcement.PublishAt, _ = set["publish_at"].(string)
+	announcement.PublishedAt, _ = set["published_at"].(string)
+	return MapModelToPbAnnouncement(announcement), nil
+}
+
+// PinAnnouncementDBHandler pins or unpins an announcement, pinned announcements are on top of the bulletin board
+func PinAnnouncementDBHandler(ctx context.Context, id string, pinned bool) (*pb.Announcement, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	announcement, err := findAnnouncement(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{"pinned": true}}
+	if !pinned {
+		update = bson.M{"$unset": bson.M{"pinned": ""}}
+	}
+	_, err = db.Collection("announcements").UpdateOne(ctx, bson.M{"_id": mustObjectID(id), "deleted_at": nil}, update)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to pin announcement")
+	}
+
+	announcement.Pinned = pinned
+	return MapModelToPbAnnouncement(announcement), nil
+}
+
+// BulletinBoardDBHandler lists the published, unexpired announcements addressed to the account, pinned ones first
+func BulletinBoardDBHandler(ctx context.Context, uid, role string, unreadOnly bool, pageSize, pageNumber uint32) ([]*pb.Announcement, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	filter, err := bulletinFilter(ctx, db, uid, role)
+	if err != nil {
+		return nil, err
+	}
+
+	readIDs, err := db.Collection("announcement_reads").Distinct(ctx, "announcement_id", bson.M{"user_id": uid})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	read := map[string]bool{}
+	for _, id := range readIDs {
+		if s, ok := id.(string); ok {
+			read[s] = true
+		}
+	}
+	if unreadOnly && len(read) > 0 {
+		objectIds := make([]primitive.ObjectID, 0, len(read))
+		for id := range read {
+			objectIds = append(objectIds, mustObjectID(id))
+		}
+		filter["_id"] = bson.M{"$nin": objectIds}
+	}
+
+	sortOption := bson.D{{Key: "pinned", Value: -1}, {Key: "published_at", Value: -1}, {Key: "_id", Value: -1}}
+	announcements, err := findAnnouncements(ctx, db, filter, sortOption, pageSize, pageNumber)
+	if err != nil {
+		return nil, err
+	}
+	for _, announcement := range announcements {
+		announcement.Read = read[announcement.Id]
+	}
+	return announcements, nil
+}
+
+// MarkAnnouncementsReadDBHandler leaves read receipts of the account on the announcements of its bulletin board,
+// ids of other announcements are skipped
+func MarkAnnouncementsReadDBHandler(ctx context.Context, uid, role string, ids []string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectIds, err := toObjectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := bulletinFilter(ctx, db, uid, role)
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = bson.M{"$in": objectIds}
+
+	visible, err := db.Collection("announcements").Distinct(ctx, "_id", filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	readIds := make([]string, 0, len(visible))
+	for _, value := range visible {
+		objectID, ok := value.(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+		// reading again keeps the first receipt
+		_, err = db.Collection("announcement_reads").UpdateOne(ctx,
+			bson.M{"announcement_id": objectID.Hex(), "user_id": uid},
+			bson.M{"$setOnInsert": bson.M{"read_at": now}},
+			options.Update().SetUpsert(true))
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Failed to store read receipt")
+		}
+		readIds = append(readIds, objectID.Hex())
+	}
+	return readIds, nil
+}
+
+// GetReadReceiptsDBHandler lists who read the announcement and how many accounts it is addressed to
+func GetReadReceiptsDBHandler(ctx context.Context, id string) (*pb.ReadReceipts, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	announcement, err := findAnnouncement(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := db.Collection("announcement_reads").Find(ctx, bson.M{"announcement_id": id}, options.Find().SetSort(bson.D{{Key: "read_at", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var reads []models.AnnouncementRead
+	err = cursor.All(ctx, &reads)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	uids := make([]string, 0, len(reads))
+	for _, read := range reads {
+		uids = append(uids, read.UserId)
+	}
+	accounts, err := notificationAccounts(ctx, db, uids)
+	if err != nil {
+		return nil, err
+	}
+	byUID := map[string]models.Exec{}
+	for _, account := range accounts {
+		byUID[TokenSubject(account)] = account
+	}
+
+	receipts := make([]*pb.ReadReceipt, 0, len(reads))
+	for _, read := range reads {
+		account := byUID[read.UserId]
+		receipts = append(receipts, &pb.ReadReceipt{UserId: read.UserId, Username: account.Username, Role: account.Role, ReadAt: read.ReadAt})
+	}
+
+	audience, err := audienceAccounts(ctx, db, announcement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadReceipts{
+		AnnouncementId: id,
+		ReadCount:      int64(len(receipts)),
+		AudienceCount:  int64(len(audience)),
+		Receipts:       receipts,
+	}, nil
+}
+
+// AnnouncementEventDBHandler is the notification of a published announcement for the accounts of its audience
+func AnnouncementEventDBHandler(ctx context.Context, announcement *pb.Announcement) (NotificationEvent, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return NotificationEvent{}, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return announcementEvent(ctx, client.Database("school"), MapPBToModelAnnouncement(announcement))
+}
+
+func announcementEvent(ctx context.Context, db *mongo.Database, announcement *models.Announcement) (NotificationEvent, error) {
+	accounts, err := audienceAccounts(ctx, db, announcement)
+	if err != nil {
+		return NotificationEvent{}, err
+	}
+
+	uids := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		uids = append(uids, TokenSubject(account))
+	}
+
+	return NotificationEvent{
+		Event:     EventAnnouncementPublished,
+		Title:     announcement.Title,
+		Body:      announcement.Body,
+		SubjectId: announcement.Id,
+		UserIds:   uids,
+	}, nil
+}
+
+// PublishScheduledAnnouncementsDBHandler publishes the scheduled announcements that are due and notifies their audience
+func PublishScheduledAnnouncementsDBHandler(ctx context.Context) (int, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	now := time.Now().Format(time.RFC3339)
+	published := 0
+	for {
+		var announcement models.Announcement
+		err = db.Collection("announcements").FindOneAndUpdate(ctx,
+			bson.M{"status": AnnouncementScheduled, "publish_at": bson.M{"$lte": now}, "deleted_at": nil},
+			bson.M{"$set": bson.M{"status": AnnouncementPublished, "published_at": now}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&announcement)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return published, nil
+			}
+			return published, utils.ErrorHandler(err, "Failed to publish scheduled announcements")
+		}
+		published++
+
+		// the announcement is published either way, a failed notification is only logged
+		event, err := announcementEvent(ctx, db, &announcement)
+		if err == nil {
+			err = NotifyDBHandler(ctx, []NotificationEvent{event})
+		}
+		if err != nil {
+			log.Println("Failed to notify the audience of announcement", announcement.Id, err)
+		}
+	}
+}
+
+// RunAnnouncementScheduler runs forever and publishes scheduled announcements once their publish_at has passed
+func RunAnnouncementScheduler(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+
+		published, err := PublishScheduledAnnouncementsDBHandler(context.Background())
+		if err != nil {
+			continue // error is already logged by the error handler, try again on the next tick
+		}
+		if published > 0 {
+			log.Printf("Announcement scheduler published %d announcements\n", published)
+		}
+	}
+}
+
+// bulletinFilter matches the published, unexpired announcements addressed to the account with the token uid and role.
+// students and teachers also get the announcements of their class and its grade level
+func bulletinFilter(ctx context.Context, db *mongo.Database, uid, role string) (bson.M, error) {
+	audience := bson.A{
+		bson.M{"audience": AudienceSchool},
+		bson.M{"audience": AudienceRole, "role": role},
+	}
+
+	className := ""
+	switch role {
+	case RoleStudent:
+		student, err := findStudent(ctx, db, uid)
+		if err != nil {
+			return nil, err
+		}
+		className = student.Class
+	case RoleTeacher:
+		var teacher models.Teacher
+		err := db.Collection("teachers").FindOne(ctx, bson.M{"_id": mustObjectID(uid), "deleted_at": nil}).Decode(&teacher)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		className = teacher.Class
+	}
+	if className != "" {
+		audience = append(audience, bson.M{"audience": AudienceClass, "class": className})
+
+		class, err := findClass(ctx, db, "", className)
+		if err == nil && class.GradeLevel > 0 {
+			audience = append(audience, bson.M{"audience": AudienceGrade, "grade_level": class.GradeLevel})
+		}
+	}
+
+	return bson.M{
+		"status":     AnnouncementPublished,
+		"deleted_at": nil,
+		"$and": bson.A{
+			bson.M{"$or": audience},
+			bson.M{"$or": bson.A{bson.M{"expires_at": nil}, bson.M{"expires_at": bson.M{"$gt": time.Now().Format(time.RFC3339)}}}},
+		},
+	}, nil
+}
+
+// audienceAccounts loads the accounts the announcement is addressed to
+func audienceAccounts(ctx context.Context, db *mongo.Database, announcement *models.Announcement) ([]models.Exec, error) {
+	filter := bson.M{"deleted_at": nil}
+
+	switch announcement.Audience {
+	case AudienceRole:
+		filter["role"] = announcement.Role
+	case AudienceClass, AudienceGrade:
+		classNames := []string{announcement.Class}
+		if announcement.Audience == AudienceGrade {
+			names, err := db.Collection("classes").Distinct(ctx, "name", bson.M{"grade_level": announcement.GradeLevel, "deleted_at": nil})
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Internal error")
+			}
+			classNames = classNames[:0]
+			for _, name := range names {
+				if s, ok := name.(string); ok {
+					classNames = append(classNames, s)
+				}
+			}
+		}
+
+		var profileIDs []string
+		for _, collection := range []string{"students", "teachers"} {
+			ids, err := db.Collection(collection).Distinct(ctx, "_id", bson.M{"class": bson.M{"$in": classNames}, "deleted_at": nil})
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Internal error")
+			}
+			for _, id := range ids {
+				if objectID, ok := id.(primitive.ObjectID); ok {
+					profileIDs = append(profileIDs, objectID.Hex())
+				}
+			}
+		}
+		filter["profile_id"] = bson.M{"$in": profileIDs}
+	}
+
+	cursor, err := db.Collection("execs").Find(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var accounts []models.Exec
+	err = cursor.All(ctx, &accounts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return accounts, nil
+}
+
+// findAnnouncements runs a paged find on the announcements, the attachments need their own mapping
+func findAnnouncements(ctx context.Context, db *mongo.Database, filter bson.M, sortOption bson.D, pageSize, pageNumber uint32) ([]*pb.Announcement, error) {
+	findOptions := options.Find().SetSkip(int64((pageNumber - 1) * pageSize)).SetLimit(int64(pageSize))
+	if len(sortOption) > 0 {
+		findOptions.SetSort(sortOption)
+	}
+
+	cursor, err := db.Collection("announcements").Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
+	}
+	var announcements []models.Announcement
+	err = cursor.All(ctx, &announcements)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	entities := make([]*pb.Announcement, 0, len(announcements))
+	for i := range announcements {
+		entities = append(entities, MapModelToPbAnnouncement(&announcements[i]))
+	}
+	return entities, nil
+}
+
+// findAnnouncement loads an announcement that is not deleted by id
+func findAnnouncement(ctx context.Context, db *mongo.Database, id string) (*models.Announcement, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid announcement id: %v", id))
+	}
+
+	var announcement models.Announcement
+	err = db.Collection("announcements").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&announcement)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: announcement %s does not exist", ErrAnnouncement, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &announcement, nil
+}
+
+func sameAudience(a, b *models.Announcement) bool {
+	return a.Audience == b.Audience && a.GradeLevel == b.GradeLevel && a.Class == b.Class && a.Role == b.Role
+}
+
+// checkAnnouncement checks the audience, times and attachments, the proto validation rules are not enforced
+func checkAnnouncement(ctx context.Context, db *mongo.Database, announcement *models.Announcement) error {
+	if announcement.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrAnnouncement)
+	}
+
+	switch announcement.Audience {
+	case AudienceSchool:
+		if announcement.GradeLevel != 0 || announcement.Class != "" || announcement.Role != "" {
+			return fmt.Errorf("%w: a school announcement has no grade_level, class or role", ErrAnnouncement)
+		}
+	case AudienceGrade:
+		if announcement.GradeLevel < 1 || announcement.Class != "" || announcement.Role != "" {
+			return fmt.Errorf("%w: a grade announcement needs a grade_level and no class or role", ErrAnnouncement)
+		}
+		count, err := db.Collection("classes").CountDocuments(ctx, bson.M{"grade_level": announcement.GradeLevel, "deleted_at": nil})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if count == 0 {
+			return fmt.Errorf("%w: there is no class in grade level %d", ErrAnnouncement, announcement.GradeLevel)
+		}
+	case AudienceClass:
+		if announcement.Class == "" || announcement.GradeLevel != 0 || announcement.Role != "" {
+			return fmt.Errorf("%w: a class announcement needs a class and no grade_level or role", ErrAnnouncement)
+		}
+		_, err := findClass(ctx, db, "", announcement.Class)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrAnnouncement, err)
+		}
+	case AudienceRole:
+		if announcement.Role == "" || announcement.GradeLevel != 0 || announcement.Class != "" {
+			return fmt.Errorf("%w: a role announcement needs a role and no grade_level or class", ErrAnnouncement)
+		}
+	default:
+		return fmt.Errorf("%w: audience %q is not one of school, grade, class, role", ErrAnnouncement, announcement.Audience)
+	}
+
+	if announcement.ExpiresAt != "" {
+		expires, err := time.Parse(time.RFC3339, announcement.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("%w: expires_at %q is not an RFC3339 time", ErrAnnouncement, announcement.ExpiresAt)
+		}
+		// stored in the local time of the server like the other times, so the bulletin board can compare them as strings
+		announcement.ExpiresAt = expires.Local().Format(time.RFC3339)
+	}
+
+	for _, attachment := range announcement.Attachments {
+		u, err := url.Parse(attachment.Url)
+		if attachment.Name == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: attachments need a name and an http or https url", ErrAnnouncement)
+		}
+		if attachment.SizeBytes < 0 {
+			return fmt.Errorf("%w: attachment %s has a negative size", ErrAnnouncement, attachment.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrAttendance is returned when attendance can not be recorded or corrected as requested
+var ErrAttendance = errors.New("attendance refused")
+
+const (
+	AttendancePresent = "present"
+	AttendanceAbsent  = "absent"
+	AttendanceLate    = "late"
+	AttendanceExcused = "excused"
+)
+
+// MarkAttendanceDBHandler records attendance for single students, the class is taken from the student when it is not given.
+// a student has one record per date and period, records that already exist are skipped and have to be corrected instead.
+func MarkAttendanceDBHandler(ctx context.Context, pbRecords []*pb.AttendanceRecord, markedBy string) (*pb.MarkAttendanceResponse, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	studentIDs := make([]string, 0, len(pbRecords))
+	for _, pbRecord := range pbRecords {
+		if pbRecord.StudentId == "" || pbRecord.Date == "" || pbRecord.Status == "" {
+			return nil, fmt.Errorf("%w: student_id, date and status are required", ErrAttendance)
+		}
+		err = checkAttendanceRecord(pbRecord.Date, pbRecord.Status)
+		if err != nil {
+			return nil, err
+		}
+		studentIDs = append(studentIDs, pbRecord.StudentId)
+	}
+
+	studentClasses, err := activeStudentClasses(ctx, db, bson.M{"_id": bson.M{"$in": mustObjectIDs(studentIDs)}})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*models.AttendanceRecord, 0, len(pbRecords))
+	for _, pbRecord := range pbRecords {
+		classID, ok := studentClasses[pbRecord.StudentId]
+		if !ok {
+			return nil, fmt.Errorf("%w: student %s does not exist", ErrAttendance, pbRecord.StudentId)
+		}
+
+		record := MapPBToModelAttendanceRecord(pbRecord)
+		if record.ClassId == "" {
+			record.ClassId = classID
+		}
+		records = append(records, record)
+	}
+
+	return markAttendance(ctx, db, records, markedBy)
+}
+
+// MarkClassAttendanceDBHandler records attendance for every student of the class the teacher is class teacher of,
+// the students are found the same way as GetStudentsByClassTeacher finds them
+func MarkClassAttendanceDBHandler(ctx context.Context, req *pb.ClassAttendanceRequest, markedBy string) (*pb.MarkAttendanceResponse, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	defaultStatus := req.GetDefaultStatus()
+	if defaultStatus == "" {
+		defaultStatus = AttendancePresent
+	}
+	err = checkAttendanceRecord(req.GetDate(), defaultStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := findHomeroomClass(ctx, db, req.GetTeacherId())
+	if err != nil {
+		return nil, err
+	}
+	if class == nil {
+		return nil, fmt.Errorf("%w: teacher %s is not the class teacher of any class", ErrAttendance, req.GetTeacherId())
+	}
+
+	studentClasses, err := activeStudentClasses(ctx, db, bson.M{"class_id": class.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := make(map[string]*pb.StudentAttendance, len(req.GetExceptions()))
+	for _, exception := range req.GetExceptions() {
+		if _, ok := studentClasses[exception.StudentId]; !ok {
+			return nil, fmt.Errorf("%w: student %s is not in class %s", ErrAttendance, exception.StudentId, class.Name)
+		}
+		err = checkAttendanceRecord(req.GetDate(), exception.Status)
+		if err != nil {
+			return nil, err
+		}
+		exceptions[exception.StudentId] = exception
+	}
+
+	records := make([]*models.AttendanceRecord, 0, len(studentClasses))
+	for studentID := range studentClasses {
+		record := &models.AttendanceRecord{
+			StudentId: studentID,
+			ClassId:   class.Id,
+			Date:      req.GetDate(),
+			Period:    req.GetPeriod(),
+			Status:    defaultStatus,
+		}
+		if exception, ok := exceptions[studentID]; ok {
+			record.Status = exception.Status
+			record.Note = exception.Note
+		}
+		records = append(records, record)
+	}
+
+	return markAttendance(ctx, db, records, markedBy)
+}
+
+// CorrectAttendanceDBHandler changes the status of a record, the previous status and the reason are kept on the record
+func CorrectAttendanceDBHandler(ctx context.Context, id, newStatus, reason, correctedBy string) (*pb.AttendanceRecord, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required to correct attendance", ErrAttendance)
+	}
+	err = checkAttendanceRecord("", newStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := client.Database("school").Collection("attendance")
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+	}
+
+	var current models.AttendanceRecord
+	err = coll.FindOne(ctx, bson.M{"_id": objectID}).Decode(&current)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: attendance record %s does not exist", ErrAttendance, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if current.Status == newStatus {
+		return nil, fmt.Errorf("%w: attendance record %s already has status %s", ErrAttendance, id, newStatus)
+	}
+
+	correction := models.AttendanceCorrection{
+		PreviousStatus: current.Status,
+		Status:         newStatus,
+		Reason:         reason,
+		CorrectedBy:    correctedBy,
+		CorrectedAt:    time.Now().Format(time.RFC3339),
+	}
+
+	// the status in the filter makes sure two corrections at the same time do not lose each others history
+	var corrected models.AttendanceRecord
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = coll.FindOneAndUpdate(ctx,
+		bson.M{"_id": objectID, "status": current.Status},
+		bson.M{"$set": bson.M{"status": newStatus}, "$push": bson.M{"corrections": correction}},
+		opts).Decode(&corrected)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: attendance record %s was changed at the same time, try again", ErrAttendance, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	return MapModelToPbAttendanceRecord(&corrected), nil
+}
+
+// GetAttendanceDBHandler returns the records matching the filter ordered by date and period
+func GetAttendanceDBHandler(ctx context.Context, filter bson.M) ([]*pb.AttendanceRecord, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "period", Value: 1}, {Key: "student_id", Value: 1}})
+	cursor, err := client.Database("school").Collection("attendance").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var records []models.AttendanceRecord
+	err = cursor.All(ctx, &records)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	pbRecords := make([]*pb.AttendanceRecord, 0, len(records))
+	for i := range records {
+		pbRecords = append(pbRecords, MapModelToPbAttendanceRecord(&records[i]))
+	}
+	return pbRecords, nil
+}
+
+// GetStudentAttendanceSummaryDBHandler counts the records of a student in a term and/or between fromDate and toDate (all optional, inclusive)
+func GetStudentAttendanceSummaryDBHandler(ctx context.Context, studentID, term, fromDate, toDate string) (*pb.AttendanceSummary, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	summaries, err := attendanceSummaries(ctx, client.Database("school"), AttendanceDateFilter(attendanceTermFilter("student_id", studentID, term), fromDate, toDate))
+	if err != nil {
+		return nil, err
+	}
+
+	summary, ok := summaries[studentID]
+	if !ok {
+		summary = &pb.AttendanceSummary{StudentId: studentID}
+		setAttendanceRate(summary)
+	}
+	return summary, nil
+}
+
+// GetClassAttendanceSummaryDBHandler counts the records taken in a class in a term and/or between fromDate and toDate, per student and in total
+func GetClassAttendanceSummaryDBHandler(ctx context.Context, classID, term, fromDate, toDate string) (*pb.ClassAttendanceSummary, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	summaries, err := attendanceSummaries(ctx, client.Database("school"), AttendanceDateFilter(attendanceTermFilter("class_id", classID, term), fromDate, toDate))
+	if err != nil {
+		return nil, err
+	}
+
+	classSummary := &pb.ClassAttendanceSummary{ClassId: classID, Totals: &pb.AttendanceSummary{}}
+	for _, summary := range summaries {
+		classSummary.Totals.Present += summary.Present
+		classSummary.Totals.Absent += summary.Absent
+		classSummary.Totals.Late += summary.Late
+		classSummary.Totals.Excused += summary.Excused
+		classSummary.Totals.Total += summary.Total
+		classSummary.Students = append(classSummary.Students, summary)
+	}
+	setAttendanceRate(classSummary.Totals)
+
+	return classSummary, nil
+}
+
+// AttendanceDateFilter limits a filter to records from fromDate up to and including toDate, empty dates leave that side open
+func AttendanceDateFilter(filter bson.M, fromDate, toDate string) bson.M {
+	dateRange := bson.M{}
+	if fromDate != "" {
+		dateRange["$gte"] = fromDate
+	}
+	if toDate != "" {
+		dateRange["$lte"] = toDate
+	}
+	if len(dateRange) > 0 {
+		filter["date"] = dateRange
+	}
+	return filter
+}
+
+// attendanceTermFilter matches the records of a student or class, limited to a term when term is given
+func attendanceTermFilter(key, id, term string) bson.M {
+	filter := bson.M{key: id}
+	if term != "" {
+		filter["term"] = term
+	}
+	return filter
+}
+
+// markAttendance inserts the records in one bulk write, records that already exist are left as they are and reported as skipped.
+// attendance is only taken on school days, a date has to lie in a term and must not be a holiday
+func markAttendance(ctx context.Context, db *mongo.Database, records []*models.AttendanceRecord, markedBy string) (*pb.MarkAttendanceResponse, error) {
+	response := &pb.MarkAttendanceResponse{}
+	if len(records) == 0 {
+		return response, nil
+	}
+
+	terms := map[string]string{}
+	for _, record := range records {
+		if _, ok := terms[record.Date]; ok {
+			continue
+		}
+		term, err := schoolDayTerm(ctx, db, record.Date)
+		if err != nil {
+			return nil, err
+		}
+		terms[record.Date] = term
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	writes := make([]mongo.WriteModel, 0, len(records))
+	for _, record := range records {
+		record.MarkedBy = markedBy
+		record.MarkedAt = now
+		record.Term = terms[record.Date]
+		record.CorrectionLog = nil
+
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"student_id": record.StudentId, "date": record.Date, "period": record.Period}).
+			SetUpdate(bson.M{"$setOnInsert": record}).
+			SetUpsert(true))
+	}
+
+	result, err := db.Collection("attendance").BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to record attendance")
+	}
+
+	for i, record := range records {
+		upsertedID, ok := result.UpsertedIDs[int64(i)]
+		if !ok {
+			response.SkippedStudentIds = append(response.SkippedStudentIds, record.StudentId)
+			continue
+		}
+		if objectID, ok := upsertedID.(primitive.ObjectID); ok {
+			record.Id = objectID.Hex()
+		}
+		response.Records = append(response.Records, MapModelToPbAttendanceRecord(record))
+	}
+
+	return response, nil
+}
+
+// schoolDayTerm returns the name of the term a date lies in, dates outside every term and holidays are refused
+func schoolDayTerm(ctx context.Context, db *mongo.Database, date string) (string, error) {
+	term, err := termForDate(ctx, db, date)
+	if err != nil {
+		return "", err
+	}
+	if term == nil {
+		return "", fmt.Errorf("%w: %s is not in any term", ErrAttendance, date)
+	}
+
+	holiday, err := isHoliday(ctx, db, date)
+	if err != nil {
+		return "", err
+	}
+	if holiday {
+		return "", fmt.Errorf("%w: %s is a holiday", ErrAttendance, date)
+	}
+	return term.Name, nil
+}
+
+// activeStudentClasses returns the class_id of every active student matching the filter, keyed by student id
+func activeStudentClasses(ctx context.Context, db *mongo.Database, filter bson.M) (map[string]string, error) {
+	filter["deleted_at"] = nil
+
+	cursor, err := db.Collection("students").Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "class_id": 1}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var students []models.Student
+	err = cursor.All(ctx, &students)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	classes := make(map[string]string, len(students))
+	for _, student := range students {
+		classes[student.Id] = student.ClassId
+	}
+	return classes, nil
+}
+
+// attendanceSummaries counts the records matching the filter per student and status
+func attendanceSummaries(ctx context.Context, db *mongo.Database, filter bson.M) (map[string]*pb.AttendanceSummary, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"student_id": "$student_id", "status": "$status"},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := db.Collection("attendance").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Id struct {
+			StudentId string `bson:"student_id"`
+			Status    string `bson:"status"`
+		} `bson:"_id"`
+		Count int32 `bson:"count"`
+	}
+	err = cursor.All(ctx, &groups)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	summaries := map[string]*pb.AttendanceSummary{}
+	for _, group := range groups {
+		summary, ok := summaries[group.Id.StudentId]
+		if !ok {
+			summary = &pb.AttendanceSummary{StudentId: group.Id.StudentId}
+			summaries[group.Id.StudentId] = summary
+		}
+
+		switch group.Id.Status {
+		case AttendancePresent:
+			summary.Present += group.Count
+		case AttendanceAbsent:
+			summary.Absent += group.Count
+		case AttendanceLate:
+			summary.Late += group.Count
+		case AttendanceExcused:
+			summary.Excused += group.Count
+		}
+		summary.Total += group.Count
+	}
+
+	for _, summary := range summaries {
+		setAttendanceRate(summary)
+	}
+	return summaries, nil
+}
+
+// setAttendanceRate sets the share of attended records in percent, excused records are not counted against the student
+func setAttendanceRate(summary *pb.AttendanceSummary) {
+	counted := summary.Total - summary.Excused
+	if counted <= 0 {
+		summary.AttendanceRate = 100
+		return
+	}
+	rate := float64(summary.Present+summary.Late) / float64(counted) * 100
+	summary.AttendanceRate = math.Round(rate*100) / 100
+}
+
+// checkAttendanceRecord validates the status and, when given, the date (YYYY-MM-DD) of a record
+func checkAttendanceRecord(date, status string) error {
+	switch status {
+	case AttendancePresent, AttendanceAbsent, AttendanceLate, AttendanceExcused:
+	default:
+		return fmt.Errorf("%w: invalid attendance status %q", ErrAttendance, status)
+	}
+
+	if date != "" {
+		_, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return fmt.Errorf("%w: invalid date %q, expected YYYY-MM-DD", ErrAttendance, date)
+		}
+	}
+	return nil
+}
+
+// mustObjectIDs converts ids that were already validated by the request rules
+func mustObjectIDs(ids []string) []primitive.ObjectID {
+	objectIds := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectIds = append(objectIds, mustObjectID(id))
+	}
+	return objectIds
+}
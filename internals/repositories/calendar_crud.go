@@ -0,0 +1,687 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrCalendar is returned when a year, term or holiday does not fit the calendar or is still in use
+var ErrCalendar = errors.New("calendar integrity violation")
+
+// Add academic years to MongoDB, years can not overlap
+func AddAcademicYearsDBHandler(ctx context.Context, yearsFromReq []*pb.AcademicYear) ([]*pb.AcademicYear, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedYears []*pb.AcademicYear
+
+	for _, pbYear := range yearsFromReq {
+		year := MapPBToModelAcademicYear(pbYear)
+
+		if year.Name == "" {
+			return nil, fmt.Errorf("%w: academic year name is required", ErrCalendar)
+		}
+		err = checkAcademicYear(ctx, db, year, "")
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := db.Collection("academic_years").InsertOne(ctx, year)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding value into database")
+		}
+
+		objectID, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			year.Id = objectID.Hex()
+		}
+
+		addedYears = append(addedYears, MapModelToPbAcademicYear(year))
+	}
+
+	return addedYears, nil
+}
+
+// Get academic years from MongoDB with optional sorting
+func GetAcademicYearsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.AcademicYear, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findPage(ctx, client.Database("school").Collection("academic_years"), filter, sortOption, pageSize, pageNumber,
+		func() *models.AcademicYear { return &models.AcademicYear{} }, func() *pb.AcademicYear { return &pb.AcademicYear{} })
+}
+
+// Update academic years in MongoDB, the dates of a year must still contain its terms
+func UpdateAcademicYearsDBHandler(ctx context.Context, pbYears []*pb.AcademicYear) ([]*pb.AcademicYear, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedYears []*pb.AcademicYear
+
+	for _, pbYear := range pbYears {
+
+		// Validate ID
+		if pbYear.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findAcademicYear(ctx, db, pbYear.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		modelYear := MapPBToModelAcademicYear(pbYear)
+		merged := *current
+		if modelYear.Name != "" {
+			merged.Name = modelYear.Name
+		}
+		if modelYear.StartDate != "" {
+			merged.StartDate = modelYear.StartDate
+		}
+		if modelYear.EndDate != "" {
+			merged.EndDate = modelYear.EndDate
+		}
+
+		err = checkAcademicYear(ctx, db, &merged, current.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		// terms and holidays have to stay inside the year
+		for _, collection := range []string{"terms", "holidays"} {
+			count, err := db.Collection(collection).CountDocuments(ctx, bson.M{
+				"academic_year_id": current.Id,
+				"deleted_at":       nil,
+				"$or": bson.A{
+					bson.M{"start_date": bson.M{"$lt": merged.StartDate}},
+					bson.M{"end_date": bson.M{"$gt": merged.EndDate}},
+				},
+			})
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Internal error")
+			}
+			if count > 0 {
+				return nil, fmt.Errorf("%w: %d %s of academic year %s would fall outside its dates", ErrCalendar, count, collection, current.Name)
+			}
+		}
+
+		updateDoc, err := updateDocFromModel(modelYear)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = db.Collection("academic_years").UpdateOne(ctx, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, bson.M{"$set": updateDoc})
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating academic year id: %s", pbYear.Id))
+		}
+
+		updatedYears = append(updatedYears, MapModelToPbAcademicYear(&merged))
+	}
+
+	return updatedYears, nil
+}
+
+// delete academic years in mongoDB by id (soft delete), years that still have terms are refused
+func DeleteAcademicYearsDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := db.Collection("terms").CountDocuments(ctx, bson.M{"academic_year_id": bson.M{"$in": idsToDelete}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("%w: academic years still have %d terms", ErrCalendar, count)
+	}
+
+	return softDeleteByIDs(ctx, db.Collection("academic_years"), objectIds, deletedBy, "academic years")
+}
+
+// Add terms to MongoDB, a term lies inside its academic year and does not overlap the other terms
+func AddTermsDBHandler(ctx context.Context, termsFromReq []*pb.Term) ([]*pb.Term, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedTerms []*pb.Term
+
+	for _, pbTerm := range termsFromReq {
+		term := MapPBToModelTerm(pbTerm)
+
+		if term.Name == "" || term.AcademicYearId == "" {
+			return nil, fmt.Errorf("%w: term name and academic_year_id are required", ErrCalendar)
+		}
+		// terms are only closed by the year-end rollover
+		term.ClosedAt = ""
+		err = checkTerm(ctx, db, term, "")
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := db.Collection("terms").InsertOne(ctx, term)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding value into database")
+		}
+
+		objectID, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			term.Id = objectID.Hex()
+		}
+
+		addedTerms = append(addedTerms, MapModelToPbTerm(term))
+	}
+
+	return addedTerms, nil
+}
+
+// Get terms from MongoDB with optional sorting
+func GetTermsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Term, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findPage(ctx, client.Database("school").Collection("terms"), filter, sortOption, pageSize, pageNumber,
+		func() *models.Term { return &models.Term{} }, func() *pb.Term { return &pb.Term{} })
+}
+
+// Update terms in MongoDB, a term that is already used by courses can not be renamed
+func UpdateTermsDBHandler(ctx context.Context, pbTerms []*pb.Term) ([]*pb.Term, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedTerms []*pb.Term
+
+	for _, pbTerm := range pbTerms {
+
+		// Validate ID
+		if pbTerm.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findTermByID(ctx, db, pbTerm.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		modelTerm := MapPBToModelTerm(pbTerm)
+		merged := *current
+		if modelTerm.AcademicYearId != "" {
+			merged.AcademicYearId = modelTerm.AcademicYearId
+		}
+		if modelTerm.Name != "" {
+			merged.Name = modelTerm.Name
+		}
+		if modelTerm.StartDate != "" {
+			merged.StartDate = modelTerm.StartDate
+		}
+		if modelTerm.EndDate != "" {
+			merged.EndDate = modelTerm.EndDate
+		}
+
+		if merged.Name != current.Name {
+			count, err := db.Collection("courses").CountDocuments(ctx, bson.M{"term": current.Name})
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Internal error")
+			}
+			if count > 0 {
+				return nil, fmt.Errorf("%w: term %s is used by %d courses and can not be renamed", ErrCalendar, current.Name, count)
+			}
+		}
+
+		err = checkTerm(ctx, db, &merged, current.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		updateDoc, err := updateDocFromModel(modelTerm)
+		if err != nil {
+			return nil, err
+		}
+		delete(updateDoc, "closed_at")
+
+		_, err = db.Collection("terms").UpdateOne(ctx, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, bson.M{"$set": updateDoc})
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating term id: %s", pbTerm.Id))
+		}
+
+		updatedTerms = append(updatedTerms, MapModelToPbTerm(&merged))
+	}
+
+	return updatedTerms, nil
+}
+
+// delete terms in mongoDB by id (soft delete), terms that still have courses are refused
+func DeleteTermsDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := db.Collection("terms").Distinct(ctx, "name", bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	count, err := db.Collection("courses").CountDocuments(ctx, bson.M{"term": bson.M{"$in": names}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("%w: terms are still used by %d courses", ErrCalendar, count)
+	}
+
+	return softDeleteByIDs(ctx, db.Collection("terms"), objectIds, deletedBy, "terms")
+}
+
+// Add holidays to MongoDB, a holiday lies inside its academic year
+func AddHolidaysDBHandler(ctx context.Context, holidaysFromReq []*pb.Holiday) ([]*pb.Holiday, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedHolidays []*pb.Holiday
+
+	for _, pbHoliday := range holidaysFromReq {
+		holiday := MapPBToModelHoliday(pbHoliday)
+
+		if holiday.Name == "" || holiday.AcademicYearId == "" {
+			return nil, fmt.Errorf("%w: holiday name and academic_year_id are required", ErrCalendar)
+		}
+		// a single day holiday only needs a start date
+		if holiday.EndDate == "" {
+			holiday.EndDate = holiday.StartDate
+		}
+		err = checkDateRange(holiday.StartDate, holiday.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		year, err := findAcademicYear(ctx, db, holiday.AcademicYearId)
+		if err != nil {
+			return nil, err
+		}
+		if holiday.StartDate < year.StartDate || holiday.EndDate > year.EndDate {
+			return nil, fmt.Errorf("%w: holiday %s is outside academic year %s", ErrCalendar, holiday.Name, year.Name)
+		}
+
+		result, err := db.Collection("holidays").InsertOne(ctx, holiday)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding value into database")
+		}
+
+		objectID, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			holiday.Id = objectID.Hex()
+		}
+
+		addedHolidays = append(addedHolidays, MapModelToPbHoliday(holiday))
+	}
+
+	return addedHolidays, nil
+}
+
+// Get the active holidays of a year and/or overlapping a date range
+func GetHolidaysDBHandler(ctx context.Context, academicYearID, fromDate, toDate string) ([]*pb.Holiday, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{"deleted_at": nil}
+	if academicYearID != "" {
+		filter["academic_year_id"] = academicYearID
+	}
+	if fromDate != "" {
+		filter["end_date"] = bson.M{"$gte": fromDate}
+	}
+	if toDate != "" {
+		filter["start_date"] = bson.M{"$lte": toDate}
+	}
+
+	cursor, err := client.Database("school").Collection("holidays").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "start_date", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	return DecodedEntities(ctx, cursor, func() *models.Holiday { return &models.Holiday{} }, func() *pb.Holiday { return &pb.Holiday{} })
+}
+
+// delete holidays in mongoDB by id (soft delete)
+func DeleteHolidaysDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	return softDeleteByIDs(ctx, client.Database("school").Collection("holidays"), objectIds, deletedBy, "holidays")
+}
+
+// CurrentTermDBHandler returns the term that is current on date (today when empty), nil when no term has started yet
+func CurrentTermDBHandler(ctx context.Context, date string) (*models.Term, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return currentTerm(ctx, client.Database("school"), date)
+}
+
+// CurrentAcademicYearDBHandler returns the academic year of the current term, nil when no term has started yet
+func CurrentAcademicYearDBHandler(ctx context.Context) (*models.AcademicYear, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	term, err := currentTerm(ctx, db, "")
+	if err != nil || term == nil {
+		return nil, err
+	}
+	return findAcademicYear(ctx, db, term.AcademicYearId)
+}
+
+// FindTermDBHandler loads an active term by name
+func FindTermDBHandler(ctx context.Context, name string) (*models.Term, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findTerm(ctx, client.Database("school"), name)
+}
+
+// currentTerm is the latest open term that started on or before date, so between two terms the last one stays current
+// until the year-end rollover closes it
+func currentTerm(ctx context.Context, db *mongo.Database, date string) (*models.Term, error) {
+	if date == "" {
+		date = time.Now().Format(time.DateOnly)
+	}
+
+	var term models.Term
+	opts := options.FindOne().SetSort(bson.D{{Key: "start_date", Value: -1}})
+	err := db.Collection("terms").FindOne(ctx, bson.M{"start_date": bson.M{"$lte": date}, "closed_at": nil, "deleted_at": nil}, opts).Decode(&term)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &term, nil
+}
+
+// upcomingTerm is the first open term that starts after today, nil when there is none
+func upcomingTerm(ctx context.Context, db *mongo.Database) (*models.Term, error) {
+	today := time.Now().Format(time.DateOnly)
+
+	var term models.Term
+	opts := options.FindOne().SetSort(bson.D{{Key: "start_date", Value: 1}})
+	err := db.Collection("terms").FindOne(ctx, bson.M{"start_date": bson.M{"$gt": today}, "closed_at": nil, "deleted_at": nil}, opts).Decode(&term)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &term, nil
+}
+
+// termClosed reports whether the term was closed by the year-end rollover, terms from before the calendar are open
+func termClosed(ctx context.Context, db *mongo.Database, name string) (bool, error) {
+	term, err := findTerm(ctx, db, name)
+	if err != nil {
+		if errors.Is(err, ErrCalendar) {
+			return false, nil
+		}
+		return false, err
+	}
+	return term.ClosedAt != "", nil
+}
+
+// termForDate returns the term the date lies in, nil when the date is outside every term
+func termForDate(ctx context.Context, db *mongo.Database, date string) (*models.Term, error) {
+	var term models.Term
+	err := db.Collection("terms").FindOne(ctx, bson.M{
+		"start_date": bson.M{"$lte": date},
+		"end_date":   bson.M{"$gte": date},
+		"deleted_at": nil,
+	}).Decode(&term)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &term, nil
+}
+
+// isHoliday reports if the date falls on a holiday
+func isHoliday(ctx context.Context, db *mongo.Database, date string) (bool, error) {
+	count, err := db.Collection("holidays").CountDocuments(ctx, bson.M{
+		"start_date": bson.M{"$lte": date},
+		"end_date":   bson.M{"$gte": date},
+		"deleted_at": nil,
+	})
+	if err != nil {
+		return false, utils.ErrorHandler(err, "Internal error")
+	}
+	return count > 0, nil
+}
+
+// findTerm loads an active term by name
+func findTerm(ctx context.Context, db *mongo.Database, name string) (*models.Term, error) {
+	var term models.Term
+	err := db.Collection("terms").FindOne(ctx, bson.M{"name": name, "deleted_at": nil}).Decode(&term)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: term %s does not exist", ErrCalendar, name)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &term, nil
+}
+
+// findTermByID loads an active term by id
+func findTermByID(ctx context.Context, db *mongo.Database, id string) (*models.Term, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid term id: %v", id))
+	}
+
+	var term models.Term
+	err = db.Collection("terms").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&term)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: term %s does not exist", ErrCalendar, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &term, nil
+}
+
+// findAcademicYear loads an active academic year by id
+func findAcademicYear(ctx context.Context, db *mongo.Database, id string) (*models.AcademicYear, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid academic year id: %v", id))
+	}
+
+	var year models.AcademicYear
+	err = db.Collection("academic_years").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&year)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: academic year %s does not exist", ErrCalendar, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &year, nil
+}
+
+// checkAcademicYear validates the dates and makes sure the name is free and the year does not overlap another year
+func checkAcademicYear(ctx context.Context, db *mongo.Database, year *models.AcademicYear, exceptID string) error {
+	err := checkDateRange(year.StartDate, year.EndDate)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"name": year.Name},
+			bson.M{"start_date": bson.M{"$lte": year.EndDate}, "end_date": bson.M{"$gte": year.StartDate}},
+		},
+	}
+	if exceptID != "" {
+		filter["_id"] = bson.M{"$ne": mustObjectID(exceptID)}
+	}
+
+	count, err := db.Collection("academic_years").CountDocuments(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: academic year %s has the name or overlaps the dates of another year", ErrCalendar, year.Name)
+	}
+	return nil
+}
+
+// checkTerm validates the dates, keeps the term inside its year and makes sure the name is free and the terms do not overlap
+func checkTerm(ctx context.Context, db *mongo.Database, term *models.Term, exceptID string) error {
+	err := checkDateRange(term.StartDate, term.EndDate)
+	if err != nil {
+		return err
+	}
+
+	year, err := findAcademicYear(ctx, db, term.AcademicYearId)
+	if err != nil {
+		return err
+	}
+	if term.StartDate < year.StartDate || term.EndDate > year.EndDate {
+		return fmt.Errorf("%w: term %s is outside academic year %s", ErrCalendar, term.Name, year.Name)
+	}
+
+	filter := bson.M{
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"name": term.Name},
+			bson.M{"start_date": bson.M{"$lte": term.EndDate}, "end_date": bson.M{"$gte": term.StartDate}},
+		},
+	}
+	if exceptID != "" {
+		filter["_id"] = bson.M{"$ne": mustObjectID(exceptID)}
+	}
+
+	count, err := db.Collection("terms").CountDocuments(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: term %s has the name or overlaps the dates of another term", ErrCalendar, term.Name)
+	}
+	return nil
+}
+
+// checkDateRange makes sure both dates are YYYY-MM-DD and start is not after end
+func checkDateRange(startDate, endDate string) error {
+	start, err := time.Parse(time.DateOnly, startDate)
+	if err != nil {
+		return fmt.Errorf("%w: invalid start_date %q, expected YYYY-MM-DD", ErrCalendar, startDate)
+	}
+	end, err := time.Parse(time.DateOnly, endDate)
+	if err != nil {
+		return fmt.Errorf("%w: invalid end_date %q, expected YYYY-MM-DD", ErrCalendar, endDate)
+	}
+	if end.Before(start) {
+		return fmt.Errorf("%w: end_date %s is before start_date %s", ErrCalendar, endDate, startDate)
+	}
+	return nil
+}
+
+// softDeleteByIDs marks the active documents with the given ids as deleted
+func softDeleteByIDs(ctx context.Context, coll *mongo.Collection, objectIds []primitive.ObjectID, deletedBy, what string) ([]string, error) {
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	res, err := coll.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	if res.ModifiedCount == 0 {
+		return nil, utils.ErrorHandler(fmt.Errorf("no %s matched the ids", what), fmt.Sprintf("No %s were deleted", what))
+	}
+
+	return hexIDs(objectIds), nil
+}
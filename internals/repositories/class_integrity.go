@@ -0,0 +1,368 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// students and teachers reference a class document by class_id, the class name is copied into their class field.
+// the homeroom teacher of a class is stored on the class document, these rules keep both sides in sync.
+
+// ErrClassIntegrity is returned when a write would leave a class without its teacher or point to a class that does not exist
+var ErrClassIntegrity = errors.New("class integrity violation")
+
+// ClassIssue is one problem found by the consistency check
+type ClassIssue struct {
+	Class        string
+	ClassId      string
+	StudentCount int64
+	TeacherId    string
+	Problem      string
+}
+
+// findClass loads an active class by id, or by name when no id is given
+func findClass(ctx context.Context, db *mongo.Database, classID, name string) (*models.Class, error) {
+	filter := bson.M{"deleted_at": nil}
+	if classID != "" {
+		objectID, err := primitive.ObjectIDFromHex(classID)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid class id: %v", classID))
+		}
+		filter["_id"] = objectID
+	} else {
+		filter["name"] = name
+	}
+
+	var class models.Class
+	err := db.Collection("classes").FindOne(ctx, filter).Decode(&class)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: class %s%s does not exist", ErrClassIntegrity, classID, name)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &class, nil
+}
+
+// findHomeroomClass loads the active class the teacher is homeroom teacher of, nil when the teacher has no class
+func findHomeroomClass(ctx context.Context, db *mongo.Database, teacherID string) (*models.Class, error) {
+	var class models.Class
+	err := db.Collection("classes").FindOne(ctx, bson.M{"homeroom_teacher_id": teacherID, "deleted_at": nil}).Decode(&class)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, utils.ErrorHandler(err, "Failed to retrive class")
+	}
+	return &class, nil
+}
+
+// resolveClassRef fills class_id and the class name of a student or teacher from the class document.
+// nil is returned when the record does not reference a class.
+func resolveClassRef(ctx context.Context, db *mongo.Database, classID, className *string) (*models.Class, error) {
+	if *classID == "" && *className == "" {
+		return nil, nil
+	}
+
+	class, err := findClass(ctx, db, *classID, *className)
+	if err != nil {
+		return nil, err
+	}
+	*classID = class.Id
+	*className = class.Name
+	return class, nil
+}
+
+// findActiveTeacher loads a teacher that is not soft deleted
+func findActiveTeacher(ctx context.Context, db *mongo.Database, id string) (*models.Teacher, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+	}
+
+	var teacher models.Teacher
+	err = db.Collection("teachers").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&teacher)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Teacher not found: %v", id))
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &teacher, nil
+}
+
+// setHomeroomTeacher makes teacherID the homeroom teacher of the class and keeps class/class_id on the teachers in sync.
+// an empty teacherID leaves the class without a homeroom teacher.
+func setHomeroomTeacher(ctx context.Context, db *mongo.Database, class *models.Class, teacherID string) error {
+	classObjID, err := primitive.ObjectIDFromHex(class.Id)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	// a teacher is homeroom teacher of one class only
+	if teacherID != "" {
+		_, err = db.Collection("classes").UpdateMany(ctx,
+			bson.M{"_id": bson.M{"$ne": classObjID}, "homeroom_teacher_id": teacherID},
+			bson.M{"$unset": bson.M{"homeroom_teacher_id": ""}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to release previous class")
+		}
+	}
+
+	// releasing the previous homeroom teacher
+	if class.HomeroomTeacherId != "" && class.HomeroomTeacherId != teacherID {
+		previousID, err := primitive.ObjectIDFromHex(class.HomeroomTeacherId)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		_, err = db.Collection("teachers").UpdateOne(ctx, bson.M{"_id": previousID}, bson.M{"$unset": bson.M{"class": "", "class_id": ""}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to release previous homeroom teacher")
+		}
+	}
+
+	classUpdate := bson.M{"$unset": bson.M{"homeroom_teacher_id": ""}}
+	if teacherID != "" {
+		teacherObjID, err := primitive.ObjectIDFromHex(teacherID)
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", teacherID))
+		}
+		_, err = db.Collection("teachers").UpdateOne(ctx, bson.M{"_id": teacherObjID}, bson.M{"$set": bson.M{"class": class.Name, "class_id": class.Id}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to set homeroom teacher")
+		}
+		classUpdate = bson.M{"$set": bson.M{"homeroom_teacher_id": teacherID}}
+	}
+
+	_, err = db.Collection("classes").UpdateOne(ctx, bson.M{"_id": classObjID}, classUpdate)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to set homeroom teacher")
+	}
+
+	err = recordMembershipsByID(ctx, db, MemberTeacher, class.HomeroomTeacherId, teacherID)
+	if err != nil {
+		return err
+	}
+	class.HomeroomTeacherId = teacherID
+	return nil
+}
+
+// checkStudentClass makes sure a student is only moved into a class that has a homeroom teacher
+func checkStudentClass(class *models.Class) error {
+	if class == nil {
+		return nil
+	}
+	if class.HomeroomTeacherId == "" {
+		return fmt.Errorf("%w: class %s has no homeroom teacher, use ReassignClass to move a class", ErrClassIntegrity, class.Name)
+	}
+	return nil
+}
+
+// checkTeacherClassChange makes sure a teacher does not leave students behind or take a class from another teacher
+func checkTeacherClassChange(ctx context.Context, db *mongo.Database, teacherID primitive.ObjectID, newClass *models.Class) error {
+	var current models.Teacher
+	err := db.Collection("teachers").FindOne(ctx, bson.M{"_id": teacherID, "deleted_at": nil}).Decode(&current)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return utils.ErrorHandler(err, fmt.Sprintf("Teacher not found: %v", teacherID.Hex()))
+		}
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	// class is not part of the update or did not change
+	if newClass == nil || newClass.Id == current.ClassId {
+		return nil
+	}
+
+	if current.ClassId != "" {
+		count, err := db.Collection("students").CountDocuments(ctx, classStudentsFilter(current.ClassId))
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: class %s still has %d students, use ReassignClass to move the teacher", ErrClassIntegrity, current.Class, count)
+		}
+	}
+
+	if newClass.HomeroomTeacherId != "" && newClass.HomeroomTeacherId != teacherID.Hex() {
+		return fmt.Errorf("%w: class %s already has homeroom teacher %s", ErrClassIntegrity, newClass.Name, newClass.HomeroomTeacherId)
+	}
+	return nil
+}
+
+// ReassignClassDBHandler moves every student of fromClass into toClass together with the homeroom teacher.
+// When teacherID is given that teacher becomes the homeroom teacher of toClass and the previous one is released.
+func ReassignClassDBHandler(ctx context.Context, fromClass, toClass, teacherID string) (int64, string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, "", utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	if fromClass == toClass && teacherID == "" {
+		return 0, "", fmt.Errorf("%w: nothing to reassign, classes are the same and no teacher was given", ErrClassIntegrity)
+	}
+
+	from, err := findClass(ctx, db, "", fromClass)
+	if err != nil {
+		return 0, "", err
+	}
+	to, err := findClass(ctx, db, "", toClass)
+	if err != nil {
+		return 0, "", err
+	}
+
+	// the teacher that ends up owning toClass
+	newTeacherID := teacherID
+	if newTeacherID == "" {
+		newTeacherID = from.HomeroomTeacherId
+	}
+	if newTeacherID == "" {
+		return 0, "", fmt.Errorf("%w: class %s has no homeroom teacher, pass a teacher id", ErrClassIntegrity, fromClass)
+	}
+
+	newTeacher, err := findActiveTeacher(ctx, db, newTeacherID)
+	if err != nil {
+		return 0, "", err
+	}
+	if newTeacher.ClassId != "" && newTeacher.ClassId != from.Id && newTeacher.ClassId != to.Id {
+		return 0, "", fmt.Errorf("%w: teacher %s already owns class %s", ErrClassIntegrity, newTeacher.Id, newTeacher.Class)
+	}
+
+	if from.Id != to.Id && to.HomeroomTeacherId != "" && to.HomeroomTeacherId != newTeacher.Id {
+		return 0, "", fmt.Errorf("%w: class %s already has homeroom teacher %s", ErrClassIntegrity, toClass, to.HomeroomTeacherId)
+	}
+
+	var moved int64
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		// moving the students
+		res, err := db.Collection("students").UpdateMany(sc,
+			bson.M{"class_id": from.Id, "deleted_at": nil},
+			bson.M{"$set": bson.M{"class": to.Name, "class_id": to.Id}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to move students")
+		}
+		moved = res.ModifiedCount
+
+		err = recordClassMemberships(sc, db, MemberStudent, bson.M{"class_id": to.Id, "deleted_at": nil})
+		if err != nil {
+			return err
+		}
+
+		// the old class is left without a homeroom teacher, then the teacher takes over the new one
+		if from.Id != to.Id {
+			err = setHomeroomTeacher(sc, db, from, "")
+			if err != nil {
+				return err
+			}
+		}
+		return setHomeroomTeacher(sc, db, to, newTeacher.Id)
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	return moved, newTeacher.Id, nil
+}
+
+// CheckClassConsistencyDBHandler reports classes that students are in but that have no homeroom teacher or do not exist
+func CheckClassConsistencyDBHandler(ctx context.Context) ([]ClassIssue, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	// counting active students per class
+	studentCursor, err := db.Collection("students").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted_at": nil}}},
+		{{Key: "$group", Value: bson.M{"_id": bson.M{"class_id": "$class_id", "class": "$class"}, "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer studentCursor.Close(ctx)
+
+	var studentGroups []struct {
+		Key struct {
+			ClassId string `bson:"class_id"`
+			Class   string `bson:"class"`
+		} `bson:"_id"`
+		Count int64 `bson:"count"`
+	}
+	err = studentCursor.All(ctx, &studentGroups)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	// loading the active classes
+	classCursor, err := db.Collection("classes").Find(ctx, bson.M{"deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer classCursor.Close(ctx)
+
+	var classes []models.Class
+	err = classCursor.All(ctx, &classes)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	classByID := make(map[string]models.Class, len(classes))
+	for _, class := range classes {
+		classByID[class.Id] = class
+	}
+
+	var issues []ClassIssue
+	for _, group := range studentGroups {
+		if group.Key.ClassId == "" && group.Key.Class == "" {
+			continue // students without a class are not a class issue
+		}
+
+		issue := ClassIssue{Class: group.Key.Class, ClassId: group.Key.ClassId, StudentCount: group.Count}
+
+		class, ok := classByID[group.Key.ClassId]
+		switch {
+		case group.Key.ClassId == "":
+			issue.Problem = "class is not migrated to a class document"
+		case !ok:
+			issue.Problem = "class does not exist"
+		case class.HomeroomTeacherId == "":
+			issue.Problem = "no homeroom teacher"
+		default:
+			_, err := findActiveTeacher(ctx, db, class.HomeroomTeacherId)
+			if err == nil {
+				continue
+			}
+			issue.TeacherId = class.HomeroomTeacherId
+			issue.Problem = "homeroom teacher does not exist"
+		}
+		issues = append(issues, issue)
+	}
+
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Class < issues[j].Class })
+	return issues, nil
+}
+
+// classStudentsFilter matches the students of the class that GetStudents lists by default, withdrawn and graduated
+// students are left out
+func classStudentsFilter(classID string) bson.M {
+	return bson.M{
+		"class_id":          classID,
+		"deleted_at":        nil,
+		"enrollment_status": bson.M{"$in": bson.A{StudentActive, nil}},
+	}
+}
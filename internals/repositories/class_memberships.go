@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	MemberStudent = "student"
+	MemberTeacher = "teacher"
+)
+
+/*
+Students and teachers only carry the class they are in now. Every write that changes a class records it in
+class_memberships for the current term as well, so the class of an earlier term can still be looked up after the
+student moved on. Nothing is recorded while there is neither a current nor an upcoming term.
+*/
+
+// ClassMembershipsDBHandler returns the class memberships of a term keyed by member id, limited to one class when
+// classID or className is given
+func ClassMembershipsDBHandler(ctx context.Context, memberType, term, classID, className string) (map[string]models.ClassMembership, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{"member_type": memberType, "term": term}
+	if classID != "" {
+		filter["class_id"] = classID
+	}
+	if className != "" {
+		filter["class_name"] = className
+	}
+
+	cursor, err := client.Database("school").Collection("class_memberships").Find(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var memberships []models.ClassMembership
+	err = cursor.All(ctx, &memberships)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	byMember := make(map[string]models.ClassMembership, len(memberships))
+	for _, membership := range memberships {
+		byMember[membership.MemberId] = membership
+	}
+	return byMember, nil
+}
+
+// recordClassMemberships copies the current class of the students or teachers matching filter into the current term.
+// after the rollover closed the old term the classes already belong to the upcoming term
+func recordClassMemberships(ctx context.Context, db *mongo.Database, memberType string, filter bson.M) error {
+	term, err := currentTerm(ctx, db, "")
+	if err != nil {
+		return err
+	}
+	if term == nil {
+		term, err = upcomingTerm(ctx, db)
+		if err != nil || term == nil {
+			return err
+		}
+	}
+	return recordTermMemberships(ctx, db, term.Name, memberType, filter)
+}
+
+// recordTermMemberships copies the current class of the students or teachers matching filter into term,
+// members that are no longer in a class lose their membership for the term
+func recordTermMemberships(ctx context.Context, db *mongo.Database, term, memberType string, filter bson.M) error {
+
+	collection := "students"
+	if memberType == MemberTeacher {
+		collection = "teachers"
+	}
+
+	cursor, err := db.Collection(collection).Find(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	var members []models.Student
+	err = cursor.All(ctx, &members)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	writes := make([]mongo.WriteModel, 0, len(members))
+	for _, member := range members {
+		key := bson.M{"member_type": memberType, "member_id": member.Id, "term": term}
+		if member.ClassId == "" {
+			writes = append(writes, mongo.NewDeleteOneModel().SetFilter(key))
+			continue
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(key).
+			SetUpdate(bson.M{"$set": bson.M{"class_id": member.ClassId, "class_name": member.Class, "updated_at": now}}).
+			SetUpsert(true))
+	}
+
+	_, err = db.Collection("class_memberships").BulkWrite(ctx, writes)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to record class memberships")
+	}
+	return nil
+}
+
+// recordMembershipsByID records the memberships of the given members
+func recordMembershipsByID(ctx context.Context, db *mongo.Database, memberType string, ids ...string) error {
+	objectIds := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return utils.ErrorHandler(err, "Invalid id")
+		}
+		objectIds = append(objectIds, objectID)
+	}
+	if len(objectIds) == 0 {
+		return nil
+	}
+	return recordClassMemberships(ctx, db, memberType, bson.M{"_id": bson.M{"$in": objectIds}})
+}
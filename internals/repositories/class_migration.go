@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MigrateClassesDBHandler turns the free text class strings of students and teachers into class documents
+// and sets class_id on every student and teacher. It can be run more than once, existing classes are reused.
+func MigrateClassesDBHandler(ctx context.Context) (int, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	// every class name used by a student or a teacher
+	names := map[string]bool{}
+	for _, coll := range []string{"students", "teachers"} {
+		values, err := db.Collection(coll).Distinct(ctx, "class", bson.M{"class": bson.M{"$nin": bson.A{nil, ""}}})
+		if err != nil {
+			return 0, utils.ErrorHandler(err, "Failed to read classes of "+coll)
+		}
+		for _, v := range values {
+			if name, ok := v.(string); ok {
+				names[name] = true
+			}
+		}
+	}
+
+	created := 0
+	for name := range names {
+		class, err := findClass(ctx, db, "", name)
+		if err != nil && !errors.Is(err, ErrClassIntegrity) {
+			return created, err
+		}
+		if err != nil {
+			// class document does not exist yet
+			grade, section := parseClassName(name)
+			class = &models.Class{Name: name, GradeLevel: grade, Section: section}
+
+			result, err := db.Collection("classes").InsertOne(ctx, class)
+			if err != nil {
+				return created, utils.ErrorHandler(err, "Failed to create class "+name)
+			}
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				class.Id = objectID.Hex()
+			}
+			created++
+		}
+
+		// pointing students and teachers to the class document
+		for _, coll := range []string{"students", "teachers"} {
+			_, err = db.Collection(coll).UpdateMany(ctx, bson.M{"class": name, "class_id": nil}, bson.M{"$set": bson.M{"class_id": class.Id}})
+			if err != nil {
+				return created, utils.ErrorHandler(err, "Failed to set class id on "+coll)
+			}
+		}
+		err = recordClassMemberships(ctx, db, MemberStudent, bson.M{"class_id": class.Id, "deleted_at": nil})
+		if err != nil {
+			return created, err
+		}
+
+		// the teacher with the class string becomes the homeroom teacher
+		if class.HomeroomTeacherId != "" {
+			continue
+		}
+		var teachers []models.Teacher
+		cursor, err := db.Collection("teachers").Find(ctx, bson.M{"class_id": class.Id, "deleted_at": nil})
+		if err != nil {
+			return created, utils.ErrorHandler(err, "Internal error")
+		}
+		err = cursor.All(ctx, &teachers)
+		if err != nil {
+			return created, utils.ErrorHandler(err, "Internal error")
+		}
+
+		switch len(teachers) {
+		case 0:
+		case 1:
+			err = setHomeroomTeacher(ctx, db, class, teachers[0].Id)
+			if err != nil {
+				return created, err
+			}
+		default:
+			log.Printf("class %s has %d teachers, set the homeroom teacher with UpdateClasses\n", name, len(teachers))
+		}
+	}
+
+	return created, nil
+}
+
+// parseClassName splits names like "9A", "10 B" or "A1" into grade level and section
+func parseClassName(name string) (int32, string) {
+	name = strings.ReplaceAll(name, " ", "")
+
+	digitsFirst := strings.IndexFunc(name, func(r rune) bool { return !unicode.IsDigit(r) })
+	if digitsFirst > 0 {
+		grade, _ := strconv.Atoi(name[:digitsFirst])
+		return int32(grade), name[digitsFirst:]
+	}
+
+	lettersFirst := strings.IndexFunc(name, unicode.IsDigit)
+	if lettersFirst > 0 {
+		grade, err := strconv.Atoi(name[lettersFirst:])
+		if err == nil {
+			return int32(grade), name[:lettersFirst]
+		}
+	}
+
+	if grade, err := strconv.Atoi(name); err == nil {
+		return int32(grade), ""
+	}
+	return 0, name
+}
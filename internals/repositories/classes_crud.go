@@ -0,0 +1,336 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Add classes to MongoDB
+func AddClassesDBHandler(ctx context.Context, classesFromReq []*pb.Class) ([]*pb.Class, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		utils.ErrorHandler(err, "internal error")
+		return nil, err
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	// Convert pb -> model
+	newClasses := make([]*models.Class, 0, len(classesFromReq))
+	for _, pbClass := range classesFromReq {
+		newClasses = append(newClasses, MapPBToModelClass(pbClass))
+	}
+
+	var addedClasses []*pb.Class
+
+	for _, class := range newClasses {
+		if class == nil {
+			continue
+		}
+
+		// the name is what students and teachers see, e.g. grade 9 section A -> "9A"
+		if class.Name == "" {
+			class.Name = fmt.Sprintf("%d%s", class.GradeLevel, class.Section)
+		}
+
+		err = checkClassNameFree(ctx, db, class.Name, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// the homeroom teacher is set after insert so the teacher gets the class id
+		homeroomTeacherID := class.HomeroomTeacherId
+		class.HomeroomTeacherId = ""
+		if homeroomTeacherID != "" {
+			teacher, err := findActiveTeacher(ctx, db, homeroomTeacherID)
+			if err != nil {
+				return nil, err
+			}
+			if teacher.ClassId != "" {
+				return nil, fmt.Errorf("%w: teacher %s already owns class %s", ErrClassIntegrity, teacher.Id, teacher.Class)
+			}
+		}
+
+		// Insert into MongoDB, together with the class.created event
+		class.Id = ""
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			result, err := db.Collection("classes").InsertOne(sc, class)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+
+			// Save generated Mongo ID
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				class.Id = objectID.Hex()
+			}
+
+			if homeroomTeacherID != "" {
+				err = setHomeroomTeacher(sc, db, class, homeroomTeacherID)
+				if err != nil {
+					return err
+				}
+			}
+			return recordEntityEvents(sc, db, "classes", ActionCreated, []string{class.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Convert model -> pb for response
+		addedClasses = append(addedClasses, MapModelToPbClass(class))
+	}
+
+	return addedClasses, nil
+}
+
+// Get classes from MongoDB with optional sorting
+func GetClassesDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Class, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("classes")
+
+	findOptions := options.Find()
+
+	findOptions.SetSkip(int64((pageNumber - 1) * pageSize))
+	findOptions.SetLimit(int64(pageSize))
+
+	if len(sortOption) > 0 {
+		findOptions.SetSort(sortOption)
+	}
+	cursor, err := coll.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	// Decode Mongo documents -> pb classes
+	classes, err := DecodedEntities(ctx, cursor, func() *models.Class { return &models.Class{} }, func() *pb.Class { return &pb.Class{} })
+	if err != nil {
+		return nil, err
+	}
+
+	return classes, nil
+}
+
+// Update classes in MongoDB, a new name is copied to the students and teachers of the class
+func UpdateClassesDBHandler(ctx context.Context, pbClasses []*pb.Class) ([]*pb.Class, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedClasses []*pb.Class
+
+	for _, pbClass := range pbClasses {
+
+		// Validate ID
+		if pbClass.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		// Convert pb -> model
+		modelClass := MapPBToModelClass(pbClass)
+
+		current, err := findClass(ctx, db, modelClass.Id, "")
+		if err != nil {
+			return nil, err
+		}
+		obj, err := primitive.ObjectIDFromHex(current.Id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "invalid id")
+		}
+
+		renamed := modelClass.Name != "" && modelClass.Name != current.Name
+		if renamed {
+			err = checkClassNameFree(ctx, db, modelClass.Name, current.Id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		newTeacherID := modelClass.HomeroomTeacherId
+		if newTeacherID != "" && newTeacherID != current.HomeroomTeacherId {
+			teacher, err := findActiveTeacher(ctx, db, newTeacherID)
+			if err != nil {
+				return nil, err
+			}
+			if teacher.ClassId != "" && teacher.ClassId != current.Id {
+				return nil, fmt.Errorf("%w: teacher %s already owns class %s", ErrClassIntegrity, teacher.Id, teacher.Class)
+			}
+		}
+
+		// Convert model -> bson
+		mclass, err := bson.Marshal(modelClass)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+
+		var updateDoc bson.M
+		err = bson.Unmarshal(mclass, &updateDoc)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+
+		// Remove _id, the soft delete fields and the homeroom teacher (set separately) from update
+		delete(updateDoc, "_id")
+		delete(updateDoc, "deleted_at")
+		delete(updateDoc, "deleted_by")
+		delete(updateDoc, "homeroom_teacher_id")
+
+		if renamed {
+			current.Name = modelClass.Name
+		}
+
+		// Update in MongoDB, together with the class.updated event
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			if len(updateDoc) > 0 {
+				_, err := db.Collection("classes").UpdateOne(sc, bson.M{"_id": obj, "deleted_at": nil}, bson.M{"$set": updateDoc})
+				if err != nil {
+					return utils.ErrorHandler(err, fmt.Sprintf("error updating class id: %s", pbClass.Id))
+				}
+			}
+
+			// the class name is copied on students and teachers
+			if renamed {
+				for _, coll := range []string{"students", "teachers"} {
+					_, err := db.Collection(coll).UpdateMany(sc, bson.M{"class_id": current.Id}, bson.M{"$set": bson.M{"class": current.Name}})
+					if err != nil {
+						return utils.ErrorHandler(err, "Failed to rename class on "+coll)
+					}
+				}
+				for _, memberType := range []string{MemberStudent, MemberTeacher} {
+					err := recordClassMemberships(sc, db, memberType, bson.M{"class_id": current.Id})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			if newTeacherID != "" && newTeacherID != current.HomeroomTeacherId {
+				err := setHomeroomTeacher(sc, db, current, newTeacherID)
+				if err != nil {
+					return err
+				}
+			}
+			return recordEntityEvents(sc, db, "classes", ActionUpdated, []string{current.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Convert model -> pb for response
+		updatedClasses = append(updatedClasses, MapModelToPbClass(modelClass))
+	}
+
+	return updatedClasses, nil
+}
+
+// delete classes in mongoDB by id (soft delete), classes that still have students are refused
+func DeleteClassesDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	// Convert to Mongo ObjectIDs
+	objectIds := make([]primitive.ObjectID, 0, len(idsToDelete))
+	for _, id := range idsToDelete {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+		}
+		objectIds = append(objectIds, objectId)
+	}
+
+	count, err := db.Collection("students").CountDocuments(ctx, bson.M{"class_id": bson.M{"$in": idsToDelete}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("%w: %d students are still in the classes, move them with ReassignClass first", ErrClassIntegrity, count)
+	}
+
+	// Soft delete many by IDs
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		ids, err := matchingIDs(sc, db.Collection("classes"), filter)
+		if err != nil {
+			return err
+		}
+
+		res, err := db.Collection("classes").UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no classes matched the ids"), "No classes were deleted")
+		}
+
+		// releasing the homeroom teachers of the deleted classes
+		_, err = db.Collection("teachers").UpdateMany(sc, bson.M{"class_id": bson.M{"$in": idsToDelete}}, bson.M{"$unset": bson.M{"class": "", "class_id": ""}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		return recordEntityEvents(sc, db, "classes", ActionDeleted, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return deleted IDs
+	deletedIds := make([]string, 0, len(objectIds))
+	for _, v := range objectIds {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// class names are unique among active classes because students and teachers can still reference a class by name
+func checkClassNameFree(ctx context.Context, db *mongo.Database, name, exceptID string) error {
+	filter := bson.M{"name": name, "deleted_at": nil}
+	if exceptID != "" {
+		objectID, err := primitive.ObjectIDFromHex(exceptID)
+		if err != nil {
+			return utils.ErrorHandler(err, "Invalid id")
+		}
+		filter["_id"] = bson.M{"$ne": objectID}
+	}
+
+	count, err := db.Collection("classes").CountDocuments(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: class %s already exists", ErrClassIntegrity, name)
+	}
+	return nil
+}
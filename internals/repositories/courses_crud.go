@@ -0,0 +1,385 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Add courses to MongoDB
+func AddCoursesDBHandler(ctx context.Context, coursesFromReq []*pb.Course) ([]*pb.Course, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		utils.ErrorHandler(err, "internal error")
+		return nil, err
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedCourses []*pb.Course
+
+	for _, pbCourse := range coursesFromReq {
+		course := MapPBToModelCourse(pbCourse)
+
+		if course.SubjectId == "" || course.ClassId == "" || course.TeacherId == "" || course.Term == "" {
+			return nil, fmt.Errorf("%w: subject_id, class_id, teacher_id and term are required", ErrCourseIntegrity)
+		}
+
+		err = checkCourseRefs(ctx, db, course, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// seats are only counted by the enrollment service
+		course.EnrolledCount = 0
+		course.WaitlistSeq = 0
+
+		// the course.created event is written with the course
+		course.Id = ""
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			result, err := db.Collection("courses").InsertOne(sc, course)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				course.Id = objectID.Hex()
+			}
+			return recordEntityEvents(sc, db, "courses", ActionCreated, []string{course.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		addedCourses = append(addedCourses, MapModelToPbCourse(course))
+	}
+
+	return addedCourses, nil
+}
+
+// Get courses from MongoDB with optional sorting
+func GetCoursesDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Course, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findPage(ctx, client.Database("school").Collection("courses"), filter, sortOption, pageSize, pageNumber,
+		func() *models.Course { return &models.Course{} }, func() *pb.Course { return &pb.Course{} })
+}
+
+// Update courses in MongoDB, changed subject/class/teacher references are checked again
+func UpdateCoursesDBHandler(ctx context.Context, pbCourses []*pb.Course) ([]*pb.Course, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedCourses []*pb.Course
+
+	for _, pbCourse := range pbCourses {
+
+		// Validate ID
+		if pbCourse.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		modelCourse := MapPBToModelCourse(pbCourse)
+
+		obj, err := primitive.ObjectIDFromHex(modelCourse.Id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "invalid id")
+		}
+
+		// the stored course is merged with the update so the references are checked as they will be saved
+		var current models.Course
+		err = db.Collection("courses").FindOne(ctx, bson.M{"_id": obj, "deleted_at": nil}).Decode(&current)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, fmt.Errorf("%w: course %s does not exist", ErrCourseIntegrity, pbCourse.Id)
+			}
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		merged := current
+		if modelCourse.SubjectId != "" {
+			merged.SubjectId = modelCourse.SubjectId
+		}
+		if modelCourse.ClassId != "" {
+			merged.ClassId = modelCourse.ClassId
+		}
+		if modelCourse.TeacherId != "" {
+			merged.TeacherId = modelCourse.TeacherId
+		}
+		if modelCourse.Term != "" {
+			merged.Term = modelCourse.Term
+		}
+
+		err = checkCourseRefs(ctx, db, &merged, current.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		// the timetable of the course has to fit the new teacher, class or term, it is checked in the transaction
+		moved := merged.TeacherId != current.TeacherId || merged.ClassId != current.ClassId || merged.Term != current.Term
+
+		updateDoc, err := updateDocFromModel(modelCourse)
+		if err != nil {
+			return nil, err
+		}
+
+		// seats are only counted by the enrollment service
+		delete(updateDoc, "enrolled_count")
+		delete(updateDoc, "waitlist_seq")
+		if modelCourse.Capacity != 0 {
+			merged.Capacity = modelCourse.Capacity
+		}
+
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			if moved {
+				err := checkCourseSessions(sc, db, &merged)
+				if err != nil {
+					return fmt.Errorf("%w: %w", ErrCourseIntegrity, err)
+				}
+			}
+
+			_, err := db.Collection("courses").UpdateOne(sc, bson.M{"_id": obj, "deleted_at": nil}, bson.M{"$set": updateDoc})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("error updating course id: %s", pbCourse.Id))
+			}
+
+			if moved {
+				err = syncCourseSessions(sc, db, &merged)
+				if err != nil {
+					return err
+				}
+			}
+
+			// a raised capacity gives the free seats to the waitlist
+			if modelCourse.Capacity > current.Capacity && current.Capacity != 0 {
+				err = promoteWaitlist(sc, db, current.Id)
+				if err != nil {
+					return err
+				}
+			}
+			return recordEntityEvents(sc, db, "courses", ActionUpdated, []string{current.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		updatedCourses = append(updatedCourses, MapModelToPbCourse(&merged))
+	}
+
+	return updatedCourses, nil
+}
+
+// delete courses in mongoDB by id (soft delete)
+func DeleteCoursesDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	db := client.Database("school")
+
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		ids, err := matchingIDs(sc, db.Collection("courses"), filter)
+		if err != nil {
+			return err
+		}
+
+		res, err := db.Collection("courses").UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no courses matched the ids"), "No courses were deleted")
+		}
+
+		// the sessions of a deleted course leave the timetable with it
+		_, err = db.Collection("sessions").UpdateMany(sc, bson.M{"course_id": bson.M{"$in": idsToDelete}, "deleted_at": nil}, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to delete the sessions of the courses")
+		}
+		return recordEntityEvents(sc, db, "courses", ActionDeleted, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hexIDs(objectIds), nil
+}
+
+// ListCoursesDBHandler returns the active courses matching the filter, optionally limited to one term
+func ListCoursesDBHandler(ctx context.Context, filter bson.M, term string) ([]*pb.Course, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter["deleted_at"] = nil
+	if term != "" {
+		filter["term"] = term
+	}
+
+	cursor, err := client.Database("school").Collection("courses").Find(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	return DecodedEntities(ctx, cursor, func() *models.Course { return &models.Course{} }, func() *pb.Course { return &pb.Course{} })
+}
+
+// GetCourseAssignmentsDBHandler builds the course assignments of every given teacher, keyed by teacher id, limited to
+// one term when term is given
+func GetCourseAssignmentsDBHandler(ctx context.Context, teacherIDs []string, term string) (map[string][]*pb.CourseAssignment, error) {
+	assignments := make(map[string][]*pb.CourseAssignment, len(teacherIDs))
+	if len(teacherIDs) == 0 {
+		return assignments, nil
+	}
+
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	filter := bson.M{"teacher_id": bson.M{"$in": teacherIDs}, "deleted_at": nil}
+	if term != "" {
+		filter["term"] = term
+	}
+
+	cursor, err := db.Collection("courses").Find(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var courses []models.Course
+	err = cursor.All(ctx, &courses)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	// subjects and classes are loaded once per id
+	subjects := map[string]*models.Subject{}
+	classes := map[string]*models.Class{}
+
+	for _, course := range courses {
+		assignment := &pb.CourseAssignment{
+			CourseId:  course.Id,
+			SubjectId: course.SubjectId,
+			ClassId:   course.ClassId,
+			Term:      course.Term,
+		}
+
+		subject, ok := subjects[course.SubjectId]
+		if !ok {
+			subject, _ = findSubject(ctx, db, course.SubjectId)
+			subjects[course.SubjectId] = subject
+		}
+		if subject != nil {
+			assignment.SubjectCode = subject.Code
+			assignment.SubjectName = subject.Name
+		}
+
+		class, ok := classes[course.ClassId]
+		if !ok {
+			class, _ = findClass(ctx, db, course.ClassId, "")
+			classes[course.ClassId] = class
+		}
+		if class != nil {
+			assignment.ClassName = class.Name
+		}
+
+		assignments[course.TeacherId] = append(assignments[course.TeacherId], assignment)
+	}
+
+	return assignments, nil
+}
+
+// checkCourseRefs makes sure subject, class and teacher exist and that the subject is offered once per class and term
+func checkCourseRefs(ctx context.Context, db *mongo.Database, course *models.Course, exceptID string) error {
+	_, err := findSubject(ctx, db, course.SubjectId)
+	if err != nil {
+		return err
+	}
+
+	_, err = findClass(ctx, db, course.ClassId, "")
+	if err != nil {
+		if errors.Is(err, ErrClassIntegrity) {
+			return fmt.Errorf("%w: class %s does not exist", ErrCourseIntegrity, course.ClassId)
+		}
+		return err
+	}
+
+	_, err = findActiveTeacher(ctx, db, course.TeacherId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("%w: teacher %s does not exist", ErrCourseIntegrity, course.TeacherId)
+		}
+		return err
+	}
+
+	// courses are offered in an open term of the academic calendar
+	term, err := findTerm(ctx, db, course.Term)
+	if err != nil {
+		if errors.Is(err, ErrCalendar) {
+			return fmt.Errorf("%w: term %s does not exist", ErrCourseIntegrity, course.Term)
+		}
+		return err
+	}
+	if term.ClosedAt != "" {
+		return fmt.Errorf("%w: term %s is closed", ErrCourseIntegrity, course.Term)
+	}
+
+	filter := bson.M{"subject_id": course.SubjectId, "class_id": course.ClassId, "term": course.Term, "deleted_at": nil}
+	if exceptID != "" {
+		objectID, err := primitive.ObjectIDFromHex(exceptID)
+		if err != nil {
+			return utils.ErrorHandler(err, "Invalid id")
+		}
+		filter["_id"] = bson.M{"$ne": objectID}
+	}
+
+	count, err := db.Collection("courses").CountDocuments(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: the subject is already offered to this class in term %s", ErrCourseIntegrity, course.Term)
+	}
+	return nil
+}
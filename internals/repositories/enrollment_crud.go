@@ -0,0 +1,409 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrEnrollment is returned when an enrollment request can not be applied to the current state of the course
+var ErrEnrollment = errors.New("enrollment refused")
+
+const (
+	EnrollmentEnrolled   = "enrolled"
+	EnrollmentWaitlisted = "waitlisted"
+	EnrollmentDropped    = "dropped"
+	EnrollmentCompleted  = "completed"
+)
+
+/*
+Seats are counted on the course document (enrolled_count) and every change to it is a single conditional update,
+so two requests can never take the last seat at the same time. The seat and the enrollment that holds it are written
+in one transaction, a seat is never taken without its enrollment or the other way around. A student has at most one
+enrollment document per course (unique index on student_id + course_id), re-enrolling after a drop reuses that
+document. A completed enrollment is kept for the prerequisite checks, the course can not be taken again.
+*/
+
+// EnrollDBHandler enrolls the student into the course, or puts them on the waitlist when the course is full
+func EnrollDBHandler(ctx context.Context, studentID, courseID string) (*pb.Enrollment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	err = checkActiveStudent(ctx, db, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := findCourse(ctx, db, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	closed, err := termClosed(ctx, db, course.Term)
+	if err != nil {
+		return nil, err
+	}
+	if closed {
+		return nil, fmt.Errorf("%w: term %s of the course is closed", ErrEnrollment, course.Term)
+	}
+
+	err = checkPrerequisitesCompleted(ctx, db, studentID, course.SubjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollment models.Enrollment
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		var err error
+		enrollment, err = enroll(sc, db, studentID, courseID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return MapModelToPbEnrollment(&enrollment), nil
+}
+
+// enroll writes the enrollment of the student and takes its seat, it runs in the transaction of EnrollDBHandler
+func enroll(sc mongo.SessionContext, db *mongo.Database, studentID, courseID string) (models.Enrollment, error) {
+	var existing models.Enrollment
+	err := db.Collection("enrollments").FindOne(sc, bson.M{"student_id": studentID, "course_id": courseID}).Decode(&existing)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return existing, utils.ErrorHandler(err, "Internal error")
+	}
+	switch existing.Status {
+	case EnrollmentEnrolled, EnrollmentWaitlisted:
+		return existing, fmt.Errorf("%w: student %s is already enrolled or waitlisted in course %s", ErrEnrollment, studentID, courseID)
+	case EnrollmentCompleted:
+		return existing, fmt.Errorf("%w: student %s already completed course %s", ErrEnrollment, studentID, courseID)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	enrollment := models.Enrollment{
+		StudentId:   studentID,
+		CourseId:    courseID,
+		RequestedAt: now,
+	}
+
+	// students already waiting keep their place, a freed seat goes to them and not to a new request
+	waiting, err := db.Collection("enrollments").CountDocuments(sc, bson.M{"course_id": courseID, "status": EnrollmentWaitlisted})
+	if err != nil {
+		return enrollment, utils.ErrorHandler(err, "Internal error")
+	}
+
+	seatTaken := false
+	if waiting == 0 {
+		seatTaken, err = takeSeat(sc, db, courseID)
+		if err != nil {
+			return enrollment, err
+		}
+	}
+
+	var update bson.M
+	if seatTaken {
+		enrollment.Status = EnrollmentEnrolled
+		enrollment.EnrolledAt = now
+		update = bson.M{
+			"$set":   bson.M{"status": enrollment.Status, "requested_at": now, "enrolled_at": now},
+			"$unset": bson.M{"waitlist_position": "", "dropped_at": ""},
+		}
+	} else {
+		enrollment.WaitlistPosition, err = nextWaitlistPosition(sc, db, courseID)
+		if err != nil {
+			return enrollment, err
+		}
+		enrollment.Status = EnrollmentWaitlisted
+		update = bson.M{
+			"$set":   bson.M{"status": enrollment.Status, "requested_at": now, "waitlist_position": enrollment.WaitlistPosition},
+			"$unset": bson.M{"enrolled_at": "", "dropped_at": ""},
+		}
+	}
+
+	// the filter only matches a dropped enrollment (or none), a parallel request for the same student and course ends
+	// up on the unique index and its transaction, with the seat, is rolled back
+	filter := bson.M{
+		"student_id": studentID,
+		"course_id":  courseID,
+		"status":     EnrollmentDropped,
+	}
+	res, err := db.Collection("enrollments").UpdateOne(sc, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return enrollment, fmt.Errorf("%w: student %s is already enrolled or waitlisted in course %s", ErrEnrollment, studentID, courseID)
+		}
+		return enrollment, utils.ErrorHandler(err, "Internal error")
+	}
+
+	enrollment.Id = existing.Id
+	if objectID, ok := res.UpsertedID.(primitive.ObjectID); ok {
+		enrollment.Id = objectID.Hex()
+	}
+	return enrollment, nil
+}
+
+// DropDBHandler drops the student from the course, a freed seat is given to the first student on the waitlist
+func DropDBHandler(ctx context.Context, studentID, courseID string) (*pb.Enrollment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	filter := bson.M{
+		"student_id": studentID,
+		"course_id":  courseID,
+		"status":     bson.M{"$in": bson.A{EnrollmentEnrolled, EnrollmentWaitlisted}},
+	}
+	update := bson.M{
+		"$set":   bson.M{"status": EnrollmentDropped, "dropped_at": time.Now().Format(time.RFC3339)},
+		"$unset": bson.M{"waitlist_position": ""},
+	}
+
+	// the drop, the freed seat and the promotions from the waitlist are written together
+	var previous models.Enrollment
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		// the document before the update tells if a seat was freed
+		err := db.Collection("enrollments").FindOneAndUpdate(sc, filter, update).Decode(&previous)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return fmt.Errorf("%w: student %s is not enrolled or waitlisted in course %s", ErrEnrollment, studentID, courseID)
+			}
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if previous.Status != EnrollmentEnrolled {
+			return nil
+		}
+		err = releaseSeat(sc, db, courseID)
+		if err != nil {
+			return err
+		}
+		return promoteWaitlist(sc, db, courseID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dropped models.Enrollment
+	err = db.Collection("enrollments").FindOne(ctx, bson.M{"_id": mustObjectID(previous.Id)}).Decode(&dropped)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	return MapModelToPbEnrollment(&dropped), nil
+}
+
+// ListEnrollmentsDBHandler returns the enrollments matching the filter, waitlisted students in waitlist order
+func ListEnrollmentsDBHandler(ctx context.Context, filter bson.M) ([]*pb.Enrollment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	opts := options.Find().SetSort(bson.D{{Key: "status", Value: 1}, {Key: "waitlist_position", Value: 1}, {Key: "requested_at", Value: 1}})
+	cursor, err := client.Database("school").Collection("enrollments").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	return DecodedEntities(ctx, cursor, func() *models.Enrollment { return &models.Enrollment{} }, func() *pb.Enrollment { return &pb.Enrollment{} })
+}
+
+// PromoteWaitlistDBHandler fills the free seats of a course from its waitlist, used after the capacity of a course is raised
+func PromoteWaitlistDBHandler(ctx context.Context, courseID string) error {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		return promoteWaitlist(sc, client.Database("school"), courseID)
+	})
+}
+
+// promoteWaitlist moves waitlisted students into free seats in waitlist order until the course is full or nobody is
+// waiting. It runs in a transaction, the seats and the enrollments taking them are written together
+func promoteWaitlist(sc mongo.SessionContext, db *mongo.Database, courseID string) error {
+	for {
+		var next models.Enrollment
+		opts := options.FindOne().SetSort(bson.D{{Key: "waitlist_position", Value: 1}})
+		err := db.Collection("enrollments").FindOne(sc, bson.M{"course_id": courseID, "status": EnrollmentWaitlisted}, opts).Decode(&next)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil
+			}
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		seatTaken, err := takeSeat(sc, db, courseID)
+		if err != nil {
+			return err
+		}
+		if !seatTaken {
+			return nil
+		}
+
+		_, err = db.Collection("enrollments").UpdateOne(sc,
+			bson.M{"_id": mustObjectID(next.Id), "status": EnrollmentWaitlisted},
+			bson.M{
+				"$set":   bson.M{"status": EnrollmentEnrolled, "enrolled_at": time.Now().Format(time.RFC3339)},
+				"$unset": bson.M{"waitlist_position": ""},
+			})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+	}
+}
+
+// takeSeat increments enrolled_count only while the course has room, a capacity of 0 means no limit
+func takeSeat(ctx context.Context, db *mongo.Database, courseID string) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(courseID)
+	if err != nil {
+		return false, utils.ErrorHandler(err, fmt.Sprintf("Invalid course id: %v", courseID))
+	}
+
+	filter := bson.M{
+		"_id":        objectID,
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"capacity": bson.M{"$in": bson.A{nil, 0}}},
+			bson.M{"$expr": bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$enrolled_count", 0}}, "$capacity"}}},
+		},
+	}
+	res, err := db.Collection("courses").UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"enrolled_count": 1}})
+	if err != nil {
+		return false, utils.ErrorHandler(err, "Internal error")
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// releaseSeat gives a seat back to the course
+func releaseSeat(ctx context.Context, db *mongo.Database, courseID string) error {
+	_, err := db.Collection("courses").UpdateOne(ctx,
+		bson.M{"_id": mustObjectID(courseID), "enrolled_count": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"enrolled_count": -1}})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return nil
+}
+
+// nextWaitlistPosition hands out the next waitlist position of the course
+func nextWaitlistPosition(ctx context.Context, db *mongo.Database, courseID string) (int32, error) {
+	var course models.Course
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := db.Collection("courses").FindOneAndUpdate(ctx,
+		bson.M{"_id": mustObjectID(courseID), "deleted_at": nil},
+		bson.M{"$inc": bson.M{"waitlist_seq": 1}}, opts).Decode(&course)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, fmt.Errorf("%w: course %s does not exist", ErrCourseIntegrity, courseID)
+		}
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	return course.WaitlistSeq, nil
+}
+
+// checkPrerequisitesCompleted makes sure the student completed a course of every prerequisite of the subject
+func checkPrerequisitesCompleted(ctx context.Context, db *mongo.Database, studentID, subjectID string) error {
+	subject, err := findSubject(ctx, db, subjectID)
+	if err != nil {
+		return err
+	}
+
+	for _, prerequisiteID := range subject.PrerequisiteSubjectIds {
+		// courses of earlier terms may already be soft deleted, they still count
+		courseIDs, err := db.Collection("courses").Distinct(ctx, "_id", bson.M{"subject_id": prerequisiteID})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		ids := make(bson.A, 0, len(courseIDs))
+		for _, id := range courseIDs {
+			if objectID, ok := id.(primitive.ObjectID); ok {
+				ids = append(ids, objectID.Hex())
+			}
+		}
+
+		count, err := db.Collection("enrollments").CountDocuments(ctx, bson.M{
+			"student_id": studentID,
+			"course_id":  bson.M{"$in": ids},
+			"status":     EnrollmentCompleted,
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if count == 0 {
+			return fmt.Errorf("%w: prerequisite subject %s is not completed", ErrEnrollment, prerequisiteID)
+		}
+	}
+	return nil
+}
+
+// checkActiveStudent makes sure the student exists and is not deleted
+func checkActiveStudent(ctx context.Context, db *mongo.Database, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return utils.ErrorHandler(err, fmt.Sprintf("Invalid student id: %v", id))
+	}
+
+	// transferred, withdrawn and graduated students can not enroll
+	count, err := db.Collection("students").CountDocuments(ctx, bson.M{
+		"_id":               objectID,
+		"deleted_at":        nil,
+		"graduated_at":      nil,
+		"enrollment_status": bson.M{"$in": bson.A{StudentActive, nil}},
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: student %s does not exist or is not active", ErrEnrollment, id)
+	}
+	return nil
+}
+
+// findCourse loads an active course by id
+func findCourse(ctx context.Context, db *mongo.Database, id string) (*models.Course, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid course id: %v", id))
+	}
+
+	var course models.Course
+	err = db.Collection("courses").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&course)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: course %s does not exist", ErrCourseIntegrity, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &course, nil
+}
+
+// mustObjectID converts ids that were read back from mongo and are therefore known to be valid
+func mustObjectID(id string) primitive.ObjectID {
+	objectID, _ := primitive.ObjectIDFromHex(id)
+	return objectID
+}
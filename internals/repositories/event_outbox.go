@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"school_project_grpc/pkg/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	EventPending    = "pending"
+	EventDispatched = "dispatched"
+)
+
+// actions of the entity events, events are named ENTITY.ACTION like student.created
+const (
+	ActionCreated  = "created"
+	ActionUpdated  = "updated"
+	ActionDeleted  = "deleted"
+	ActionRestored = "restored"
+)
+
+// entity name of the events of a collection
+var eventEntities = map[string]string{
+	"students":  "student",
+	"teachers":  "teacher",
+	"classes":   "class",
+	"subjects":  "subject",
+	"courses":   "course",
+	"guardians": "guardian",
+}
+
+// fields that never leave the server in an event
+var eventHiddenFields = map[string][]string{
+	"students": {"medical_notes"},
+}
+
+/*
+The CRUD repositories write an event into event_outbox in the same transaction as the change, so an event exists exactly
+when the change was committed. The event carries the document as it is after the change, soft deleted documents
+included. The webhook dispatcher fans the pending events out into deliveries of the subscribed endpoints.
+*/
+
+// recordEntityEvents writes an event for each of the documents into the event outbox. ctx has to be the session
+// context of the transaction of the change, the documents are read inside it
+func recordEntityEvents(ctx context.Context, db *mongo.Database, collection, action string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	objectIds, err := toObjectIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := db.Collection(collection).Find(ctx, bson.M{"_id": bson.M{"$in": objectIds}})
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to record "+collection+" events")
+	}
+	var docs []bson.M
+	err = cursor.All(ctx, &docs)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to record "+collection+" events")
+	}
+
+	event := eventEntities[collection] + "." + action
+	now := time.Now().Format(time.RFC3339)
+
+	events := make([]any, 0, len(docs))
+	for _, doc := range docs {
+		entityID := doc["_id"].(primitive.ObjectID).Hex()
+		doc["_id"] = entityID
+		for _, field := range eventHiddenFields[collection] {
+			delete(doc, field)
+		}
+
+		eventID := primitive.NewObjectID()
+		payload, err := eventPayload(eventID.Hex(), event, entityID, now, doc)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, bson.M{
+			"_id":        eventID,
+			"event":      event,
+			"entity_id":  entityID,
+			"payload":    payload,
+			"status":     EventPending,
+			"created_at": now,
+		})
+	}
+
+	_, err = db.Collection("event_outbox").InsertMany(ctx, events)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to record "+collection+" events")
+	}
+	return nil
+}
+
+// eventPayload is the json body the endpoints get, data is the document in relaxed extended json
+func eventPayload(eventID, event, entityID, occurredAt string, doc bson.M) (string, error) {
+	data, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Failed to encode event payload")
+	}
+
+	payload, err := json.Marshal(struct {
+		Id         string          `json:"id"`
+		Event      string          `json:"event"`
+		EntityId   string          `json:"entity_id"`
+		OccurredAt string          `json:"occurred_at"`
+		Data       json.RawMessage `json:"data"`
+	}{eventID, event, entityID, occurredAt, data})
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Failed to encode event payload")
+	}
+	return string(payload), nil
+}
+
+// matchingIDs is the ids of the documents the filter matches, read before an UpdateMany with the filter so the events
+// are only recorded for the documents it changes
+func matchingIDs(ctx context.Context, coll *mongo.Collection, filter bson.M) ([]string, error) {
+	values, err := coll.Distinct(ctx, "_id", filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		if objectID, ok := value.(primitive.ObjectID); ok {
+			ids = append(ids, objectID.Hex())
+		}
+	}
+	return ids, nil
+}
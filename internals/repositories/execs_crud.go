@@ -16,14 +16,12 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-mail/mail"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec, error) {
+func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec, invitedBy string) ([]*pb.Exec, error) {
 
 	// creating db client throught with I will be inserting data
 	client, err := mongodb.CreatMongoClient()
@@ -36,12 +34,17 @@ func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec
 	newExecs := make([]*models.Exec, 0, len(execsFromReq)) //  pb value  to model value
 	for i, pbExec := range execsFromReq {
 		newExecs = append(newExecs, MapPBToModelExec(pbExec))
-		// encoding the password into hash (security)
-		hashedPassword, err := utils.HashPassword(newExecs[i].Password)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
+
+		// the user chooses the password when accepting the invite
+		if newExecs[i].Password != "" {
+			return nil, fmt.Errorf("%w: passwords are set by the user through the invite, leave password empty", ErrAccount)
+		}
+		if newExecs[i].Email == "" {
+			return nil, fmt.Errorf("%w: the invite is sent to the email of the user, email is required", ErrAccount)
 		}
-		newExecs[i].Password = hashedPassword // ovevwriting password of the current newExecs
+		newExecs[i].PasswordResetToken = ""
+		newExecs[i].PasswordTokenExp = ""
+		newExecs[i].PasswordChangedAt = ""
 
 		// setting the curret time to the field UserCreatedAt
 		currentTime := time.Now().Format(time.RFC3339)
@@ -56,6 +59,12 @@ func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec
 			continue
 		}
 
+		// student and teacher accounts belong to their student or teacher
+		err = checkAccountProfile(ctx, client.Database("school"), exec)
+		if err != nil {
+			return nil, err
+		}
+
 		result, err := client.Database("school").Collection("execs").InsertOne(ctx, exec)
 		if err != nil {
 			return nil, utils.ErrorHandler(err, "Error adding value into database")
@@ -66,6 +75,12 @@ func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec
 			exec.Id = objectID.Hex()
 		}
 
+		// the account can only be used once the user accepted the invite
+		err = sendInvite(ctx, client.Database("school"), exec, invitedBy)
+		if err != nil {
+			return nil, err
+		}
+
 		pbExec := MapModelToPbExec(exec)
 
 		addedExec = append(addedExec, pbExec)
@@ -73,36 +88,17 @@ func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec
 	return addedExec, nil
 }
 
-func GetExecsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M) ([]*pb.Exec, error) {
+func GetExecsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, page PageRequest) ([]*pb.Exec, PageInfo, error) {
 	client, err := mongodb.CreatMongoClient()
 	if err != nil {
-		return nil, err
+		return nil, PageInfo{}, err
 	}
 	defer client.Disconnect(ctx)
 
 	// getting collection of the execs
 	coll := client.Database("school").Collection("execs")
 
-	var cursor *mongo.Cursor
-	if len(sortOption) < 1 {
-		cursor, err = coll.Find(ctx, filter)
-	} else {
-		cursor, err = coll.Find(ctx, filter, options.Find().SetSort(sortOption))
-	}
-
-	// cheking the error from above coll.find
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
-	}
-	defer cursor.Close(ctx)
-
-	// decode mongo documents -> pb execs
-	execs, err := DecodedEntities(ctx, cursor, func() *models.Exec { return &models.Exec{} }, func() *pb.Exec { return &pb.Exec{} })
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
-	}
-
-	return execs, nil
+	return findKeysetPage(ctx, coll, filter, sortOption, page, func() *models.Exec { return &models.Exec{} }, func() *pb.Exec { return &pb.Exec{} })
 }
 
 // Update Execs in MongoDB
@@ -143,12 +139,16 @@ func UpdateExecsDBHandler(ctx context.Context, pbExecs []*pb.Exec) ([]*pb.Exec,
 			return nil, utils.ErrorHandler(err, "Internal error")
 		}
 
-		// Remove _id from update
+		// Remove _id, the soft delete fields, the password (only set by its user) and the profile (fixed when the account is created) from update
 		delete(updateDoc, "_id")
+		delete(updateDoc, "deleted_at")
+		delete(updateDoc, "deleted_by")
+		delete(updateDoc, "profile_id")
+		delete(updateDoc, "password")
 
 		// Update in MongoDB
 		_, err = client.Database("school").Collection("Execs").
-			UpdateOne(ctx, bson.M{"_id": obj}, bson.M{"$set": updateDoc})
+			UpdateOne(ctx, bson.M{"_id": obj, "deleted_at": nil}, bson.M{"$set": updateDoc})
 		if err != nil {
 			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating exec id: %s", exec.Id))
 		}
@@ -162,7 +162,7 @@ func UpdateExecsDBHandler(ctx context.Context, pbExecs []*pb.Exec) ([]*pb.Exec,
 }
 
 // delete Exec in mongoDB by user id
-func DeleteExecsDBHandler(ctx context.Context, idstodelete []string) ([]string, error) {
+func DeleteExecsDBHandler(ctx context.Context, idstodelete []string, deletedBy string) ([]string, error) {
 
 	client, err := mongodb.CreatMongoClient()
 	if err != nil {
@@ -180,24 +180,77 @@ func DeleteExecsDBHandler(ctx context.Context, idstodelete []string) ([]string,
 		objectIds = append(objectIds, objectId)
 	}
 
-	// Delete many by IDs
-	filter := bson.M{"_id": bson.M{"$in": objectIds}}
+	// Soft delete many by IDs, the documents stay in the collection until the purge job removes them
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	// only the ids that were not deleted yet are deleted and returned
+	deletedIds, err := softDeleteExecs(ctx, client, filter, update)
+	if err != nil {
+		return nil, err
+	}
+	if len(deletedIds) == 0 {
+		return nil, utils.ErrorHandler(errors.New("no documents matched the ids"), "No Execs were deleted")
+	}
+	return deletedIds, nil
+}
+
+// restore soft deleted Exec in mongoDB by user id
+func RestoreExecsDBHandler(ctx context.Context, idsToRestore []string) ([]string, error) {
 
-	res, err := client.Database("school").Collection("execs").DeleteMany(ctx, filter)
+	client, err := mongodb.CreatMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
+	defer client.Disconnect(ctx)
 
-	if res.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(err, "No Execs were deleted")
+	// Convert to Mongo ObjectIDs
+	objectIds := make([]primitive.ObjectID, 0, len(idsToRestore))
+	for _, id := range idsToRestore {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+		}
+		objectIds = append(objectIds, objectId)
 	}
 
-	// Return deleted IDs
-	deletedIds := make([]string, 0, len(objectIds))
-	for _, v := range objectIds {
-		deletedIds = append(deletedIds, v.Hex())
+	// only documents that are still soft deleted can be restored
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{"$unset": bson.M{"deleted_at": "", "deleted_by": ""}}
+
+	// only the ids that were soft deleted are restored and returned
+	restoredIds, err := softDeleteExecs(ctx, client, filter, update)
+	if err != nil {
+		return nil, err
 	}
-	return deletedIds, nil
+	if len(restoredIds) == 0 {
+		return nil, utils.ErrorHandler(errors.New("no soft deleted documents matched the ids"), "No Execs were restored")
+	}
+	return restoredIds, nil
+}
+
+// softDeleteExecs applies the delete or restore update to the execs matching the filter and returns their ids, in
+// one transaction so the ids are exactly the execs that were changed
+func softDeleteExecs(ctx context.Context, client *mongo.Client, filter, update bson.M) ([]string, error) {
+	var changed []string
+	err := mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		coll := client.Database("school").Collection("execs")
+		ids, err := matchingIDs(sc, coll, filter)
+		if err != nil {
+			return err
+		}
+
+		_, err = coll.UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		changed = ids
+		return nil
+	})
+	return changed, err
 }
 
 func LoginDBHandler(ctx context.Context, username string) (models.Exec, error) {
@@ -208,7 +261,7 @@ func LoginDBHandler(ctx context.Context, username string) (models.Exec, error) {
 	defer client.Disconnect(ctx)
 
 	// makeing filer for db to know which columt to change
-	filter := bson.M{"username": username}
+	filter := bson.M{"username": username, "deleted_at": nil}
 	log.Println(filter)
 	var exec models.Exec
 	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec) // inserting the data recieved of the same id into exec
@@ -218,6 +271,14 @@ func LoginDBHandler(ctx context.Context, username string) (models.Exec, error) {
 		}
 		return models.Exec{}, utils.ErrorHandler(err, "Internal error")
 	}
+
+	// students and teachers can not log in once their profile is deleted
+	if IsProfileRole(exec.Role) {
+		err = checkProfileExists(ctx, client.Database("school"), exec.Role, exec.ProfileId)
+		if err != nil {
+			return models.Exec{}, err
+		}
+	}
 	return exec, nil
 }
 
@@ -233,9 +294,10 @@ func UpdatePasswordDBHandler(ctx context.Context, req *pb.UpdatePasswordRequest)
 		return models.Exec{}, utils.ErrorHandler(err, "Invalid ID")
 	}
 
-	// retriving the user (exec) from data base
+	// retriving the user (exec) from data base, student and teacher accounts are also found by their profile id (the uid of their token)
+	filter := bson.M{"$or": bson.A{bson.M{"_id": objectID}, bson.M{"profile_id": req.GetId()}}, "deleted_at": nil}
 	var user models.Exec
-	err = client.Database("school").Collection("execs").FindOne(ctx, bson.M{"_id": objectID}).Decode(&user)
+	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&user)
 	if err != nil {
 		return models.Exec{}, utils.ErrorHandler(err, "Internal error")
 	}
@@ -260,7 +322,7 @@ func UpdatePasswordDBHandler(ctx context.Context, req *pb.UpdatePasswordRequest)
 	}
 
 	// updating in db
-	_, err = client.Database("school").Collection("execs").UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	_, err = client.Database("school").Collection("execs").UpdateOne(ctx, bson.M{"_id": mustObjectID(user.Id)}, update)
 	if err != nil {
 		return models.Exec{}, utils.ErrorHandler(err, "Internal error")
 	}
@@ -327,7 +389,7 @@ func ForgotPasswordDBHandler(ctx context.Context, email string) error {
 	defer client.Disconnect(ctx)
 
 	var exec models.Exec
-	err = client.Database("school").Collection("execs").FindOne(ctx, bson.M{"email": email}).Decode(&exec) // getting the full user info and storing in in a var
+	err = client.Database("school").Collection("execs").FindOne(ctx, bson.M{"email": email, "deleted_at": nil}).Decode(&exec) // getting the full user info and storing in in a var
 	if err != nil {
 		if err == mongo.ErrNoDocuments { // if there is not user with that username
 			return utils.ErrorHandler(err, "User not found. Incorrect password/username")
@@ -335,16 +397,11 @@ func ForgotPasswordDBHandler(ctx context.Context, email string) error {
 		return utils.ErrorHandler(err, "Internal error")
 	}
 
-	tokenbyte := make([]byte, 32) // generate tokne to send to the user
-	_, err = rand.Read(tokenbyte)
+	token, hashedTokenString, err := newHashedToken() // token that will be sent to the user and its hash that will be stored in db
 	if err != nil {
-		return utils.ErrorHandler(err, "Failed to generate token")
+		return err
 	}
 
-	token := hex.EncodeToString(tokenbyte) // token that will be sent to the user
-	hashedToken := sha256.Sum256(tokenbyte)
-	hashedTokenString := hex.EncodeToString(hashedToken[:]) // token that will be stored in db
-
 	duration, err := strconv.Atoi(os.Getenv("RESET_TOKEN_EXP_DURATION"))
 	if err != nil {
 		return utils.ErrorHandler(err, "Failed to get token exp duration")
@@ -366,24 +423,8 @@ func ForgotPasswordDBHandler(ctx context.Context, email string) error {
 
 	resetURL := fmt.Sprintf("https://localhost:50051/execs/resetpassword/reset/%s", token) // this will be send to the exec
 
-	message := fmt.Sprintf(`
-		Forgot your password? Reset your password using the following link: 
-		%s
-		Please use the reset code: %s along with your request to change password. 
-		if you didn't request a password reset, please ignore this email. 
-
-		This link is only valid for %v minutes.`, resetURL, token, mins)
-
-	subject := "Your password reset link"
-
-	m := mail.NewMessage()
-	m.SetHeader("From", "schooladmin@gmail.com") // replay with your own email
-	m.SetHeader("To", email)
-	m.SetHeader("Subject", subject)
-	m.SetBody("text/plain", message)
-
-	d := mail.NewDialer("localhost", 1025, "", "")
-	err = d.DialAndSend(m)
+	// the mail worker sends the link, the rpc only has to get it into the outbox
+	err = queueMail(ctx, client.Database("school"), "password_reset", email, map[string]any{"URL": resetURL, "Code": token, "Minutes": duration})
 	if err != nil {
 		cleanup := bson.M{
 			"$set": bson.M{
@@ -404,7 +445,7 @@ func ResetPasswordDBHandler(ctx context.Context, hashedTokenString string, passw
 	}
 	defer client.Disconnect(ctx)
 
-	filter := bson.M{"password_reset_token": hashedTokenString, "password_token_exp": bson.M{"$gt": time.Now().Format(time.RFC3339)}} // building filters and checking if the token is expired or not comparing to time.Now()
+	filter := bson.M{"deleted_at": nil, "password_reset_token": hashedTokenString, "password_token_exp": bson.M{"$gt": time.Now().Format(time.RFC3339)}} // building filters and checking if the token is expired or not comparing to time.Now()
 
 	var exec models.Exec
 	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec) // store the resulting value in a variable
@@ -431,3 +472,16 @@ func ResetPasswordDBHandler(ctx context.Context, hashedTokenString string, passw
 	}
 	return nil
 }
+
+// newHashedToken generates a random token for a link sent by email. the token goes to the user, only its sha256 hash
+// is stored so a leaked database can not be used to reset passwords or accept invites
+func newHashedToken() (string, string, error) {
+	tokenbyte := make([]byte, 32)
+	_, err := rand.Read(tokenbyte)
+	if err != nil {
+		return "", "", utils.ErrorHandler(err, "Failed to generate token")
+	}
+
+	hashedToken := sha256.Sum256(tokenbyte)
+	return hex.EncodeToString(tokenbyte), hex.EncodeToString(hashedToken[:]), nil
+}
@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// documents the cursor of an export fetches per round trip
+const exportBatchSize = 500
+
+/*
+Unlike the Get handlers the exports do not collect the result with DecodedEntities: every document is decoded and handed
+to the caller while the cursor moves on, so an export only holds one batch of the cursor in memory whatever its size.
+*/
+
+// ExportStudentsDBHandler hands every student matching the filter to each, in the order of the sort
+func ExportStudentsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, each func(*pb.Student) error) error {
+	return eachEntity(ctx, "students", sortOption, filter, func() *models.Student { return &models.Student{} },
+		func(student *models.Student) error { return each(MapModelToPbStudent(student)) })
+}
+
+// ExportTeachersDBHandler hands every teacher matching the filter to each, in the order of the sort
+func ExportTeachersDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, each func(*pb.Teacher) error) error {
+	return eachEntity(ctx, "teachers", sortOption, filter, func() *models.Teacher { return &models.Teacher{} },
+		func(teacher *models.Teacher) error { return each(MapModelToPbTeacher(teacher)) })
+}
+
+// ExportExecsDBHandler hands every exec matching the filter to each, in the order of the sort, without their passwords
+// and tokens
+func ExportExecsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, each func(*pb.Exec) error) error {
+	return eachEntity(ctx, "execs", sortOption, filter, func() *models.Exec { return &models.Exec{} },
+		func(exec *models.Exec) error {
+			entity := MapModelToPbExec(exec)
+			entity.Password = ""
+			entity.PasswordResetToken = ""
+			entity.PasswordTokenExp = ""
+			return each(entity)
+		})
+}
+
+// eachEntity decodes the documents of the collection matching the filter one by one and hands them to each, an error
+// of each stops the iteration and is returned
+func eachEntity[M any](ctx context.Context, collection string, sortOption bson.D, filter bson.M, newmodel func() *M, each func(*M) error) error {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	findOptions := options.Find().SetBatchSize(exportBatchSize)
+	if len(sortOption) > 0 {
+		findOptions.SetSort(sortOption)
+	}
+
+	cursor, err := client.Database("school").Collection(collection).Find(ctx, filter, findOptions)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to fetch data from db")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		model := newmodel()
+		err = cursor.Decode(model)
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to fetch data from db")
+		}
+
+		err = each(model)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cursor.Err() != nil {
+		return utils.ErrorHandler(cursor.Err(), "Failed to fetch data from db")
+	}
+	return nil
+}
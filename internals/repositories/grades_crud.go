@@ -0,0 +1,459 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrGrades is returned when an assessment or score write does not fit the course it belongs to
+var ErrGrades = errors.New("grades refused")
+
+// ErrNotCourseTeacher is returned when a teacher writes grades for a course they do not teach
+var ErrNotCourseTeacher = errors.New("not the teacher of the course")
+
+/*
+Every write takes the id of the teacher making it. An empty teacherID is used for admins and managers, who may
+write grades for every course, a teacher may only write grades for courses whose teacher_id is their own id.
+*/
+
+// Add assessments to MongoDB
+func AddAssessmentsDBHandler(ctx context.Context, assessmentsFromReq []*pb.Assessment, teacherID, createdBy string) ([]*pb.Assessment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedAssessments []*pb.Assessment
+
+	for _, pbAssessment := range assessmentsFromReq {
+		assessment := MapPBToModelAssessment(pbAssessment)
+
+		if assessment.CourseId == "" || assessment.Name == "" {
+			return nil, fmt.Errorf("%w: course_id and name are required", ErrGrades)
+		}
+		err = checkAssessment(assessment)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = findTaughtCourse(ctx, db, assessment.CourseId, teacherID)
+		if err != nil {
+			return nil, err
+		}
+
+		assessment.CreatedBy = createdBy
+
+		result, err := db.Collection("assessments").InsertOne(ctx, assessment)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding value into database")
+		}
+
+		objectID, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			assessment.Id = objectID.Hex()
+		}
+
+		addedAssessments = append(addedAssessments, MapModelToPbAssessment(assessment))
+	}
+
+	return addedAssessments, nil
+}
+
+// Get the active assessments matching the filter
+func GetAssessmentsDBHandler(ctx context.Context, filter bson.M) ([]*pb.Assessment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter["deleted_at"] = nil
+
+	opts := options.Find().SetSort(bson.D{{Key: "due_date", Value: 1}, {Key: "name", Value: 1}})
+	cursor, err := client.Database("school").Collection("assessments").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	return DecodedEntities(ctx, cursor, func() *models.Assessment { return &models.Assessment{} }, func() *pb.Assessment { return &pb.Assessment{} })
+}
+
+// Update assessments in MongoDB, an assessment can not be moved to another course
+func UpdateAssessmentsDBHandler(ctx context.Context, pbAssessments []*pb.Assessment, teacherID string) ([]*pb.Assessment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedAssessments []*pb.Assessment
+
+	for _, pbAssessment := range pbAssessments {
+
+		// Validate ID
+		if pbAssessment.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findAssessment(ctx, db, pbAssessment.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		modelAssessment := MapPBToModelAssessment(pbAssessment)
+		if modelAssessment.CourseId != "" && modelAssessment.CourseId != current.CourseId {
+			return nil, fmt.Errorf("%w: assessment %s can not be moved to another course", ErrGrades, pbAssessment.Id)
+		}
+		if modelAssessment.Type != "" || modelAssessment.Weight != 0 || modelAssessment.MaxScore != 0 {
+			err = checkAssessmentUpdate(modelAssessment)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = findTaughtCourse(ctx, db, current.CourseId, teacherID)
+		if err != nil {
+			return nil, err
+		}
+
+		updateDoc, err := updateDocFromModel(modelAssessment)
+		if err != nil {
+			return nil, err
+		}
+		delete(updateDoc, "course_id")
+		delete(updateDoc, "created_by")
+
+		var updated models.Assessment
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+		err = db.Collection("assessments").FindOneAndUpdate(ctx, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, bson.M{"$set": updateDoc}, opts).Decode(&updated)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating assessment id: %s", pbAssessment.Id))
+		}
+
+		updatedAssessments = append(updatedAssessments, MapModelToPbAssessment(&updated))
+	}
+
+	return updatedAssessments, nil
+}
+
+// delete assessments in mongoDB by id (soft delete), their scores no longer count towards the averages
+func DeleteAssessmentsDBHandler(ctx context.Context, idsToDelete []string, teacherID, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range idsToDelete {
+		assessment, err := findAssessment(ctx, db, id)
+		if err != nil {
+			return nil, err
+		}
+		_, err = findTaughtCourse(ctx, db, assessment.CourseId, teacherID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	res, err := db.Collection("assessments").UpdateMany(ctx, filter, update)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	if res.ModifiedCount == 0 {
+		return nil, utils.ErrorHandler(errors.New("no assessments matched the ids"), "No assessments were deleted")
+	}
+
+	return hexIDs(objectIds), nil
+}
+
+// RecordScoresDBHandler writes the scores of one assessment in a single bulk write, existing scores are overwritten.
+// only students enrolled in the course can be graded.
+func RecordScoresDBHandler(ctx context.Context, assessmentID string, studentScores []*pb.StudentScore, teacherID, gradedBy string) ([]*pb.Score, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	assessment, err := findAssessment(ctx, db, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	course, err := findTaughtCourse(ctx, db, assessment.CourseId, teacherID)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIDs := make([]string, 0, len(studentScores))
+	for _, studentScore := range studentScores {
+		if studentScore.Score < 0 || studentScore.Score > assessment.MaxScore {
+			return nil, fmt.Errorf("%w: score %v of student %s is outside 0 to %v", ErrGrades, studentScore.Score, studentScore.StudentId, assessment.MaxScore)
+		}
+		studentIDs = append(studentIDs, studentScore.StudentId)
+	}
+
+	enrolled, err := courseStudents(ctx, db, assessment.CourseId, studentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	scores := make([]*models.Score, 0, len(studentScores))
+	writes := make([]mongo.WriteModel, 0, len(studentScores))
+	for _, studentScore := range studentScores {
+		if !enrolled[studentScore.StudentId] {
+			return nil, fmt.Errorf("%w: student %s is not enrolled in the course", ErrGrades, studentScore.StudentId)
+		}
+
+		score := &models.Score{
+			AssessmentId: assessment.Id,
+			CourseId:     assessment.CourseId,
+			StudentId:    studentScore.StudentId,
+			Score:        studentScore.Score,
+			Comment:      studentScore.Comment,
+			GradedBy:     gradedBy,
+			GradedAt:     now,
+			Term:         course.Term,
+		}
+		scores = append(scores, score)
+
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"assessment_id": score.AssessmentId, "student_id": score.StudentId}).
+			SetUpdate(bson.M{"$set": score}).
+			SetUpsert(true))
+	}
+
+	_, err = db.Collection("scores").BulkWrite(ctx, writes)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to record scores")
+	}
+
+	pbScores := make([]*pb.Score, 0, len(scores))
+	for _, score := range scores {
+		pbScores = append(pbScores, MapModelToPbScore(score))
+	}
+	return pbScores, nil
+}
+
+// GetScoresDBHandler returns the scores matching the filter
+func GetScoresDBHandler(ctx context.Context, filter bson.M) ([]*pb.Score, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	opts := options.Find().SetSort(bson.D{{Key: "assessment_id", Value: 1}, {Key: "student_id", Value: 1}})
+	cursor, err := client.Database("school").Collection("scores").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	return DecodedEntities(ctx, cursor, func() *models.Score { return &models.Score{} }, func() *pb.Score { return &pb.Score{} })
+}
+
+// GetCourseGradesDBHandler computes the weighted average and letter grade of every enrolled student, or of one student.
+// assessments a student has no score for yet are left out of their average.
+func GetCourseGradesDBHandler(ctx context.Context, courseID, studentID string) (*pb.CourseGrades, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	course, err := findCourse(ctx, db, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := courseGrades(ctx, db, course, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CourseGrades{CourseId: course.Id, Term: course.Term, Grades: grades}, nil
+}
+
+// courseGrades computes the grades of the enrolled students of a course, limited to one student when studentID is given
+func courseGrades(ctx context.Context, db *mongo.Database, course *models.Course, studentID string) ([]*pb.StudentGrade, error) {
+	var studentIDs []string
+	if studentID != "" {
+		studentIDs = []string{studentID}
+	}
+	enrolled, err := courseStudents(ctx, db, course.Id, studentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := db.Collection("assessments").Find(ctx, bson.M{"course_id": course.Id, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var assessments []models.Assessment
+	err = cursor.All(ctx, &assessments)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	assessmentByID := make(map[string]models.Assessment, len(assessments))
+	var totalWeight float64
+	for _, assessment := range assessments {
+		assessmentByID[assessment.Id] = assessment
+		totalWeight += assessment.Weight
+	}
+
+	scoreFilter := bson.M{"course_id": course.Id}
+	if studentID != "" {
+		scoreFilter["student_id"] = studentID
+	}
+	cursor, err = db.Collection("scores").Find(ctx, scoreFilter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var scores []models.Score
+	err = cursor.All(ctx, &scores)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	// weighted points and graded weight per student
+	points := map[string]float64{}
+	graded := map[string]float64{}
+	for _, score := range scores {
+		assessment, ok := assessmentByID[score.AssessmentId]
+		if !ok || assessment.MaxScore <= 0 || !enrolled[score.StudentId] {
+			continue
+		}
+		points[score.StudentId] += score.Score / assessment.MaxScore * assessment.Weight
+		graded[score.StudentId] += assessment.Weight
+	}
+
+	grades := make([]*pb.StudentGrade, 0, len(enrolled))
+	for id := range enrolled {
+		grade := &pb.StudentGrade{StudentId: id, CourseId: course.Id, Term: course.Term}
+		if graded[id] > 0 {
+			average := points[id] / graded[id] * 100
+			grade.WeightedAverage = math.Round(average*100) / 100
+			grade.LetterGrade = letterGrade(grade.WeightedAverage)
+			grade.GradedWeight = math.Round(graded[id]/totalWeight*10000) / 100
+		}
+		grades = append(grades, grade)
+	}
+
+	// the enrolled students are a map, the grades are listed in a stable order
+	slices.SortFunc(grades, func(a, b *pb.StudentGrade) int { return strings.Compare(a.StudentId, b.StudentId) })
+
+	return grades, nil
+}
+
+// findTaughtCourse loads the course and, when a teacherID is given, makes sure the teacher teaches it
+func findTaughtCourse(ctx context.Context, db *mongo.Database, courseID, teacherID string) (*models.Course, error) {
+	course, err := findCourse(ctx, db, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if teacherID != "" && course.TeacherId != teacherID {
+		return nil, fmt.Errorf("%w: course %s", ErrNotCourseTeacher, courseID)
+	}
+	return course, nil
+}
+
+// findAssessment loads an active assessment by id
+func findAssessment(ctx context.Context, db *mongo.Database, id string) (*models.Assessment, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid assessment id: %v", id))
+	}
+
+	var assessment models.Assessment
+	err = db.Collection("assessments").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&assessment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: assessment %s does not exist", ErrGrades, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &assessment, nil
+}
+
+// courseStudents returns the students enrolled in (or done with) the course, limited to studentIDs when given
+func courseStudents(ctx context.Context, db *mongo.Database, courseID string, studentIDs []string) (map[string]bool, error) {
+	filter := bson.M{"course_id": courseID, "status": bson.M{"$in": bson.A{EnrollmentEnrolled, EnrollmentCompleted}}}
+	if len(studentIDs) > 0 {
+		filter["student_id"] = bson.M{"$in": studentIDs}
+	}
+
+	ids, err := db.Collection("enrollments").Distinct(ctx, "student_id", filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	students := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if studentID, ok := id.(string); ok {
+			students[studentID] = true
+		}
+	}
+	return students, nil
+}
+
+// checkAssessment validates type, weight and maximum score of a new assessment
+func checkAssessment(assessment *models.Assessment) error {
+	if assessment.Type == "" || assessment.Weight <= 0 || assessment.MaxScore <= 0 {
+		return fmt.Errorf("%w: type, a positive weight and a positive max_score are required", ErrGrades)
+	}
+	return checkAssessmentUpdate(assessment)
+}
+
+// checkAssessmentUpdate validates the fields of an assessment that are set
+func checkAssessmentUpdate(assessment *models.Assessment) error {
+	switch assessment.Type {
+	case "", "homework", "quiz", "exam":
+	default:
+		return fmt.Errorf("%w: invalid assessment type %q", ErrGrades, assessment.Type)
+	}
+	if assessment.Weight < 0 || assessment.MaxScore < 0 {
+		return fmt.Errorf("%w: weight and max_score can not be negative", ErrGrades)
+	}
+	return nil
+}
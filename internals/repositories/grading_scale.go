@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GradeBoundary is the lowest percentage that still earns the letter, Points is what the letter is worth in the GPA
+type GradeBoundary struct {
+	Letter string
+	Min    float64
+	Points float64
+}
+
+// gradingScale is ordered from the highest boundary down, it is replaced at startup by SetGradingScale
+var gradingScale = []GradeBoundary{
+	{Letter: "A", Min: 90, Points: 4},
+	{Letter: "B", Min: 80, Points: 3},
+	{Letter: "C", Min: 70, Points: 2},
+	{Letter: "D", Min: 60, Points: 1},
+	{Letter: "F", Min: 0, Points: 0},
+}
+
+// SetGradingScale replaces the grading scale with a spec like "A:90:4,B:80:3,C:70:2,D:60:1,F:0:0" (LETTER:MIN:POINTS).
+// when no entry has points the letters are worth len-1 down to 0 points from the top.
+// an empty spec keeps the default scale, the lowest boundary has to be 0 so every average gets a letter.
+func SetGradingScale(spec string) error {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil
+	}
+
+	var scale []GradeBoundary
+	withPoints := 0
+	for _, part := range strings.Split(spec, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ":")
+		if len(fields) < 2 || len(fields) > 3 || fields[0] == "" {
+			return fmt.Errorf("invalid grading scale entry %q, expected LETTER:MIN or LETTER:MIN:POINTS", part)
+		}
+		minValue, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || minValue < 0 || minValue > 100 {
+			return fmt.Errorf("invalid minimum in grading scale entry %q", part)
+		}
+		boundary := GradeBoundary{Letter: fields[0], Min: minValue}
+		if len(fields) == 3 {
+			boundary.Points, err = strconv.ParseFloat(fields[2], 64)
+			if err != nil || boundary.Points < 0 {
+				return fmt.Errorf("invalid points in grading scale entry %q", part)
+			}
+			withPoints++
+		}
+		scale = append(scale, boundary)
+	}
+
+	if withPoints != 0 && withPoints != len(scale) {
+		return errors.New("either every or no entry of the grading scale must have points")
+	}
+
+	sort.Slice(scale, func(i, j int) bool { return scale[i].Min > scale[j].Min })
+	if scale[len(scale)-1].Min != 0 {
+		return errors.New("the lowest grade of the grading scale must start at 0")
+	}
+
+	if withPoints == 0 {
+		for i := range scale {
+			scale[i].Points = float64(len(scale) - 1 - i)
+		}
+	}
+
+	gradingScale = scale
+	return nil
+}
+
+// letterGrade returns the letter for a percentage
+func letterGrade(percent float64) string {
+	return gradeBoundary(percent).Letter
+}
+
+// gradePoints returns the GPA points for a percentage
+func gradePoints(percent float64) float64 {
+	return gradeBoundary(percent).Points
+}
+
+func gradeBoundary(percent float64) GradeBoundary {
+	for _, boundary := range gradingScale {
+		if percent >= boundary.Min {
+			return boundary
+		}
+	}
+	return gradingScale[len(gradingScale)-1]
+}
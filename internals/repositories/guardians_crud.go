@@ -0,0 +1,417 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrGuardian is returned when a guardian or guardian link refers to a student or guardian that does not exist
+var ErrGuardian = errors.New("guardian integrity violation")
+
+/*
+Guardians are stored once and linked to their students in student_guardians, so siblings share the guardian record.
+The links of a student are numbered 1..n by emergency priority without gaps, every change to the links of a student
+renumbers all of them inside one transaction.
+*/
+
+// Add guardians to MongoDB
+func AddGuardiansDBHandler(ctx context.Context, guardiansFromReq []*pb.Guardian) ([]*pb.Guardian, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedGuardians []*pb.Guardian
+
+	for _, pbGuardian := range guardiansFromReq {
+		guardian := MapPBToModelGuardian(pbGuardian)
+
+		err = checkGuardian(guardian)
+		if err != nil {
+			return nil, err
+		}
+
+		// the guardian.created event is written with the guardian
+		guardian.Id = ""
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			result, err := db.Collection("guardians").InsertOne(sc, guardian)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				guardian.Id = objectID.Hex()
+			}
+			return recordEntityEvents(sc, db, "guardians", ActionCreated, []string{guardian.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		addedGuardians = append(addedGuardians, MapModelToPbGuardian(guardian))
+	}
+
+	return addedGuardians, nil
+}
+
+// Get guardians from MongoDB with optional sorting
+func GetGuardiansDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Guardian, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findPage(ctx, client.Database("school").Collection("guardians"), filter, sortOption, pageSize, pageNumber,
+		func() *models.Guardian { return &models.Guardian{} }, func() *pb.Guardian { return &pb.Guardian{} })
+}
+
+// Update guardians in MongoDB
+func UpdateGuardiansDBHandler(ctx context.Context, pbGuardians []*pb.Guardian) ([]*pb.Guardian, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedGuardians []*pb.Guardian
+
+	for _, pbGuardian := range pbGuardians {
+
+		// Validate ID
+		if pbGuardian.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findGuardian(ctx, db, pbGuardian.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		modelGuardian := MapPBToModelGuardian(pbGuardian)
+		updateDoc, err := updateDocFromModel(modelGuardian)
+		if err != nil {
+			return nil, err
+		}
+
+		// the guardian has to stay reachable
+		merged := *current
+		raw, err := bson.Marshal(updateDoc)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		err = bson.Unmarshal(raw, &merged)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		err = checkGuardian(&merged)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			_, err := db.Collection("guardians").UpdateOne(sc, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, bson.M{"$set": updateDoc})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("error updating guardian id: %s", pbGuardian.Id))
+			}
+			return recordEntityEvents(sc, db, "guardians", ActionUpdated, []string{current.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		updatedGuardians = append(updatedGuardians, MapModelToPbGuardian(&merged))
+	}
+
+	return updatedGuardians, nil
+}
+
+// delete guardians in mongoDB by id (soft delete), their links are removed and the priorities of the students renumbered
+func DeleteGuardiansDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		ids, err := matchingIDs(sc, db.Collection("guardians"), bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil})
+		if err != nil {
+			return err
+		}
+		deletedIds, err = softDeleteByIDs(sc, db.Collection("guardians"), objectIds, deletedBy, "guardians")
+		if err != nil {
+			return err
+		}
+		err = recordEntityEvents(sc, db, "guardians", ActionDeleted, ids)
+		if err != nil {
+			return err
+		}
+
+		studentIDs, err := db.Collection("student_guardians").Distinct(sc, "student_id", bson.M{"guardian_id": bson.M{"$in": deletedIds}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		_, err = db.Collection("student_guardians").DeleteMany(sc, bson.M{"guardian_id": bson.M{"$in": deletedIds}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		for _, studentID := range studentIDs {
+			id, _ := studentID.(string)
+			links, err := studentGuardianLinks(sc, db, id)
+			if err != nil {
+				return err
+			}
+			err = renumberGuardianLinks(sc, db, id, linkedGuardianIDs(links))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedIds, nil
+}
+
+// LinkGuardianDBHandler links the guardian to the student at the given emergency priority, 0 puts the guardian last.
+// linking a guardian that is already linked moves it to the new priority
+func LinkGuardianDBHandler(ctx context.Context, studentID, guardianID string, priority int32) (*pb.GuardianLink, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	if priority < 0 {
+		return nil, fmt.Errorf("%w: priority can not be negative", ErrGuardian)
+	}
+
+	// the student has to be active, graduated students keep their guardians
+	count, err := db.Collection("students").CountDocuments(ctx, bson.M{"_id": mustObjectID(studentID), "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("%w: student %s does not exist", ErrGuardian, studentID)
+	}
+	_, err = findGuardian(ctx, db, guardianID)
+	if err != nil {
+		return nil, err
+	}
+
+	var position int
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		links, err := studentGuardianLinks(sc, db, studentID)
+		if err != nil {
+			return err
+		}
+
+		order := make([]string, 0, len(links)+1)
+		for _, id := range linkedGuardianIDs(links) {
+			if id != guardianID {
+				order = append(order, id)
+			}
+		}
+
+		position = len(order)
+		if priority > 0 && int(priority) <= len(order) {
+			position = int(priority) - 1
+		}
+		order = append(order[:position], append([]string{guardianID}, order[position:]...)...)
+
+		return renumberGuardianLinks(sc, db, studentID, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GuardianLink{StudentId: studentID, GuardianId: guardianID, Priority: int32(position + 1)}, nil
+}
+
+// UnlinkGuardianDBHandler removes the link between the guardian and the student, the guardians after it move up
+func UnlinkGuardianDBHandler(ctx context.Context, studentID, guardianID string) error {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	return mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		res, err := db.Collection("student_guardians").DeleteOne(sc, bson.M{"student_id": studentID, "guardian_id": guardianID})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if res.DeletedCount == 0 {
+			return fmt.Errorf("%w: guardian %s is not linked to student %s", ErrGuardian, guardianID, studentID)
+		}
+
+		links, err := studentGuardianLinks(sc, db, studentID)
+		if err != nil {
+			return err
+		}
+		return renumberGuardianLinks(sc, db, studentID, linkedGuardianIDs(links))
+	})
+}
+
+// StudentGuardiansDBHandler returns the active guardians of the students keyed by student id, ordered by priority
+func StudentGuardiansDBHandler(ctx context.Context, studentIDs []string) (map[string][]*pb.StudentGuardian, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	cursor, err := db.Collection("student_guardians").Find(ctx, bson.M{"student_id": bson.M{"$in": studentIDs}},
+		options.Find().SetSort(bson.D{{Key: "student_id", Value: 1}, {Key: "priority", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var links []models.GuardianLink
+	err = cursor.All(ctx, &links)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	guardianIDs := make([]string, 0, len(links))
+	for _, link := range links {
+		guardianIDs = append(guardianIDs, link.GuardianId)
+	}
+	cursor, err = db.Collection("guardians").Find(ctx, bson.M{"_id": bson.M{"$in": mustObjectIDs(guardianIDs)}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var guardians []models.Guardian
+	err = cursor.All(ctx, &guardians)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	byID := make(map[string]models.Guardian, len(guardians))
+	for _, guardian := range guardians {
+		byID[guardian.Id] = guardian
+	}
+
+	byStudent := make(map[string][]*pb.StudentGuardian, len(studentIDs))
+	for _, link := range links {
+		guardian, ok := byID[link.GuardianId]
+		if !ok {
+			continue
+		}
+		byStudent[link.StudentId] = append(byStudent[link.StudentId], &pb.StudentGuardian{
+			GuardianId:   guardian.Id,
+			FirstName:    guardian.FirstName,
+			LastName:     guardian.LastName,
+			Relationship: guardian.Relationship,
+			Phone:        guardian.Phone,
+			Email:        guardian.Email,
+			Address:      guardian.Address,
+			Priority:     link.Priority,
+		})
+	}
+	return byStudent, nil
+}
+
+// a guardian needs a name and a way to be reached
+func checkGuardian(guardian *models.Guardian) error {
+	if guardian.FirstName == "" || guardian.LastName == "" {
+		return fmt.Errorf("%w: guardian first_name and last_name are required", ErrGuardian)
+	}
+	if guardian.Phone == "" && guardian.Email == "" {
+		return fmt.Errorf("%w: guardian %s %s needs a phone or an email", ErrGuardian, guardian.FirstName, guardian.LastName)
+	}
+	return nil
+}
+
+// findGuardian loads an active guardian by id
+func findGuardian(ctx context.Context, db *mongo.Database, id string) (*models.Guardian, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid guardian id: %v", id))
+	}
+
+	var guardian models.Guardian
+	err = db.Collection("guardians").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&guardian)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: guardian %s does not exist", ErrGuardian, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &guardian, nil
+}
+
+// studentGuardianLinks loads the guardian links of a student ordered by priority
+func studentGuardianLinks(ctx context.Context, db *mongo.Database, studentID string) ([]models.GuardianLink, error) {
+	cursor, err := db.Collection("student_guardians").Find(ctx, bson.M{"student_id": studentID},
+		options.Find().SetSort(bson.D{{Key: "priority", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var links []models.GuardianLink
+	err = cursor.All(ctx, &links)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return links, nil
+}
+
+func linkedGuardianIDs(links []models.GuardianLink) []string {
+	ids := make([]string, 0, len(links))
+	for _, link := range links {
+		ids = append(ids, link.GuardianId)
+	}
+	return ids
+}
+
+// renumberGuardianLinks gives the guardians of the student the priorities 1..n in the given order,
+// guardians that were not linked yet are linked
+func renumberGuardianLinks(ctx context.Context, db *mongo.Database, studentID string, order []string) error {
+	now := time.Now().Format(time.RFC3339)
+	for i, guardianID := range order {
+		_, err := db.Collection("student_guardians").UpdateOne(ctx,
+			bson.M{"student_id": studentID, "guardian_id": guardianID},
+			bson.M{
+				"$set":         bson.M{"priority": int32(i + 1)},
+				"$setOnInsert": bson.M{"linked_at": now},
+			},
+			options.Update().SetUpsert(true))
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+	}
+	return nil
+}
@@ -2,12 +2,16 @@ package repositories
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"school_project_grpc/internals/models"
 	"school_project_grpc/pkg/utils"
 	pb "school_project_grpc/proto/gen"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 /*
@@ -42,36 +46,100 @@ func DecodedEntities[T any, M any](ctx context.Context, cursor *mongo.Cursor, ne
 
 		// Create a new protobuf entity
 		entity := newentity()
+		copyModelToEntity(model, entity)
 
-		// Use reflection to copy fields from model -> protobuf entity
-		// model is a pointer, so we need Elem() to access the struct value
-		modelVal := reflect.ValueOf(model).Elem()
-		// entity is also a pointer, so Elem() gives the struct inside
-		pbVal := reflect.ValueOf(entity).Elem()
+		entities = append(entities, entity)
+	}
+
+	// Check if cursor had errors during iteration
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
 
-		// Go through each field in model and try to set same field in protobuf entity
-		for i := 0; i < modelVal.NumField(); i++ {
-			modelField := modelVal.Field(i)
-			modelFieldName := modelVal.Type().Field(i).Name
+	return entities, nil
+}
 
-			// Find the field with same name inside protobuf struct
-			pbField := pbVal.FieldByName(modelFieldName)
+// copyModelToEntity copies the fields of the model to the fields with the same name and type of the protobuf entity
+func copyModelToEntity[T any, M any](model *M, entity *T) {
+	// Use reflection to copy fields from model -> protobuf entity
+	// model is a pointer, so we need Elem() to access the struct value
+	modelVal := reflect.ValueOf(model).Elem()
+	// entity is also a pointer, so Elem() gives the struct inside
+	pbVal := reflect.ValueOf(entity).Elem()
+
+	// Go through each field in model and try to set same field in protobuf entity
+	for i := 0; i < modelVal.NumField(); i++ {
+		modelField := modelVal.Field(i)
+		modelFieldName := modelVal.Type().Field(i).Name
 
-			// If field exists and can be set, set it
-			if pbField.IsValid() && pbField.CanSet() {
-				pbField.Set(modelField)
-			}
+		// Find the field with same name inside protobuf struct
+		pbField := pbVal.FieldByName(modelFieldName)
+
+		// If field exists, can be set and has the same type, set it
+		if pbField.IsValid() && pbField.CanSet() && modelField.Type().AssignableTo(pbField.Type()) {
+			pbField.Set(modelField)
 		}
+	}
+}
 
-		entities = append(entities, entity)
+// findPage runs a paged find on the collection and decodes the documents into protobuf entities
+func findPage[T any, M any](ctx context.Context, coll *mongo.Collection, filter bson.M, sortOption bson.D, pageSize, pageNumber uint32, newmodel func() *M, newentity func() *T) ([]*T, error) {
+	findOptions := options.Find()
+
+	findOptions.SetSkip(int64((pageNumber - 1) * pageSize))
+	findOptions.SetLimit(int64(pageSize))
+
+	if len(sortOption) > 0 {
+		findOptions.SetSort(sortOption)
 	}
+	cursor, err := coll.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
+	}
+	defer cursor.Close(ctx)
 
-	// Check if cursor had errors during iteration
-	if err := cursor.Err(); err != nil {
+	return DecodedEntities(ctx, cursor, newmodel, newentity)
+}
+
+// toObjectIDs converts hex ids from the request into mongo ObjectIDs
+func toObjectIDs(ids []string) ([]primitive.ObjectID, error) {
+	objectIds := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+		}
+		objectIds = append(objectIds, objectId)
+	}
+	return objectIds, nil
+}
+
+// hexIDs converts mongo ObjectIDs back into the hex ids returned to the client
+func hexIDs(objectIds []primitive.ObjectID) []string {
+	ids := make([]string, 0, len(objectIds))
+	for _, v := range objectIds {
+		ids = append(ids, v.Hex())
+	}
+	return ids
+}
+
+// updateDocFromModel turns a model into a $set document, _id and the soft delete fields are never updated
+func updateDocFromModel(model any) (bson.M, error) {
+	raw, err := bson.Marshal(model)
+	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
 
-	return entities, nil
+	var updateDoc bson.M
+	err = bson.Unmarshal(raw, &updateDoc)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	delete(updateDoc, "_id")
+	delete(updateDoc, "deleted_at")
+	delete(updateDoc, "deleted_by")
+	return updateDoc, nil
 }
 
 /*
@@ -104,8 +172,9 @@ func mapModelToPb[T any, M any](model *M, newentity func() *T) *T {
 		modelField := modelVal.Field(i)
 		fieldName := modelType.Field(i).Name
 
+		// fields of another type, like nested messages, are left to the wrapper of the entity
 		pbField := pbVal.FieldByName(fieldName)
-		if pbField.IsValid() && pbField.CanSet() {
+		if pbField.IsValid() && pbField.CanSet() && modelField.Type().AssignableTo(pbField.Type()) {
 			pbField.Set(modelField)
 		}
 	}
@@ -123,6 +192,109 @@ func MapModelToPbExec(exec *models.Exec) *pb.Exec {
 	return mapModelToPb(exec, func() *pb.Exec { return &pb.Exec{} })
 }
 
+// MapModelToPbClass maps internal Class model -> protobuf Class entity.
+func MapModelToPbClass(class *models.Class) *pb.Class {
+	return mapModelToPb(class, func() *pb.Class { return &pb.Class{} })
+}
+
+// MapModelToPbSubject maps internal Subject model -> protobuf Subject entity.
+func MapModelToPbSubject(subject *models.Subject) *pb.Subject {
+	return mapModelToPb(subject, func() *pb.Subject { return &pb.Subject{} })
+}
+
+// MapModelToPbCourse maps internal Course model -> protobuf Course entity.
+func MapModelToPbCourse(course *models.Course) *pb.Course {
+	return mapModelToPb(course, func() *pb.Course { return &pb.Course{} })
+}
+
+// MapModelToPbEnrollment maps internal Enrollment model -> protobuf Enrollment entity.
+func MapModelToPbEnrollment(enrollment *models.Enrollment) *pb.Enrollment {
+	return mapModelToPb(enrollment, func() *pb.Enrollment { return &pb.Enrollment{} })
+}
+
+// MapModelToPbAttendanceRecord maps internal AttendanceRecord model -> protobuf AttendanceRecord entity, with its corrections.
+func MapModelToPbAttendanceRecord(record *models.AttendanceRecord) *pb.AttendanceRecord {
+	pbRecord := mapModelToPb(record, func() *pb.AttendanceRecord { return &pb.AttendanceRecord{} })
+	for i := range record.CorrectionLog {
+		pbRecord.Corrections = append(pbRecord.Corrections,
+			mapModelToPb(&record.CorrectionLog[i], func() *pb.AttendanceCorrection { return &pb.AttendanceCorrection{} }))
+	}
+	return pbRecord
+}
+
+// MapModelToPbAssessment maps internal Assessment model -> protobuf Assessment entity.
+func MapModelToPbAssessment(assessment *models.Assessment) *pb.Assessment {
+	return mapModelToPb(assessment, func() *pb.Assessment { return &pb.Assessment{} })
+}
+
+// MapModelToPbScore maps internal Score model -> protobuf Score entity.
+func MapModelToPbScore(score *models.Score) *pb.Score {
+	return mapModelToPb(score, func() *pb.Score { return &pb.Score{} })
+}
+
+// MapModelToPbReportCardComment maps internal ReportCardComment model -> protobuf ReportCardComment entity.
+func MapModelToPbReportCardComment(comment *models.ReportCardComment) *pb.ReportCardComment {
+	return mapModelToPb(comment, func() *pb.ReportCardComment { return &pb.ReportCardComment{} })
+}
+
+// MapModelToPbAcademicYear maps internal AcademicYear model -> protobuf AcademicYear entity.
+func MapModelToPbAcademicYear(year *models.AcademicYear) *pb.AcademicYear {
+	return mapModelToPb(year, func() *pb.AcademicYear { return &pb.AcademicYear{} })
+}
+
+// MapModelToPbSession maps internal Session model -> protobuf Session entity.
+func MapModelToPbSession(session *models.Session) *pb.Session {
+	return mapModelToPb(session, func() *pb.Session { return &pb.Session{} })
+}
+
+// MapModelToPbTerm maps internal Term model -> protobuf Term entity.
+func MapModelToPbTerm(term *models.Term) *pb.Term {
+	return mapModelToPb(term, func() *pb.Term { return &pb.Term{} })
+}
+
+// MapModelToPbHoliday maps internal Holiday model -> protobuf Holiday entity.
+func MapModelToPbHoliday(holiday *models.Holiday) *pb.Holiday {
+	return mapModelToPb(holiday, func() *pb.Holiday { return &pb.Holiday{} })
+}
+
+// MapModelToPbGuardian maps internal Guardian model -> protobuf Guardian entity.
+func MapModelToPbGuardian(guardian *models.Guardian) *pb.Guardian {
+	return mapModelToPb(guardian, func() *pb.Guardian { return &pb.Guardian{} })
+}
+
+// MapModelToPbAnnouncement maps internal Announcement model -> protobuf Announcement entity, attachments included.
+func MapModelToPbAnnouncement(announcement *models.Announcement) *pb.Announcement {
+	entity := mapModelToPb(announcement, func() *pb.Announcement { return &pb.Announcement{} })
+	for i := range announcement.Attachments {
+		entity.Attachments = append(entity.Attachments, mapModelToPb(&announcement.Attachments[i], func() *pb.Attachment { return &pb.Attachment{} }))
+	}
+	return entity
+}
+
+// MapModelToPbWebhookEndpoint maps internal WebhookEndpoint model -> protobuf WebhookEndpoint entity.
+func MapModelToPbWebhookEndpoint(endpoint *models.WebhookEndpoint) *pb.WebhookEndpoint {
+	return mapModelToPb(endpoint, func() *pb.WebhookEndpoint { return &pb.WebhookEndpoint{} })
+}
+
+// MapModelToPbWebhookDelivery maps internal WebhookDelivery model -> protobuf WebhookDelivery entity, the attempt log included.
+func MapModelToPbWebhookDelivery(delivery *models.WebhookDelivery) *pb.WebhookDelivery {
+	entity := mapModelToPb(delivery, func() *pb.WebhookDelivery { return &pb.WebhookDelivery{} })
+	for i := range delivery.AttemptLog {
+		entity.AttemptLog = append(entity.AttemptLog, mapModelToPb(&delivery.AttemptLog[i], func() *pb.WebhookAttempt { return &pb.WebhookAttempt{} }))
+	}
+	return entity
+}
+
+// MapModelToPbNotification maps internal Notification model -> protobuf Notification entity.
+func MapModelToPbNotification(notification *models.Notification) *pb.Notification {
+	return mapModelToPb(notification, func() *pb.Notification { return &pb.Notification{} })
+}
+
+// MapModelToPbNotificationPreferences maps internal NotificationPreferences model -> protobuf NotificationPreferences entity.
+func MapModelToPbNotificationPreferences(preferences *models.NotificationPreferences) *pb.NotificationPreferences {
+	return mapModelToPb(preferences, func() *pb.NotificationPreferences { return &pb.NotificationPreferences{} })
+}
+
 // MapModelToPbStudent maps internal Student model -> protobuf Student entity.
 func MapModelToPbStudent(Student *models.Student) *pb.Student {
 	return mapModelToPb(Student, func() *pb.Student { return &pb.Student{} })
@@ -152,7 +324,7 @@ func mapPBToModel[T any, M any](entity *T, newModel func() *M) *M {
 		fieldName := pbVal.Type().Field(i).Name
 
 		modelField := modelVal.FieldByName(fieldName)
-		if modelField.IsValid() && modelField.CanSet() {
+		if modelField.IsValid() && modelField.CanSet() && field.Type().AssignableTo(modelField.Type()) {
 			modelField.Set(field)
 		}
 	}
@@ -170,6 +342,80 @@ func MapPBToModelExec(pbExec *pb.Exec) *models.Exec {
 	return mapPBToModel(pbExec, func() *models.Exec { return &models.Exec{} })
 }
 
+// MapPBToModelClass maps protobuf Class -> internal Class model.
+func MapPBToModelClass(pbClass *pb.Class) *models.Class {
+	return mapPBToModel(pbClass, func() *models.Class { return &models.Class{} })
+}
+
+// MapPBToModelSubject maps protobuf Subject -> internal Subject model.
+func MapPBToModelSubject(pbSubject *pb.Subject) *models.Subject {
+	return mapPBToModel(pbSubject, func() *models.Subject { return &models.Subject{} })
+}
+
+// MapPBToModelCourse maps protobuf Course -> internal Course model.
+func MapPBToModelCourse(pbCourse *pb.Course) *models.Course {
+	return mapPBToModel(pbCourse, func() *models.Course { return &models.Course{} })
+}
+
+// MapPBToModelAttendanceRecord maps protobuf AttendanceRecord -> internal AttendanceRecord model, corrections are never taken from a request.
+func MapPBToModelAttendanceRecord(pbRecord *pb.AttendanceRecord) *models.AttendanceRecord {
+	return mapPBToModel(pbRecord, func() *models.AttendanceRecord { return &models.AttendanceRecord{} })
+}
+
+// MapPBToModelAssessment maps protobuf Assessment -> internal Assessment model.
+func MapPBToModelAssessment(pbAssessment *pb.Assessment) *models.Assessment {
+	return mapPBToModel(pbAssessment, func() *models.Assessment { return &models.Assessment{} })
+}
+
+// MapPBToModelReportCardComment maps protobuf ReportCardComment -> internal ReportCardComment model.
+func MapPBToModelReportCardComment(pbComment *pb.ReportCardComment) *models.ReportCardComment {
+	return mapPBToModel(pbComment, func() *models.ReportCardComment { return &models.ReportCardComment{} })
+}
+
+// MapPBToModelAcademicYear maps protobuf AcademicYear -> internal AcademicYear model.
+func MapPBToModelAcademicYear(pbAcademicYear *pb.AcademicYear) *models.AcademicYear {
+	return mapPBToModel(pbAcademicYear, func() *models.AcademicYear { return &models.AcademicYear{} })
+}
+
+// MapPBToModelSession maps protobuf Session -> internal Session model.
+func MapPBToModelSession(pbSession *pb.Session) *models.Session {
+	return mapPBToModel(pbSession, func() *models.Session { return &models.Session{} })
+}
+
+// MapPBToModelTerm maps protobuf Term -> internal Term model.
+func MapPBToModelTerm(pbTerm *pb.Term) *models.Term {
+	return mapPBToModel(pbTerm, func() *models.Term { return &models.Term{} })
+}
+
+// MapPBToModelHoliday maps protobuf Holiday -> internal Holiday model.
+func MapPBToModelHoliday(pbHoliday *pb.Holiday) *models.Holiday {
+	return mapPBToModel(pbHoliday, func() *models.Holiday { return &models.Holiday{} })
+}
+
+// MapPBToModelGuardian maps protobuf Guardian -> internal Guardian model.
+func MapPBToModelGuardian(pbGuardian *pb.Guardian) *models.Guardian {
+	return mapPBToModel(pbGuardian, func() *models.Guardian { return &models.Guardian{} })
+}
+
+// MapPBToModelAnnouncement maps protobuf Announcement -> internal Announcement model, attachments included.
+func MapPBToModelAnnouncement(pbAnnouncement *pb.Announcement) *models.Announcement {
+	model := mapPBToModel(pbAnnouncement, func() *models.Announcement { return &models.Announcement{} })
+	for _, attachment := range pbAnnouncement.GetAttachments() {
+		model.Attachments = append(model.Attachments, *mapPBToModel(attachment, func() *models.AnnouncementAttachment { return &models.AnnouncementAttachment{} }))
+	}
+	return model
+}
+
+// MapPBToModelWebhookEndpoint maps protobuf WebhookEndpoint -> internal WebhookEndpoint model.
+func MapPBToModelWebhookEndpoint(pbEndpoint *pb.WebhookEndpoint) *models.WebhookEndpoint {
+	return mapPBToModel(pbEndpoint, func() *models.WebhookEndpoint { return &models.WebhookEndpoint{} })
+}
+
+// MapPBToModelNotificationPreferences maps protobuf NotificationPreferences -> internal NotificationPreferences model.
+func MapPBToModelNotificationPreferences(pbPreferences *pb.NotificationPreferences) *models.NotificationPreferences {
+	return mapPBToModel(pbPreferences, func() *models.NotificationPreferences { return &models.NotificationPreferences{} })
+}
+
 // MapPBToModelStrudent maps protobuf Exec -> internal Student model.
 func MapPBToModelStudent(pbStudent *pb.Student) *models.Student {
 	return mapPBToModel(pbStudent, func() *models.Student { return &models.Student{} })
@@ -0,0 +1,361 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ImportImported  = "imported"
+	ImportValid     = "valid"
+	ImportInvalid   = "invalid"
+	ImportDuplicate = "duplicate"
+	ImportFailed    = "failed"
+)
+
+// rows written per InsertMany, every batch is one transaction with its events
+const importBatchSize = 200
+
+/*
+Imports check every row like AddStudents and AddTeachers do and skip the rows they refuse, the report says why for each
+of them. Emails identify the people of an import: they are stored trimmed and lower case, a row whose email is already
+used by an active record or by an earlier row of the file is a duplicate. The rows that pass are inserted in batches of
+importBatchSize, a batch that can not be written fails as a whole and the next batch is still tried. A dry run stops
+after the checks, admission numbers are only drawn for the rows that are written.
+*/
+
+// ImportRow is one row of an import file with its line in the file
+type ImportRow[T any] struct {
+	Number uint32
+	Entity T
+}
+
+// ImportStudentsDBHandler checks the students of the rows and inserts the valid ones unless dryRun is set. The result
+// of each row is returned in the order of the rows
+func ImportStudentsDBHandler(ctx context.Context, rows []ImportRow[*pb.Student], dryRun bool) ([]*pb.ImportRowResult, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	emails := make([]string, 0, len(rows))
+	admissionNumbers := make([]string, 0, len(rows))
+	for _, row := range rows {
+		emails = append(emails, normalizeEmail(row.Entity.GetEmail()))
+		if row.Entity.GetAdmissionNumber() != "" {
+			admissionNumbers = append(admissionNumbers, row.Entity.GetAdmissionNumber())
+		}
+	}
+	takenEmails, err := usedValues(ctx, db.Collection("students"), "email", emails, bson.M{"deleted_at": nil})
+	if err != nil {
+		return nil, err
+	}
+	// admission numbers stay taken by deleted and graduated students
+	takenNumbers, err := usedValues(ctx, db.Collection("students"), "admission_number", admissionNumbers, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.ImportRowResult, 0, len(rows))
+	var valid []*models.Student
+	var validResults []*pb.ImportRowResult
+	seenEmails := map[string]uint32{}
+	seenNumbers := map[string]uint32{}
+	classes := map[string]*models.Class{}
+
+	for _, row := range rows {
+		student := MapPBToModelStudent(row.Entity)
+		student.Email = normalizeEmail(student.Email)
+		result := &pb.ImportRowResult{RowNumber: row.Number, Email: student.Email}
+		results = append(results, result)
+
+		status, err := checkImportEmail(student.Email, takenEmails, seenEmails, "student")
+		if err != nil {
+			result.Status, result.Error = status, err.Error()
+			continue
+		}
+		seenEmails[student.Email] = row.Number
+
+		if number := student.AdmissionNumber; number != "" {
+			if takenNumbers[number] || seenNumbers[number] != 0 {
+				result.Status, result.Error = ImportDuplicate, fmt.Sprintf("admission number %s is already used", number)
+				continue
+			}
+			seenNumbers[number] = row.Number
+		}
+
+		// students only graduate through the year-end rollover
+		student.GraduatedAt = ""
+		student.DeletedAt, student.DeletedBy = "", ""
+
+		// like AddStudents, the class has to have a homeroom teacher
+		err = checkNewStudent(student)
+		if err == nil {
+			err = resolveImportClass(ctx, db, classes, &student.ClassId, &student.Class)
+		}
+		if err == nil && student.ClassId != "" {
+			err = checkStudentClass(classes["id:"+student.ClassId])
+		}
+		if err != nil {
+			result.Status, result.Error = ImportInvalid, err.Error()
+			continue
+		}
+
+		result.Status = ImportValid
+		valid = append(valid, student)
+		validResults = append(validResults, result)
+	}
+
+	if dryRun {
+		return results, nil
+	}
+
+	insertImportBatches(ctx, client, db, "students", valid, validResults,
+		func(ctx context.Context, student *models.Student) error {
+			if student.AdmissionNumber != "" {
+				return nil
+			}
+			number, err := nextAdmissionNumber(ctx, db, student.AdmissionDate)
+			student.AdmissionNumber = number
+			return err
+		},
+		func(sc mongo.SessionContext, batch []*models.Student, ids []string) error {
+			return recordMembershipsByID(sc, db, MemberStudent, ids...)
+		})
+
+	return results, nil
+}
+
+// ImportTeachersDBHandler checks the teachers of the rows and inserts the valid ones unless dryRun is set. A teacher
+// with a class becomes its homeroom teacher, so the class has to be without one. The result of each row is returned in
+// the order of the rows
+func ImportTeachersDBHandler(ctx context.Context, rows []ImportRow[*pb.Teacher], dryRun bool) ([]*pb.ImportRowResult, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	emails := make([]string, 0, len(rows))
+	for _, row := range rows {
+		emails = append(emails, normalizeEmail(row.Entity.GetEmail()))
+	}
+	takenEmails, err := usedValues(ctx, db.Collection("teachers"), "email", emails, bson.M{"deleted_at": nil})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.ImportRowResult, 0, len(rows))
+	var valid []*models.Teacher
+	var validResults []*pb.ImportRowResult
+	seenEmails := map[string]uint32{}
+	classes := map[string]*models.Class{}
+	claimedClasses := map[string]uint32{}
+
+	for _, row := range rows {
+		teacher := MapPBToModelTeacher(row.Entity)
+		teacher.Email = normalizeEmail(teacher.Email)
+		result := &pb.ImportRowResult{RowNumber: row.Number, Email: teacher.Email}
+		results = append(results, result)
+
+		status, err := checkImportEmail(teacher.Email, takenEmails, seenEmails, "teacher")
+		if err != nil {
+			result.Status, result.Error = status, err.Error()
+			continue
+		}
+		seenEmails[teacher.Email] = row.Number
+
+		teacher.DeletedAt, teacher.DeletedBy = "", ""
+
+		// a new teacher can only take a class without homeroom teacher, and only one teacher of the file can take it
+		err = resolveImportClass(ctx, db, classes, &teacher.ClassId, &teacher.Class)
+		if err == nil && teacher.ClassId != "" {
+			class := classes["id:"+teacher.ClassId]
+			if class.HomeroomTeacherId != "" {
+				err = fmt.Errorf("%w: class %s already has homeroom teacher %s", ErrClassIntegrity, class.Name, class.HomeroomTeacherId)
+			} else if claimed := claimedClasses[class.Id]; claimed != 0 {
+				err = fmt.Errorf("%w: class %s is already taken by row %d", ErrClassIntegrity, class.Name, claimed)
+			}
+		}
+		if err != nil {
+			result.Status, result.Error = ImportInvalid, err.Error()
+			continue
+		}
+		if teacher.ClassId != "" {
+			claimedClasses[teacher.ClassId] = row.Number
+		}
+
+		result.Status = ImportValid
+		valid = append(valid, teacher)
+		validResults = append(validResults, result)
+	}
+
+	if dryRun {
+		return results, nil
+	}
+
+	insertImportBatches(ctx, client, db, "teachers", valid, validResults, nil,
+		func(sc mongo.SessionContext, batch []*models.Teacher, ids []string) error {
+			for i, teacher := range batch {
+				if teacher.ClassId == "" {
+					continue
+				}
+				err := setHomeroomTeacher(sc, db, classes["id:"+teacher.ClassId], ids[i])
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	return results, nil
+}
+
+// insertImportBatches inserts the models batch by batch and sets the results of their rows. prepare runs for every
+// model of a batch before it is written, written runs inside the transaction of the batch with the new ids
+func insertImportBatches[M any](ctx context.Context, client *mongo.Client, db *mongo.Database, collection string, batchModels []*M, results []*pb.ImportRowResult,
+	prepare func(ctx context.Context, model *M) error, written func(sc mongo.SessionContext, batch []*M, ids []string) error) {
+
+	for start := 0; start < len(batchModels); start += importBatchSize {
+		end := min(start+importBatchSize, len(batchModels))
+		batch := batchModels[start:end]
+
+		var ids []string
+		err := func() error {
+			docs := make([]any, 0, len(batch))
+			for _, model := range batch {
+				if prepare != nil {
+					err := prepare(ctx, model)
+					if err != nil {
+						return err
+					}
+				}
+				docs = append(docs, model)
+			}
+
+			// the created events are written with the batch
+			return mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+				res, err := db.Collection(collection).InsertMany(sc, docs)
+				if err != nil {
+					if mongo.IsDuplicateKeyError(err) {
+						return fmt.Errorf("a row of the batch uses a value that was taken meanwhile: %w", err)
+					}
+					return utils.ErrorHandler(err, "Error adding value into database")
+				}
+
+				ids = make([]string, 0, len(res.InsertedIDs))
+				for _, id := range res.InsertedIDs {
+					objectID, _ := id.(primitive.ObjectID)
+					ids = append(ids, objectID.Hex())
+				}
+
+				err = written(sc, batch, ids)
+				if err != nil {
+					return err
+				}
+				return recordEntityEvents(sc, db, collection, ActionCreated, ids)
+			})
+		}()
+
+		for i, result := range results[start:end] {
+			if err != nil {
+				result.Status, result.Error = ImportFailed, err.Error()
+				continue
+			}
+			result.Status, result.Id = ImportImported, ids[i]
+		}
+	}
+}
+
+// resolveImportClass resolves the class of a row like resolveClassRef, the classes are looked up once per import
+func resolveImportClass(ctx context.Context, db *mongo.Database, classes map[string]*models.Class, classID, className *string) error {
+	if *classID == "" && *className == "" {
+		return nil
+	}
+
+	key := "name:" + *className
+	if *classID != "" {
+		key = "id:" + *classID
+	}
+
+	class, ok := classes[key]
+	if !ok {
+		var err error
+		class, err = findClass(ctx, db, *classID, *className)
+		if err != nil {
+			return err
+		}
+		classes[key] = class
+		classes["id:"+class.Id] = class
+	}
+
+	*classID = class.Id
+	*className = class.Name
+	return nil
+}
+
+// checkImportEmail refuses rows without email as invalid and rows whose email is taken or was in an earlier row as
+// duplicates
+func checkImportEmail(email string, taken map[string]bool, seen map[string]uint32, what string) (string, error) {
+	if email == "" {
+		return ImportInvalid, fmt.Errorf("email is required")
+	}
+	if row := seen[email]; row != 0 {
+		return ImportDuplicate, fmt.Errorf("email %s is already in row %d", email, row)
+	}
+	if taken[email] {
+		return ImportDuplicate, fmt.Errorf("a %s with email %s already exists", what, email)
+	}
+	return "", nil
+}
+
+// usedValues returns which of the values the field already has in the documents matching the filter. Emails are
+// compared ignoring case, records added before the imports may have them in any case
+func usedValues(ctx context.Context, coll *mongo.Collection, field string, values []string, filter bson.M) (map[string]bool, error) {
+	used := map[string]bool{}
+	if len(values) == 0 {
+		return used, nil
+	}
+
+	opts := options.Distinct()
+	if field == "email" {
+		opts.SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	}
+
+	filter[field] = bson.M{"$in": values}
+	found, err := coll.Distinct(ctx, field, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	for _, value := range found {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if field == "email" {
+			s = normalizeEmail(s)
+		}
+		used[s] = true
+	}
+	return used, nil
+}
+
+// normalizeEmail is the form emails are compared and stored in by the imports
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// indexes the repositories rely on for correctness, keyed by collection
+var collectionIndexes = map[string][]mongo.IndexModel{
+	"enrollments": {
+		// one enrollment document per student and course, this is what makes concurrent Enroll calls safe
+		{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "course_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "course_id", Value: 1}, {Key: "status", Value: 1}, {Key: "waitlist_position", Value: 1}}},
+	},
+	"attendance": {
+		// one record per student, date and period, bulk marking relies on it to skip records that already exist
+		{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "date", Value: 1}, {Key: "period", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "class_id", Value: 1}, {Key: "date", Value: 1}}},
+	},
+	"scores": {
+		// one score per student and assessment, recording scores again overwrites it
+		{Keys: bson.D{{Key: "assessment_id", Value: 1}, {Key: "student_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "course_id", Value: 1}, {Key: "student_id", Value: 1}}},
+	},
+	"class_memberships": {
+		// one class per student or teacher and term
+		{Keys: bson.D{{Key: "member_type", Value: 1}, {Key: "member_id", Value: 1}, {Key: "term", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "term", Value: 1}, {Key: "class_id", Value: 1}}},
+	},
+	"terms": {
+		{Keys: bson.D{{Key: "start_date", Value: 1}}},
+	},
+	"sessions": {
+		// the conflict checks look up the sessions of a teacher, class or room on one day of a term
+		{Keys: bson.D{{Key: "term", Value: 1}, {Key: "day", Value: 1}, {Key: "teacher_id", Value: 1}}},
+		{Keys: bson.D{{Key: "term", Value: 1}, {Key: "day", Value: 1}, {Key: "class_id", Value: 1}}},
+		{Keys: bson.D{{Key: "term", Value: 1}, {Key: "day", Value: 1}, {Key: "room", Value: 1}}},
+		{Keys: bson.D{{Key: "course_id", Value: 1}}},
+	},
+	"execs": {
+		// student and teacher accounts are looked up by the profile id in their token
+		{Keys: bson.D{{Key: "profile_id", Value: 1}}, Options: options.Index().SetSparse(true)},
+	},
+	"students": {
+		// admission numbers are unique, students from before the profile migration have none
+		{Keys: bson.D{{Key: "admission_number", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	},
+	"student_guardians": {
+		// a guardian is linked to a student once, linking again only changes the priority
+		{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "guardian_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "guardian_id", Value: 1}}},
+	},
+	"mail_outbox": {
+		// the mail worker claims due mails in the order of their next attempt
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}},
+	},
+	"notifications": {
+		// the inbox of an account, newest first
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "read_at", Value: 1}}},
+	},
+	"announcements": {
+		// the bulletin board and the scheduler look up announcements by status
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "audience", Value: 1}, {Key: "published_at", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "publish_at", Value: 1}}},
+	},
+	"announcement_reads": {
+		// one read receipt per account and announcement
+		{Keys: bson.D{{Key: "announcement_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	},
+	"event_outbox": {
+		// the dispatcher fans out the pending events oldest first
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}}},
+	},
+	"webhook_deliveries": {
+		// one delivery per event and endpoint, replays come on top
+		{Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "endpoint_id", Value: 1}}, Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"replay_of": bson.M{"$exists": false}})},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}},
+		{Keys: bson.D{{Key: "endpoint_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	},
+	"report_comments": {
+		{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "term", Value: 1}, {Key: "course_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+	},
+}
+
+// EnsureIndexesDBHandler creates the indexes in collectionIndexes and the indexes of the sortable fields, existing
+// indexes are left as they are
+func EnsureIndexesDBHandler(ctx context.Context) error {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	for collection, indexes := range collectionIndexes {
+		_, err = db.Collection(collection).Indexes().CreateMany(ctx, indexes)
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to create indexes on "+collection)
+		}
+	}
+
+	// the lists are only sorted by indexed fields
+	for collection := range sortFields {
+		_, err = db.Collection(collection).Indexes().CreateMany(ctx, sortIndexes(collection))
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to create sort indexes on "+collection)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	InvitePending = "pending"
+	InviteExpired = "expired"
+	InviteRevoked = "revoked"
+)
+
+/*
+New users are created without a password. They get an email with a single-use invite code that expires after
+INVITE_TOKEN_EXP_HOURS, only its sha256 hash is stored like the password reset token. Accepting the invite sets the
+password and removes the invite, until then the user can not log in.
+*/
+
+// sendInvite gives the exec a new invite code and queues the invite email, an earlier code of the exec stops working
+func sendInvite(ctx context.Context, db *mongo.Database, exec *models.Exec, invitedBy string) error {
+	hours, err := strconv.Atoi(os.Getenv("INVITE_TOKEN_EXP_HOURS"))
+	if err != nil || hours < 1 {
+		return utils.ErrorHandler(err, "Failed to get invite token exp duration")
+	}
+
+	token, hashedToken, err := newHashedToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	exec.InviteToken = hashedToken
+	exec.InviteTokenExp = now.Add(time.Duration(hours) * time.Hour).Format(time.RFC3339)
+	exec.InvitedAt = now.Format(time.RFC3339)
+	exec.InvitedBy = invitedBy
+	exec.InviteRevokedAt = ""
+
+	filter := bson.M{"_id": mustObjectID(exec.Id)}
+	_, err = db.Collection("execs").UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{
+			"invite_token":     exec.InviteToken,
+			"invite_token_exp": exec.InviteTokenExp,
+			"invited_at":       exec.InvitedAt,
+			"invited_by":       exec.InvitedBy,
+		},
+		"$unset": bson.M{"invite_revoked_at": ""},
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	inviteURL := fmt.Sprintf("https://localhost:50051/execs/invite/accept/%s", token)
+
+	err = queueMail(ctx, db, "invite", exec.Email, map[string]any{
+		"FirstName": exec.FirstName,
+		"Username":  exec.Username,
+		"URL":       inviteURL,
+		"Code":      token,
+		"Hours":     hours,
+	})
+	if err != nil {
+		// the code will never be delivered, it must not stay usable
+		_, _ = db.Collection("execs").UpdateOne(ctx, filter, bson.M{"$unset": bson.M{"invite_token": "", "invite_token_exp": ""}})
+		return utils.ErrorHandler(err, fmt.Sprintf("Failed to send the invite to %s, it can be sent again with ResendInvite", exec.Email))
+	}
+	return nil
+}
+
+// AcceptInviteDBHandler sets the password of the user the invite code was sent to, hashedToken is the sha256 of the code
+func AcceptInviteDBHandler(ctx context.Context, hashedToken, password string) error {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal Error")
+	}
+	defer client.Disconnect(ctx)
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	// the filter makes the code single-use, a second request no longer finds the token
+	filter := bson.M{
+		"deleted_at":       nil,
+		"password":         nil,
+		"invite_token":     hashedToken,
+		"invite_token_exp": bson.M{"$gt": time.Now().Format(time.RFC3339)},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"password":            hashedPassword,
+			"password_changed_at": time.Now().Format(time.RFC3339),
+		},
+		"$unset": bson.M{"invite_token": "", "invite_token_exp": ""},
+	}
+
+	res, err := client.Database("school").Collection("execs").UpdateOne(ctx, filter, update)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if res.ModifiedCount == 0 {
+		return fmt.Errorf("%w: invalid, used or expired invite code", ErrAccount)
+	}
+	return nil
+}
+
+// ListInvitesDBHandler lists the users that have not accepted their invite yet
+func ListInvitesDBHandler(ctx context.Context, includeExpired, includeRevoked bool) ([]*pb.Invite, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	cursor, err := client.Database("school").Collection("execs").Find(ctx,
+		bson.M{"password": nil, "invited_at": bson.M{"$ne": nil}, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "invited_at", Value: -1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var execs []models.Exec
+	err = cursor.All(ctx, &execs)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	invites := make([]*pb.Invite, 0, len(execs))
+	for _, exec := range execs {
+		status := InvitePending
+		if exec.InviteRevokedAt != "" {
+			status = InviteRevoked
+		} else if exec.InviteToken == "" || exec.InviteTokenExp <= now {
+			// an invite whose email could not be sent has no code either
+			status = InviteExpired
+		}
+
+		if (status == InviteExpired && !includeExpired) || (status == InviteRevoked && !includeRevoked) {
+			continue
+		}
+
+		invites = append(invites, &pb.Invite{
+			ExecId:    exec.Id,
+			Username:  exec.Username,
+			Email:     exec.Email,
+			Role:      exec.Role,
+			InvitedAt: exec.InvitedAt,
+			InvitedBy: exec.InvitedBy,
+			ExpiresAt: exec.InviteTokenExp,
+			Status:    status,
+		})
+	}
+	return invites, nil
+}
+
+// ResendInviteDBHandler sends a new invite code to users that have not set their password, revoked invites included
+func ResendInviteDBHandler(ctx context.Context, ids []string, invitedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	execs, err := pendingInviteExecs(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	resent := make([]string, 0, len(execs))
+	for i := range execs {
+		err = sendInvite(ctx, db, &execs[i], invitedBy)
+		if err != nil {
+			return resent, err
+		}
+		resent = append(resent, execs[i].Id)
+	}
+	return resent, nil
+}
+
+// RevokeInviteDBHandler makes the invite codes of the users unusable, the users stay until they are deleted or invited again
+func RevokeInviteDBHandler(ctx context.Context, ids []string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	execs, err := pendingInviteExecs(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make([]string, 0, len(execs))
+	for _, exec := range execs {
+		revoked = append(revoked, exec.Id)
+	}
+
+	_, err = db.Collection("execs").UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": mustObjectIDs(revoked)}},
+		bson.M{
+			"$set":   bson.M{"invite_revoked_at": time.Now().Format(time.RFC3339)},
+			"$unset": bson.M{"invite_token": "", "invite_token_exp": ""},
+		})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return revoked, nil
+}
+
+// pendingInviteExecs loads the execs by id and refuses users that already set their password
+func pendingInviteExecs(ctx context.Context, db *mongo.Database, ids []string) ([]models.Exec, error) {
+	objectIds, err := toObjectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := db.Collection("execs").Find(ctx, bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var execs []models.Exec
+	err = cursor.All(ctx, &execs)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	if len(execs) != len(objectIds) {
+		return nil, fmt.Errorf("%w: %d of the execs do not exist", ErrAccount, len(objectIds)-len(execs))
+	}
+	for _, exec := range execs {
+		if exec.Password != "" {
+			return nil, fmt.Errorf("%w: %s already accepted the invite", ErrAccount, exec.Username)
+		}
+	}
+	return execs, nil
+}
@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"school_project_grpc/internals/mailer"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	MailQueued  = "queued"
+	MailSending = "sending"
+	MailSent    = "sent"
+	MailDead    = "dead"
+)
+
+/*
+Emails are not sent inside the rpcs. They are rendered from the mail templates and stored in the mail_outbox collection,
+the mail worker sends them in the background. A failed send is tried again after a backoff that doubles with every
+attempt, after MAIL_MAX_ATTEMPTS attempts the mail is dead and stays in the outbox for inspection. A worker claims a
+mail for mailLease, a mail of a worker that stopped while sending is picked up again once the lease is over.
+*/
+
+const (
+	mailLease        = 5 * time.Minute
+	mailRetryBackoff = 30 * time.Second
+	mailMaxBackoff   = 6 * time.Hour
+)
+
+// mailTemplates are the email templates, they are set at startup by SetMailTemplates
+var mailTemplates *mailer.Templates
+
+// SetMailTemplates sets the templates the queued emails are rendered from
+func SetMailTemplates(templates *mailer.Templates) {
+	mailTemplates = templates
+}
+
+// queueMail renders the email template for the recipient and puts it into the outbox
+func queueMail(ctx context.Context, db *mongo.Database, template, to string, data any) error {
+	if mailTemplates == nil {
+		return utils.ErrorHandler(errors.New("mail templates are not loaded"), "Internal error")
+	}
+
+	message, err := mailTemplates.Render(template, to, data)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to render the "+template+" email")
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	_, err = db.Collection("mail_outbox").InsertOne(ctx, models.OutboxMail{
+		Template:      template,
+		To:            message.To,
+		Subject:       message.Subject,
+		Text:          message.Text,
+		HTML:          message.HTML,
+		Status:        MailQueued,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to queue the "+template+" email")
+	}
+	return nil
+}
+
+// retryBackoff is the wait before the next attempt of something that failed attempts times, it doubles from base
+// with every attempt up to limit
+func retryBackoff(attempts int32, base, limit time.Duration) time.Duration {
+	backoff := base
+	for i := int32(1); i < attempts && backoff < limit; i++ {
+		backoff *= 2
+	}
+	return min(backoff, limit)
+}
+
+// claimMail takes the next due mail of the outbox for this worker, it returns nil when nothing is due
+func claimMail(ctx context.Context, db *mongo.Database) (*models.OutboxMail, error) {
+	now := time.Now()
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": MailQueued, "next_attempt_at": bson.M{"$lte": now.Format(time.RFC3339)}},
+		bson.M{"status": MailSending, "locked_until": bson.M{"$lt": now.Format(time.RFC3339)}},
+	}}
+	update := bson.M{
+		"$set": bson.M{"status": MailSending, "locked_until": now.Add(mailLease).Format(time.RFC3339)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var mail models.OutboxMail
+	err := db.Collection("mail_outbox").FindOneAndUpdate(ctx, filter, update, opts).Decode(&mail)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &mail, nil
+}
+
+// deliverMail sends a claimed mail and records the outcome, a failed mail is queued again or, out of attempts, dead
+func deliverMail(ctx context.Context, db *mongo.Database, sender mailer.Sender, mail *models.OutboxMail, maxAttempts int32) error {
+	err := sender.Send(ctx, mailer.Message{ID: mail.Id, To: mail.To, Subject: mail.Subject, Text: mail.Text, HTML: mail.HTML})
+
+	now := time.Now()
+	update := bson.M{
+		"$set":   bson.M{"status": MailSent, "sent_at": now.Format(time.RFC3339)},
+		"$unset": bson.M{"locked_until": "", "last_error": "", "next_attempt_at": ""},
+	}
+	if err != nil {
+		set := bson.M{"status": MailQueued, "last_error": err.Error(), "next_attempt_at": now.Add(retryBackoff(mail.Attempts, mailRetryBackoff, mailMaxBackoff)).Format(time.RFC3339)}
+		if mail.Attempts >= maxAttempts {
+			set = bson.M{"status": MailDead, "last_error": err.Error()}
+			log.Printf("Mail %s to %s is dead after %d attempts: %v\n", mail.Id, mail.To, mail.Attempts, err)
+		}
+		update = bson.M{"$set": set, "$unset": bson.M{"locked_until": ""}}
+	}
+
+	_, uerr := db.Collection("mail_outbox").UpdateOne(ctx, bson.M{"_id": mustObjectID(mail.Id), "status": MailSending}, update)
+	if uerr != nil {
+		return uerr
+	}
+	return err
+}
+
+// SendQueuedMailDBHandler sends every mail of the outbox that is due and returns how many were sent
+func SendQueuedMailDBHandler(ctx context.Context, sender mailer.Sender, maxAttempts int32) (int, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	sent := 0
+	for {
+		mail, err := claimMail(ctx, db)
+		if err != nil {
+			return sent, utils.ErrorHandler(err, "Failed to read the mail outbox")
+		}
+		if mail == nil {
+			return sent, nil
+		}
+
+		err = deliverMail(ctx, db, sender, mail, maxAttempts)
+		if err != nil {
+			// the mail waits for its next attempt, the others are still sent
+			log.Printf("Failed to send mail %s to %s (attempt %d): %v\n", mail.Id, mail.To, mail.Attempts, err)
+			continue
+		}
+		sent++
+	}
+}
+
+// RunMailWorker runs forever and sends the queued mails of the outbox on every interval
+func RunMailWorker(sender mailer.Sender, interval time.Duration, maxAttempts int32) {
+	for {
+		time.Sleep(interval)
+
+		sent, err := SendQueuedMailDBHandler(context.Background(), sender, maxAttempts)
+		if err != nil {
+			continue // error is already logged by the error handler, try again on the next tick
+		}
+		if sent > 0 {
+			log.Printf("Mail worker sent %d mails\n", sent)
+		}
+	}
+}
+
+// RequeueDeadMailDBHandler gives dead mails a new set of attempts, all dead mails when ids is empty
+func RequeueDeadMailDBHandler(ctx context.Context, ids []string) (int64, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{"status": MailDead}
+	if len(ids) > 0 {
+		objectIds, err := toObjectIDs(ids)
+		if err != nil {
+			return 0, err
+		}
+		filter["_id"] = bson.M{"$in": objectIds}
+	}
+
+	res, err := client.Database("school").Collection("mail_outbox").UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"status": MailQueued, "attempts": 0, "next_attempt_at": time.Now().Format(time.RFC3339)},
+	})
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Failed to requeue dead mails")
+	}
+	return res.ModifiedCount, nil
+}
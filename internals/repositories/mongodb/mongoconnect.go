@@ -2,17 +2,33 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 	"school_project_grpc/pkg/utils"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// defaultURI is used when MONGODB_URI is not set, a single node replica set on this machine
+const defaultURI = "mongodb://localhost:27017/?replicaSet=rs0"
+
+// clientOptions are the options of MONGODB_URI from the .env. The writes of the repositories run in transactions, which
+// mongo only allows on replica set members, so the uri has to name the replica set with replicaSet=
+func clientOptions() *options.ClientOptions {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		uri = defaultURI
+	}
+	return options.Client().ApplyURI(uri)
+}
+
 func CreatMongoClient() (*mongo.Client, error) {
 	ctx := context.Background()
 
-	// client, err := mongo.Connect(ctx, options.Client().ApplyURI("username:password@mongodb://localhost:27017"))
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://localhost:27017"))
+	client, err := mongo.Connect(ctx, clientOptions())
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Failed to connect to DataBase")
 	}
@@ -25,3 +41,34 @@ func CreatMongoClient() (*mongo.Client, error) {
 
 	return client, nil
 }
+
+// CheckReplicaSet makes sure the server of MONGODB_URI can run transactions: the uri names a replica set and the server
+// is a member of it. A standalone mongod refuses every transaction, so the server does not start against one
+func CheckReplicaSet(ctx context.Context) error {
+	opts := clientOptions()
+	err := opts.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid MONGODB_URI: %w", err)
+	}
+	if opts.ReplicaSet == nil || *opts.ReplicaSet == "" {
+		return errors.New("MONGODB_URI has to name the replica set with replicaSet=, transactions do not run on a standalone mongo server")
+	}
+
+	client, err := CreatMongoClient()
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(ctx)
+
+	var hello struct {
+		SetName string `bson:"setName"`
+	}
+	err = client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to ask mongo for its replica set")
+	}
+	if hello.SetName != *opts.ReplicaSet {
+		return fmt.Errorf("mongo is not a member of the replica set %q of MONGODB_URI, start mongod with --replSet %s and run rs.initiate()", *opts.ReplicaSet, *opts.ReplicaSet)
+	}
+	return nil
+}
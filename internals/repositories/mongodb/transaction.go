@@ -0,0 +1,23 @@
+package mongodb
+
+import (
+	"context"
+	"school_project_grpc/pkg/utils"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RunTransaction runs fn inside a mongo transaction so every write in it is committed or rolled back together.
+// Transactions need mongo to run as a replica set, the server checks that on startup with CheckReplicaSet.
+func RunTransaction(ctx context.Context, client *mongo.Client, fn func(sc mongo.SessionContext) error) error {
+	session, err := client.StartSession()
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to start mongo session")
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
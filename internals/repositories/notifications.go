@@ -0,0 +1,515 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ErrNotification is returned when notification preferences are invalid
+var ErrNotification = errors.New("notification invalid")
+
+// events the handlers emit
+const (
+	EventAttendanceAbsent      = "attendance.absent"
+	EventGradeRecorded         = "grade.recorded"
+	EventAccountDeactivated    = "account.deactivated"
+	EventAnnouncementPublished = "announcement.published"
+)
+
+const (
+	ChannelInApp   = "in_app"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+var notificationEvents = []string{EventAttendanceAbsent, EventGradeRecorded, EventAccountDeactivated, EventAnnouncementPublished}
+
+var notificationChannels = []string{ChannelInApp, ChannelEmail, ChannelWebhook}
+
+// channels of accounts that never saved their preferences
+var defaultNotificationChannels = []string{ChannelInApp, ChannelEmail}
+
+/*
+Notifications belong to login accounts and are keyed by the uid of the account's token, the profile id for students
+and teachers. Every notification is stored with the channels it went out on, the inbox only shows those with the in_app
+channel. Emails go through the mail outbox, webhooks get one POST with the notification as json from a goroutine and
+the outcome is stored on the notification. The POST is signed like the deliveries of the webhook endpoints, with the
+secret of the preferences, and only goes to public addresses so a webhook_url can not reach into the server's network.
+Subscribers of SubscribeNotifications get in_app notifications of this server process as they are stored.
+*/
+
+// NotificationEvent is one event for the accounts in UserIds, those are token uids or exec ids. Ids without an
+// account, like students that never got one, are skipped
+type NotificationEvent struct {
+	Event     string
+	Title     string
+	Body      string
+	SubjectId string
+	UserIds   []string
+}
+
+// NotifyDBHandler delivers the events to the accounts on the channels of their preferences
+func NotifyDBHandler(ctx context.Context, events []NotificationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	for _, event := range events {
+		accounts, err := notificationAccounts(ctx, db, event.UserIds)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			uid := TokenSubject(account)
+			preferences, err := notificationPreferences(ctx, db, uid)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(preferences.MutedEvents, event.Event) {
+				continue
+			}
+
+			err = deliverNotification(ctx, db, account, preferences, event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deliverNotification stores the notification of one account and sends it out on its channels
+func deliverNotification(ctx context.Context, db *mongo.Database, account models.Exec, preferences *models.NotificationPreferences, event NotificationEvent) error {
+	channels := slices.Clone(preferences.Channels)
+	if account.Email == "" || mailTemplates == nil {
+		channels = slices.DeleteFunc(channels, func(c string) bool { return c == ChannelEmail })
+	}
+	if preferences.WebhookUrl == "" {
+		channels = slices.DeleteFunc(channels, func(c string) bool { return c == ChannelWebhook })
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+
+	notification := models.Notification{
+		UserId:    TokenSubject(account),
+		Event:     event.Event,
+		Title:     event.Title,
+		Body:      event.Body,
+		SubjectId: event.SubjectId,
+		Channels:  channels,
+		CreatedAt: time.Now().Format(time.RFC3339),
+	}
+	res, err := db.Collection("notifications").InsertOne(ctx, notification)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to store notification")
+	}
+	notification.Id = res.InsertedID.(primitive.ObjectID).Hex()
+	pbNotification := MapModelToPbNotification(&notification)
+
+	if slices.Contains(channels, ChannelInApp) {
+		notificationSubscribers.publish(notification.UserId, pbNotification)
+	}
+	if slices.Contains(channels, ChannelEmail) {
+		err = queueMail(ctx, db, "notification", account.Email, map[string]any{
+			"FirstName": account.FirstName,
+			"Title":     event.Title,
+			"Body":      event.Body,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if slices.Contains(channels, ChannelWebhook) {
+		go postNotificationWebhook(preferences.WebhookUrl, preferences.WebhookSecret, pbNotification)
+	}
+	return nil
+}
+
+// notificationAccounts loads the accounts of token uids and exec ids
+func notificationAccounts(ctx context.Context, db *mongo.Database, ids []string) ([]models.Exec, error) {
+	objectIds, err := toObjectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"deleted_at": nil, "$or": bson.A{
+		bson.M{"_id": bson.M{"$in": objectIds}},
+		bson.M{"profile_id": bson.M{"$in": ids}},
+	}}
+	cursor, err := db.Collection("execs").Find(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var accounts []models.Exec
+	err = cursor.All(ctx, &accounts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return accounts, nil
+}
+
+// notificationWebhookClient only dials public addresses, the check runs on the address that is dialed so neither a
+// redirect nor a dns record that changed after the url was saved gets around it
+var notificationWebhookClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{Timeout: 5 * time.Second, Control: dialPublicOnly}).DialContext,
+	},
+}
+
+// postNotificationWebhook posts the notification once and stores the outcome on it
+func postNotificationWebhook(webhookURL, secret string, notification *pb.Notification) {
+	body, err := protojson.Marshal(notification)
+	if err == nil {
+		err = sendNotificationWebhook(webhookURL, secret, notification, body)
+	}
+
+	update := bson.M{"$set": bson.M{"webhook_sent_at": time.Now().Format(time.RFC3339)}}
+	if err != nil {
+		log.Printf("Failed to post notification %s to its webhook: %v\n", notification.Id, err)
+		update = bson.M{"$set": bson.M{"webhook_error": err.Error()}}
+	}
+
+	ctx := context.Background()
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		log.Println("Failed to store the webhook outcome of notification", notification.Id, err)
+		return
+	}
+	defer client.Disconnect(ctx)
+
+	_, _ = client.Database("school").Collection("notifications").UpdateOne(ctx, bson.M{"_id": mustObjectID(notification.Id)}, update)
+}
+
+// sendNotificationWebhook posts the body with the headers and the signature of the webhook deliveries
+func sendNotificationWebhook(webhookURL, secret string, notification *pb.Notification, body []byte) error {
+	if secret == "" {
+		return errors.New("the preferences have no webhook secret, save the webhook_url again")
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequest(http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", notification.Id)
+	req.Header.Set("X-Webhook-Event", notification.Event)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", "sha256="+signWebhook(secret, timestamp, string(body)))
+
+	resp, err := notificationWebhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
+
+// dialPublicOnly is the Control of the webhook dialer, it refuses every address that is not public
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !publicAddress(ip) {
+		return fmt.Errorf("%w: webhooks can not be posted to %s", ErrNotification, host)
+	}
+	return nil
+}
+
+// ranges that are not reachable from the internet and that IsPrivate does not know
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// publicAddress tells whether the address is on the internet, loopback, private, link local (the cloud metadata
+// services live there) and multicast addresses are not
+func publicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWebhookHost resolves the host of the webhook url, every address it resolves to has to be public
+func checkWebhookHost(ctx context.Context, webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return fmt.Errorf("%w: webhook_url %q is not an http or https url", ErrNotification, webhookURL)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: the host of webhook_url %q can not be resolved", ErrNotification, webhookURL)
+	}
+	for _, addr := range addrs {
+		if !publicAddress(addr) {
+			return fmt.Errorf("%w: webhook_url %q points to %s, which is not a public address", ErrNotification, webhookURL, addr)
+		}
+	}
+	return nil
+}
+
+// ListNotificationsDBHandler lists the inbox of the account, newest first
+func ListNotificationsDBHandler(ctx context.Context, uid string, unreadOnly bool, event string, pageSize, pageNumber uint32) (*pb.Notifications, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("notifications")
+
+	filter := bson.M{"user_id": uid, "channels": ChannelInApp}
+	if unreadOnly {
+		filter["read_at"] = nil
+	}
+	if event != "" {
+		filter["event"] = event
+	}
+
+	notifications, err := findPage(ctx, coll, filter, bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}, pageSize, pageNumber,
+		func() *models.Notification { return &models.Notification{} },
+		func() *pb.Notification { return &pb.Notification{} })
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := coll.CountDocuments(ctx, bson.M{"user_id": uid, "channels": ChannelInApp, "read_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	return &pb.Notifications{Notifications: notifications, UnreadCount: unread}, nil
+}
+
+// MarkReadDBHandler marks notifications of the account as read, every unread one when ids is empty.
+// it returns how many were marked and how many are still unread
+func MarkReadDBHandler(ctx context.Context, uid string, ids []string) (int64, int64, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("notifications")
+
+	// the user_id keeps an account to its own notifications
+	filter := bson.M{"user_id": uid, "read_at": nil}
+	if len(ids) > 0 {
+		objectIds, err := toObjectIDs(ids)
+		if err != nil {
+			return 0, 0, err
+		}
+		filter["_id"] = bson.M{"$in": objectIds}
+	}
+
+	res, err := coll.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"read_at": time.Now().Format(time.RFC3339)}})
+	if err != nil {
+		return 0, 0, utils.ErrorHandler(err, "Failed to mark notifications read")
+	}
+
+	unread, err := coll.CountDocuments(ctx, bson.M{"user_id": uid, "channels": ChannelInApp, "read_at": nil})
+	if err != nil {
+		return 0, 0, utils.ErrorHandler(err, "Internal error")
+	}
+	return res.ModifiedCount, unread, nil
+}
+
+// GetNotificationPreferencesDBHandler returns the preferences of the account, the defaults when it saved none
+func GetNotificationPreferencesDBHandler(ctx context.Context, uid string) (*pb.NotificationPreferences, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	preferences, err := notificationPreferences(ctx, client.Database("school"), uid)
+	if err != nil {
+		return nil, err
+	}
+	return MapModelToPbNotificationPreferences(preferences), nil
+}
+
+// UpdateNotificationPreferencesDBHandler replaces the preferences of the account. The webhook secret can not be set by
+// the client, the stored one is kept while there is a webhook_url and a new one is made when the url is first set
+func UpdateNotificationPreferencesDBHandler(ctx context.Context, uid string, pbPreferences *pb.NotificationPreferences) (*pb.NotificationPreferences, error) {
+	preferences := MapPBToModelNotificationPreferences(pbPreferences)
+	preferences.WebhookSecret = ""
+	err := checkNotificationPreferences(preferences)
+	if err != nil {
+		return nil, err
+	}
+	if preferences.WebhookUrl != "" {
+		err = checkWebhookHost(ctx, preferences.WebhookUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	if preferences.WebhookUrl != "" {
+		stored, err := notificationPreferences(ctx, client.Database("school"), uid)
+		if err != nil {
+			return nil, err
+		}
+		preferences.WebhookSecret = stored.WebhookSecret
+		if preferences.WebhookSecret == "" {
+			preferences.WebhookSecret, err = newWebhookSecret()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	preferences.Id = ""
+	preferences.UpdatedAt = time.Now().Format(time.RFC3339)
+	if preferences.Channels == nil {
+		preferences.Channels = []string{}
+	}
+	if preferences.MutedEvents == nil {
+		preferences.MutedEvents = []string{}
+	}
+
+	_, err = client.Database("school").Collection("notification_preferences").ReplaceOne(ctx, bson.M{"_id": uid}, preferences, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to save notification preferences")
+	}
+
+	preferences.Id = uid
+	return MapModelToPbNotificationPreferences(preferences), nil
+}
+
+// notificationPreferences loads the preferences of the account with the token uid, the defaults when it saved none
+func notificationPreferences(ctx context.Context, db *mongo.Database, uid string) (*models.NotificationPreferences, error) {
+	var preferences models.NotificationPreferences
+	err := db.Collection("notification_preferences").FindOne(ctx, bson.M{"_id": uid}).Decode(&preferences)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &models.NotificationPreferences{Id: uid, Channels: slices.Clone(defaultNotificationChannels), MutedEvents: []string{}}, nil
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &preferences, nil
+}
+
+// checkNotificationPreferences checks the channels and events, the proto validation rules are not enforced
+func checkNotificationPreferences(preferences *models.NotificationPreferences) error {
+	for _, channel := range preferences.Channels {
+		if !slices.Contains(notificationChannels, channel) {
+			return fmt.Errorf("%w: channel %q is not one of in_app, email, webhook", ErrNotification, channel)
+		}
+	}
+	for _, event := range preferences.MutedEvents {
+		if !slices.Contains(notificationEvents, event) {
+			return fmt.Errorf("%w: unknown event %q", ErrNotification, event)
+		}
+	}
+
+	if preferences.WebhookUrl != "" {
+		u, err := url.Parse(preferences.WebhookUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: webhook_url %q is not an http or https url", ErrNotification, preferences.WebhookUrl)
+		}
+	}
+	if slices.Contains(preferences.Channels, ChannelWebhook) && preferences.WebhookUrl == "" {
+		return fmt.Errorf("%w: the webhook channel needs a webhook_url", ErrNotification)
+	}
+	return nil
+}
+
+// notificationHub hands the in_app notifications to the SubscribeNotifications streams of their account
+type notificationHub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *pb.Notification]bool
+}
+
+var notificationSubscribers = &notificationHub{subscribers: map[string]map[chan *pb.Notification]bool{}}
+
+// SubscribeNotifications returns a channel with the new in_app notifications of the account and a function that ends
+// the subscription. A subscriber that falls behind loses notifications, they stay in the inbox
+func SubscribeNotifications(uid string) (<-chan *pb.Notification, func()) {
+	ch := make(chan *pb.Notification, 16)
+
+	hub := notificationSubscribers
+	hub.mu.Lock()
+	if hub.subscribers[uid] == nil {
+		hub.subscribers[uid] = map[chan *pb.Notification]bool{}
+	}
+	hub.subscribers[uid][ch] = true
+	hub.mu.Unlock()
+
+	return ch, func() {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		delete(hub.subscribers[uid], ch)
+		if len(hub.subscribers[uid]) == 0 {
+			delete(hub.subscribers, uid)
+		}
+	}
+}
+
+func (h *notificationHub) publish(uid string, notification *pb.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[uid] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"school_project_grpc/pkg/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrPageToken is returned for page tokens that were not made by the server or for another sort
+var ErrPageToken = errors.New("invalid page token")
+
+/*
+The lists of students, teachers and execs are paged by keyset instead of Skip: a page token holds the sort and the
+sort values of the last document of the page, the next page is the documents that sort after it. _id is added as the
+last sort key so every document has its own place in the order, and a page does not shift when documents are added
+in front of it. The token is only base64 to the client, a token of another sort is refused. Without a token the old
+page_num still skips pages.
+*/
+
+// PageRequest is the page a list asks for, Token wins over Number
+type PageRequest struct {
+	Size      uint32
+	Number    uint32
+	Token     string
+	WithTotal bool
+}
+
+// PageInfo is where the next page starts, NextPageToken is empty on the last page. TotalSize is only counted when the
+// request asked for it
+type PageInfo struct {
+	NextPageToken string
+	TotalSize     int64
+}
+
+// pageToken is encoded into the page tokens
+type pageToken struct {
+	Sort  bson.D        `bson:"s"`
+	After bson.RawValue `bson:"a"`
+}
+
+// findKeysetPage loads the page of the documents matching the filter in the order of the sort
+func findKeysetPage[T any, M any](ctx context.Context, coll *mongo.Collection, filter bson.M, sortOption bson.D, page PageRequest, newmodel func() *M, newentity func() *T) ([]*T, PageInfo, error) {
+	var info PageInfo
+
+	// the total is counted before the filter is narrowed to the page
+	if page.WithTotal {
+		total, err := coll.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, info, utils.ErrorHandler(err, "Failed to fetch data from db")
+		}
+		info.TotalSize = total
+	}
+
+	sortOption = keysetSort(sortOption)
+
+	// one document more than the page tells whether there is a next page
+	findOptions := options.Find().SetSort(sortOption).SetLimit(int64(page.Size) + 1)
+	if page.Token != "" {
+		after, err := afterPageToken(page.Token, sortOption)
+		if err != nil {
+			return nil, info, err
+		}
+		filter = bson.M{"$and": bson.A{filter, after}}
+	} else if page.Number > 1 {
+		findOptions.SetSkip(int64((page.Number - 1) * page.Size))
+	}
+
+	cursor, err := coll.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, info, utils.ErrorHandler(err, "Failed to fetch data from db")
+	}
+	defer cursor.Close(ctx)
+
+	var entities []*T
+	var last bson.Raw
+	for cursor.Next(ctx) {
+		if uint32(len(entities)) == page.Size {
+			info.NextPageToken, err = newPageToken(sortOption, last)
+			if err != nil {
+				return nil, info, err
+			}
+			break
+		}
+
+		model := newmodel()
+		err = cursor.Decode(model)
+		if err != nil {
+			return nil, info, utils.ErrorHandler(err, "Failed to fetch data from db")
+		}
+		entity := newentity()
+		copyModelToEntity(model, entity)
+		entities = append(entities, entity)
+
+		last = append(last[:0], cursor.Current...)
+	}
+	if cursor.Err() != nil {
+		return nil, info, utils.ErrorHandler(cursor.Err(), "Failed to fetch data from db")
+	}
+
+	return entities, info, nil
+}
+
+// keysetSort is the sort with _id as the last key, the order of documents with the same sort values. _id goes in the
+// direction of the key before it like in buildSortOptions of the handlers
+func keysetSort(sortOption bson.D) bson.D {
+	order := int64(1)
+	for _, key := range sortOption {
+		if key.Key == "_id" {
+			return sortOption
+		}
+		order = sortValue(key.Value)
+	}
+	return append(append(bson.D{}, sortOption...), bson.E{Key: "_id", Value: order})
+}
+
+// newPageToken encodes the sort and the sort values of the last document of a page
+func newPageToken(sortOption bson.D, last bson.Raw) (string, error) {
+	values := bson.D{}
+	for _, key := range sortOption {
+		value, err := last.LookupErr(strings.Split(key.Key, ".")...)
+		if err != nil {
+			// missing fields sort like null
+			values = append(values, bson.E{Key: key.Key, Value: nil})
+			continue
+		}
+		values = append(values, bson.E{Key: key.Key, Value: value})
+	}
+
+	after, err := bson.Marshal(values)
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Failed to create page token")
+	}
+	token, err := bson.Marshal(pageToken{Sort: sortOption, After: bson.RawValue{Type: bson.TypeEmbeddedDocument, Value: after}})
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Failed to create page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// afterPageToken turns a page token into the filter of the documents that sort after the last document of its page:
+// the first sort key is past its value, or it is equal and the second is past its value, and so on
+func afterPageToken(encoded string, sortOption bson.D) (bson.M, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPageToken, err)
+	}
+	var token pageToken
+	err = bson.Unmarshal(raw, &token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPageToken, err)
+	}
+	if !sameSort(token.Sort, sortOption) {
+		return nil, fmt.Errorf("%w: the token was made for another sort_by, start again from the first page", ErrPageToken)
+	}
+	values, err := token.After.Document().Elements()
+	if err != nil || len(values) != len(sortOption) {
+		return nil, fmt.Errorf("%w: the token is damaged", ErrPageToken)
+	}
+
+	branches := bson.A{}
+	equal := bson.M{}
+	for i, key := range sortOption {
+		value := values[i].Value()
+		null := value.Type == bson.TypeNull
+
+		past := keysetPast(key.Key, value, null, sortValue(key.Value) < 0)
+		if past != nil {
+			branch := bson.M{}
+			for k, v := range equal {
+				branch[k] = v
+			}
+			for k, v := range past {
+				branch[k] = v
+			}
+			branches = append(branches, branch)
+		}
+
+		if null {
+			equal[key.Key] = nil
+		} else {
+			equal[key.Key] = value
+		}
+	}
+
+	// the last document was past every other one
+	if len(branches) == 0 {
+		return bson.M{"_id": bson.M{"$exists": false}}, nil
+	}
+	return bson.M{"$or": branches}, nil
+}
+
+// keysetPast matches the values of the key that sort after value. Missing fields and null sort first, so they come
+// after every value in a descending sort and nothing comes before them in an ascending one. nil when nothing is past
+func keysetPast(key string, value bson.RawValue, null, descending bool) bson.M {
+	switch {
+	case null && descending:
+		return nil
+	case null:
+		return bson.M{key: bson.M{"$ne": nil}}
+	case descending:
+		return bson.M{"$or": bson.A{bson.M{key: bson.M{"$lt": value}}, bson.M{key: nil}}}
+	}
+	return bson.M{key: bson.M{"$gt": value}}
+}
+
+// sameSort compares the keys and directions of two sorts
+func sameSort(a, b bson.D) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || sortValue(a[i].Value) != sortValue(b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortValue is the direction of a sort key, the token brings it back as another integer type than the handlers use
+func sortValue(value any) int64 {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	}
+	return 0
+}
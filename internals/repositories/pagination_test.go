@@ -0,0 +1,385 @@
+package repositories
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// the documents are paged in memory: matchesFilter evaluates the operators afterPageToken uses, compareValues orders
+// values like mongo does for the types of the test documents, null and missing first
+
+func compareValues(a, b bson.RawValue) int {
+	aNull := a.Type == 0 || a.Type == bson.TypeNull
+	bNull := b.Type == 0 || b.Type == bson.TypeNull
+	switch {
+	case aNull && bNull:
+		return 0
+	case aNull:
+		return -1
+	case bNull:
+		return 1
+	}
+	if aID, ok := a.ObjectIDOK(); ok {
+		bID, _ := b.ObjectIDOK()
+		return bytes.Compare(aID[:], bID[:])
+	}
+	return strings.Compare(a.StringValue(), b.StringValue())
+}
+
+func matchesFilter(t *testing.T, doc bson.Raw, filter bson.M) bool {
+	for key, cond := range filter {
+		switch key {
+		case "$or", "$and":
+			any := false
+			all := true
+			for _, sub := range cond.(bson.A) {
+				if matchesFilter(t, doc, sub.(bson.M)) {
+					any = true
+				} else {
+					all = false
+				}
+			}
+			if key == "$or" && !any || key == "$and" && !all {
+				return false
+			}
+			continue
+		}
+
+		value := doc.Lookup(key)
+		null := value.Type == 0 || value.Type == bson.TypeNull
+		switch c := cond.(type) {
+		case nil:
+			if !null {
+				return false
+			}
+		case bson.RawValue:
+			if null || compareValues(value, c) != 0 {
+				return false
+			}
+		case bson.M:
+			for op, operand := range c {
+				switch op {
+				case "$gt", "$lt":
+					if null {
+						return false
+					}
+					cmp := compareValues(value, operand.(bson.RawValue))
+					if op == "$gt" && cmp <= 0 || op == "$lt" && cmp >= 0 {
+						return false
+					}
+				case "$ne":
+					if operand != nil {
+						t.Fatalf("unexpected $ne operand %v", operand)
+					}
+					if null {
+						return false
+					}
+				case "$exists":
+					if operand.(bool) != (value.Type != 0) {
+						return false
+					}
+				default:
+					t.Fatalf("unexpected operator %s", op)
+				}
+			}
+		default:
+			t.Fatalf("unexpected condition %T for %s", cond, key)
+		}
+	}
+	return true
+}
+
+// sortDocs orders the documents like mongo would for the sort
+func sortDocs(docs []bson.Raw, sortOption bson.D) {
+	slices.SortStableFunc(docs, func(a, b bson.Raw) int {
+		for _, key := range sortOption {
+			cmp := compareValues(a.Lookup(key.Key), b.Lookup(key.Key))
+			if sortValue(key.Value) < 0 {
+				cmp = -cmp
+			}
+			if cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	})
+}
+
+// pageThrough reads every page of the documents like findKeysetPage and returns the ids in the order they came
+func pageThrough(t *testing.T, docs []bson.Raw, sortOption bson.D, size int) []primitive.ObjectID {
+	sortOption = keysetSort(sortOption)
+
+	var seen []primitive.ObjectID
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > len(docs)+1 {
+			t.Fatal("the pages do not end")
+		}
+
+		var page []bson.Raw
+		for _, doc := range docs {
+			if token == "" {
+				page = append(page, doc)
+				continue
+			}
+			after, err := afterPageToken(token, sortOption)
+			if err != nil {
+				t.Fatalf("afterPageToken failed: %v", err)
+			}
+			if matchesFilter(t, doc, after) {
+				page = append(page, doc)
+			}
+		}
+		sortDocs(page, sortOption)
+
+		for _, doc := range page[:min(size, len(page))] {
+			seen = append(seen, doc.Lookup("_id").ObjectID())
+		}
+		if len(page) <= size {
+			return seen
+		}
+
+		var err error
+		token, err = newPageToken(sortOption, page[size-1])
+		if err != nil {
+			t.Fatalf("newPageToken failed: %v", err)
+		}
+	}
+}
+
+func testDocs(t *testing.T) []bson.Raw {
+	names := []any{"Smith", "Adams", "Smith", nil, "Brown", "Smith", "Adams", "missing", "Smith", nil, "Clark"}
+
+	var docs []bson.Raw
+	for _, name := range names {
+		doc := bson.D{{Key: "_id", Value: primitive.NewObjectID()}}
+		if name != "missing" {
+			doc = append(doc, bson.E{Key: "last_name", Value: name})
+		}
+		raw, err := bson.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		docs = append(docs, raw)
+	}
+	// the ids are not in the order of the names, so the ties are not sorted by accident
+	slices.Reverse(docs)
+	return docs
+}
+
+func TestKeysetPagesVisitEveryDocumentOnce(t *testing.T) {
+	docs := testDocs(t)
+
+	sorts := map[string]bson.D{
+		"ascending":        {{Key: "last_name", Value: 1}},
+		"descending":       {{Key: "last_name", Value: -1}},
+		"id only":          {},
+		"id descending":    {{Key: "_id", Value: -1}},
+		"tiebreaker given": {{Key: "last_name", Value: -1}, {Key: "_id", Value: 1}},
+	}
+	for name, sortOption := range sorts {
+		for _, size := range []int{1, 2, 3, len(docs), len(docs) + 1} {
+			want := slices.Clone(docs)
+			sortDocs(want, keysetSort(sortOption))
+			wantIDs := make([]primitive.ObjectID, 0, len(want))
+			for _, doc := range want {
+				wantIDs = append(wantIDs, doc.Lookup("_id").ObjectID())
+			}
+
+			got := pageThrough(t, docs, sortOption, size)
+			if !slices.Equal(got, wantIDs) {
+				t.Errorf("%s with pages of %d: got %v, want %v", name, size, got, wantIDs)
+			}
+		}
+	}
+}
+
+func TestKeysetTiesAreResolvedByID(t *testing.T) {
+	ids := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()}
+	var docs []bson.Raw
+	for _, id := range ids {
+		raw, _ := bson.Marshal(bson.D{{Key: "_id", Value: id}, {Key: "last_name", Value: "Smith"}})
+		docs = append(docs, raw)
+	}
+
+	sortOption := keysetSort(bson.D{{Key: "last_name", Value: 1}})
+	token, err := newPageToken(sortOption, docs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := afterPageToken(token, sortOption)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if matchesFilter(t, docs[0], after) {
+		t.Error("the last document of the page is on the next page again")
+	}
+	for _, doc := range docs[1:] {
+		if !matchesFilter(t, doc, after) {
+			t.Errorf("document %v with the same last_name is skipped", doc.Lookup("_id").ObjectID())
+		}
+	}
+
+	// descending, _id goes down too
+	sortOption = keysetSort(bson.D{{Key: "last_name", Value: -1}})
+	token, err = newPageToken(sortOption, docs[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err = afterPageToken(token, sortOption)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, doc := range docs {
+		if got := matchesFilter(t, doc, after); got != (i < 2) {
+			t.Errorf("descending: document %d after the token = %v", i, got)
+		}
+	}
+}
+
+func TestKeysetSort(t *testing.T) {
+	tests := []struct {
+		name string
+		sort bson.D
+		want bson.D
+	}{
+		{"empty", bson.D{}, bson.D{{Key: "_id", Value: int64(1)}}},
+		{"ascending", bson.D{{Key: "a", Value: 1}}, bson.D{{Key: "a", Value: 1}, {Key: "_id", Value: int64(1)}}},
+		{"descending", bson.D{{Key: "a", Value: 1}, {Key: "b", Value: -1}},
+			bson.D{{Key: "a", Value: 1}, {Key: "b", Value: -1}, {Key: "_id", Value: int64(-1)}}},
+		{"has _id", bson.D{{Key: "_id", Value: -1}, {Key: "a", Value: 1}}, bson.D{{Key: "_id", Value: -1}, {Key: "a", Value: 1}}},
+	}
+	for _, tt := range tests {
+		if got := keysetSort(tt.sort); !sameSort(got, tt.want) {
+			t.Errorf("%s: keysetSort = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSameSort(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b bson.D
+		want bool
+	}{
+		{"same", bson.D{{Key: "a", Value: 1}, {Key: "_id", Value: 1}}, bson.D{{Key: "a", Value: 1}, {Key: "_id", Value: 1}}, true},
+		{"integer types", bson.D{{Key: "a", Value: int32(-1)}}, bson.D{{Key: "a", Value: int64(-1)}}, true},
+		{"direction", bson.D{{Key: "a", Value: 1}}, bson.D{{Key: "a", Value: -1}}, false},
+		{"key", bson.D{{Key: "a", Value: 1}}, bson.D{{Key: "b", Value: 1}}, false},
+		{"order of keys", bson.D{{Key: "a", Value: 1}, {Key: "b", Value: 1}}, bson.D{{Key: "b", Value: 1}, {Key: "a", Value: 1}}, false},
+		{"length", bson.D{{Key: "a", Value: 1}}, bson.D{{Key: "a", Value: 1}, {Key: "_id", Value: 1}}, false},
+	}
+	for _, tt := range tests {
+		if got := sameSort(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: sameSort = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	id := primitive.NewObjectID()
+	last, _ := bson.Marshal(bson.D{{Key: "_id", Value: id}, {Key: "last_name", Value: "Smith"}, {Key: "email", Value: "a@b.c"}})
+	sortOption := keysetSort(bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}})
+
+	token, err := newPageToken(sortOption, last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(token); err != nil {
+		t.Fatalf("the token is not url safe base64: %v", err)
+	}
+
+	raw, _ := base64.RawURLEncoding.DecodeString(token)
+	var decoded pageToken
+	if err := bson.Unmarshal(raw, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !sameSort(decoded.Sort, sortOption) {
+		t.Errorf("the token sort is %v, want %v", decoded.Sort, sortOption)
+	}
+	values, err := decoded.After.Document().Elements()
+	if err != nil || len(values) != 3 {
+		t.Fatalf("the token holds %v, %v", values, err)
+	}
+	if values[0].Value().StringValue() != "Smith" {
+		t.Errorf("last_name = %v", values[0].Value())
+	}
+	if values[1].Value().Type != bson.TypeNull {
+		t.Errorf("the missing first_name is %v, want null", values[1].Value())
+	}
+	if values[2].Value().ObjectID() != id {
+		t.Errorf("_id = %v, want %v", values[2].Value(), id)
+	}
+
+	if _, err := afterPageToken(token, sortOption); err != nil {
+		t.Errorf("afterPageToken refused its own token: %v", err)
+	}
+}
+
+func TestPageTokenRejected(t *testing.T) {
+	last, _ := bson.Marshal(bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "last_name", Value: "Smith"}})
+	sortOption := keysetSort(bson.D{{Key: "last_name", Value: 1}})
+	token, err := newPageToken(sortOption, last)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	short, _ := bson.Marshal(bson.D{{Key: "last_name", Value: "Smith"}})
+	shortToken, _ := bson.Marshal(pageToken{Sort: sortOption, After: bson.RawValue{Type: bson.TypeEmbeddedDocument, Value: short}})
+
+	tests := []struct {
+		name  string
+		token string
+		sort  bson.D
+	}{
+		{"not base64", "not a token!", sortOption},
+		{"not bson", base64.RawURLEncoding.EncodeToString([]byte("hello world")), sortOption},
+		{"truncated", token[:len(token)/2], sortOption},
+		{"standard base64 padding", token + "==", sortOption},
+		{"other direction", token, keysetSort(bson.D{{Key: "last_name", Value: -1}})},
+		{"other field", token, keysetSort(bson.D{{Key: "first_name", Value: 1}})},
+		{"more fields", token, keysetSort(bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}})},
+		{"values missing", base64.RawURLEncoding.EncodeToString(shortToken), sortOption},
+	}
+	for _, tt := range tests {
+		_, err := afterPageToken(tt.token, tt.sort)
+		if !errors.Is(err, ErrPageToken) {
+			t.Errorf("%s: afterPageToken = %v, want an ErrPageToken", tt.name, err)
+		}
+	}
+}
+
+func TestKeysetPast(t *testing.T) {
+	raw, _ := bson.Marshal(bson.D{{Key: "a", Value: "M"}})
+	value := bson.Raw(raw).Lookup("a")
+
+	if got := keysetPast("a", bson.RawValue{Type: bson.TypeNull}, true, true); got != nil {
+		t.Errorf("nothing comes after null descending, got %v", got)
+	}
+	if got := keysetPast("a", bson.RawValue{Type: bson.TypeNull}, true, false); !matchesPast(t, got, "B") || matchesPast(t, got, nil) {
+		t.Errorf("every value comes after null ascending, got %v", got)
+	}
+	if got := keysetPast("a", value, false, false); !matchesPast(t, got, "N") || matchesPast(t, got, "M") || matchesPast(t, got, nil) {
+		t.Errorf("ascending past M, got %v", got)
+	}
+	if got := keysetPast("a", value, false, true); !matchesPast(t, got, "L") || !matchesPast(t, got, nil) || matchesPast(t, got, "M") {
+		t.Errorf("descending past M, got %v", got)
+	}
+}
+
+func matchesPast(t *testing.T, filter bson.M, value any) bool {
+	doc := bson.D{}
+	if value != nil {
+		doc = append(doc, bson.E{Key: "a", Value: value})
+	}
+	raw, _ := bson.Marshal(doc)
+	return matchesFilter(t, raw, filter)
+}
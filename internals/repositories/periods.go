@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Period is a lesson slot of the school day, the times are HH:MM
+type Period struct {
+	Number int32
+	Start  string
+	End    string
+}
+
+// periods are the lesson slots of a day by number, they are replaced at startup by SetPeriods
+var periods = map[int32]Period{
+	1: {Number: 1, Start: "08:00", End: "08:45"},
+	2: {Number: 2, Start: "08:55", End: "09:40"},
+	3: {Number: 3, Start: "09:50", End: "10:35"},
+	4: {Number: 4, Start: "10:55", End: "11:40"},
+	5: {Number: 5, Start: "11:50", End: "12:35"},
+	6: {Number: 6, Start: "13:20", End: "14:05"},
+	7: {Number: 7, Start: "14:15", End: "15:00"},
+	8: {Number: 8, Start: "15:10", End: "15:55"},
+}
+
+var clockTime = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// SetPeriods replaces the periods with a spec like "1:08:00-08:45,2:08:55-09:40" (NUMBER:START-END).
+// an empty spec keeps the default periods.
+func SetPeriods(spec string) error {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil
+	}
+
+	parsed := map[int32]Period{}
+	for _, part := range strings.Split(spec, ",") {
+		number, times, ok := strings.Cut(strings.TrimSpace(part), ":")
+		start, end, okTimes := strings.Cut(times, "-")
+		if !ok || !okTimes {
+			return fmt.Errorf("invalid period %q, expected NUMBER:START-END", part)
+		}
+		n, err := strconv.ParseInt(number, 10, 32)
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid period number in %q", part)
+		}
+		if !clockTime.MatchString(start) || !clockTime.MatchString(end) || start >= end {
+			return fmt.Errorf("invalid times in period %q", part)
+		}
+		if _, ok := parsed[int32(n)]; ok {
+			return fmt.Errorf("period %d is given twice", n)
+		}
+		parsed[int32(n)] = Period{Number: int32(n), Start: start, End: end}
+	}
+
+	periods = parsed
+	return nil
+}
+
+// sessionTimes fills start and end from the period or checks the given times, a period wins over given times
+func sessionTimes(period int32, start, end string) (string, string, error) {
+	if period != 0 {
+		p, ok := periods[period]
+		if !ok {
+			return "", "", fmt.Errorf("%w: period %d is not configured", ErrTimetable, period)
+		}
+		return p.Start, p.End, nil
+	}
+
+	if !clockTime.MatchString(start) || !clockTime.MatchString(end) {
+		return "", "", fmt.Errorf("%w: a period or start_time and end_time (HH:MM) are required", ErrTimetable)
+	}
+	if start >= end {
+		return "", "", fmt.Errorf("%w: start_time %s is not before end_time %s", ErrTimetable, start, end)
+	}
+	return start, end, nil
+}
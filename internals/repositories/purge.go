@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"context"
+	"log"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// collections that use soft delete, documents in them are only removed by the purge job. The collections that point at
+// others come first, so a course and the teacher it names can be purged in the same run
+var softDeleteCollections = []string{"sessions", "assessments", "courses", "students", "teachers", "classes", "subjects", "academic_years", "terms", "holidays", "guardians", "execs", "announcements", "webhook_endpoints"}
+
+// purgeLink is a field of a collection that holds the id of a document of another collection
+type purgeLink struct {
+	collection string
+	field      string
+}
+
+/*
+A purged document takes the records that only exist for it along (purgeDependents), the enrollments, scores and
+accounts of a student are worthless once the student is gone. Records that live on their own and point at the document
+(purgeReferences), a course naming its teacher, keep the document: it is skipped until nothing points at it anymore,
+a soft deleted referrer counts too as it can still be restored. Every document is purged with its dependents in one
+transaction.
+*/
+
+// records removed together with the document they belong to, keyed by the collection of the document
+var purgeDependents = map[string][]purgeLink{
+	"students": {
+		{"class_memberships", "member_id"}, {"enrollments", "student_id"}, {"scores", "student_id"},
+		{"attendance", "student_id"}, {"student_guardians", "student_id"}, {"report_comments", "student_id"},
+		{"execs", "profile_id"},
+	},
+	"teachers":          {{"class_memberships", "member_id"}, {"execs", "profile_id"}},
+	"classes":           {{"class_memberships", "class_id"}},
+	"courses":           {{"enrollments", "course_id"}, {"scores", "course_id"}, {"report_comments", "course_id"}},
+	"assessments":       {{"scores", "assessment_id"}},
+	"guardians":         {{"student_guardians", "guardian_id"}},
+	"announcements":     {{"announcement_reads", "announcement_id"}},
+	"webhook_endpoints": {{"webhook_deliveries", "endpoint_id"}},
+}
+
+// records that keep the document they point at from being purged, keyed by the collection of the document
+var purgeReferences = map[string][]purgeLink{
+	"teachers": {{"courses", "teacher_id"}, {"classes", "homeroom_teacher_id"}, {"sessions", "teacher_id"}},
+	"classes": {
+		{"courses", "class_id"}, {"sessions", "class_id"}, {"students", "class_id"}, {"teachers", "class_id"},
+		{"attendance", "class_id"},
+	},
+	"courses":        {{"sessions", "course_id"}, {"assessments", "course_id"}},
+	"subjects":       {{"courses", "subject_id"}, {"subjects", "prerequisite_subject_ids"}},
+	"academic_years": {{"terms", "academic_year_id"}},
+}
+
+// PurgeDeletedDBHandler hard deletes every document that was soft deleted before the cutoff time, with the records that
+// belong to it. Documents other records still point at are kept
+func PurgeDeletedDBHandler(ctx context.Context, cutoff time.Time) (int64, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	// deleted_at is stored as RFC3339 in UTC so it can be compared as a string like the reset token expiry
+	filter := bson.M{"deleted_at": bson.M{"$ne": nil, "$lt": cutoff.UTC().Format(time.RFC3339)}}
+
+	var purged int64
+	for _, coll := range softDeleteCollections {
+		n, err := purgeCollection(ctx, client, coll, filter)
+		purged += n
+		if err != nil {
+			return purged, err
+		}
+	}
+	return purged, nil
+}
+
+// purgeCollection purges the documents of the collection matching the filter one by one
+func purgeCollection(ctx context.Context, client *mongo.Client, coll string, filter bson.M) (int64, error) {
+	db := client.Database("school")
+
+	cursor, err := db.Collection(coll).Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Failed to purge "+coll)
+	}
+	var docs []struct {
+		Id primitive.ObjectID `bson:"_id"`
+	}
+	err = cursor.All(ctx, &docs)
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Failed to purge "+coll)
+	}
+
+	var purged int64
+	for _, doc := range docs {
+		removed := false
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			removed = false
+			id := doc.Id.Hex()
+			for _, ref := range purgeReferences[coll] {
+				err := db.Collection(ref.collection).FindOne(sc, bson.M{ref.field: id}, options.FindOne().SetProjection(bson.M{"_id": 1})).Err()
+				if err == nil {
+					return nil
+				}
+				if err != mongo.ErrNoDocuments {
+					return utils.ErrorHandler(err, "Failed to purge "+coll)
+				}
+			}
+
+			// the document may have been restored since it was found
+			res, err := db.Collection(coll).DeleteOne(sc, bson.M{"_id": doc.Id, "deleted_at": filter["deleted_at"]})
+			if err != nil {
+				return utils.ErrorHandler(err, "Failed to purge "+coll)
+			}
+			if res.DeletedCount == 0 {
+				return nil
+			}
+			for _, dep := range purgeDependents[coll] {
+				_, err = db.Collection(dep.collection).DeleteMany(sc, bson.M{dep.field: id})
+				if err != nil {
+					return utils.ErrorHandler(err, "Failed to purge the "+dep.collection+" of "+coll)
+				}
+			}
+			removed = true
+			return nil
+		})
+		if err != nil {
+			return purged, err
+		}
+		if removed {
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// PurgeDeletedRecords runs forever and removes soft deleted documents once they are older than the retention period
+func PurgeDeletedRecords(interval, retention time.Duration) {
+	for {
+		time.Sleep(interval)
+
+		purged, err := PurgeDeletedDBHandler(context.Background(), time.Now().Add(-retention))
+		if err != nil {
+			continue // error is already logged by the error handler, try again on the next tick
+		}
+		log.Printf("Purge job removed %d soft deleted documents\n", purged)
+	}
+}
@@ -0,0 +1,398 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/reports"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrReports is returned when a report can not be assembled for the requested student or class
+var ErrReports = errors.New("report refused")
+
+// GetReportCardDBHandler assembles the report card of a student for a term
+func GetReportCardDBHandler(ctx context.Context, studentID, term, fromDate, toDate string) (*reports.ReportCard, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	student, err := findReportStudent(ctx, db, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildReportCard(ctx, db, newReportLookup(), student, term, fromDate, toDate)
+}
+
+// GetClassReportCardsDBHandler assembles the report cards of every active student of a class for a term
+func GetClassReportCardsDBHandler(ctx context.Context, classID, term, fromDate, toDate string) (string, []*reports.ReportCard, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	class, err := findClass(ctx, db, classID, "")
+	if err != nil {
+		if errors.Is(err, ErrClassIntegrity) {
+			return "", nil, fmt.Errorf("%w: class %s does not exist", ErrReports, classID)
+		}
+		return "", nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}})
+	cursor, err := db.Collection("students").Find(ctx, bson.M{"class_id": class.Id, "deleted_at": nil}, opts)
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var students []models.Student
+	err = cursor.All(ctx, &students)
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	lookup := newReportLookup()
+	cards := make([]*reports.ReportCard, 0, len(students))
+	for i := range students {
+		card, err := buildReportCard(ctx, db, lookup, &students[i], term, fromDate, toDate)
+		if err != nil {
+			return "", nil, err
+		}
+		cards = append(cards, card)
+	}
+
+	return class.Name, cards, nil
+}
+
+// GetTranscriptDBHandler assembles the cumulative transcript of a student over every term
+func GetTranscriptDBHandler(ctx context.Context, studentID string) (*reports.Transcript, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	student, err := findReportStudent(ctx, db, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := newReportLookup()
+	courses, err := studentCourses(ctx, db, student.Id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	byTerm := map[string][]reports.CourseResult{}
+	for _, course := range courses {
+		result, err := courseResult(ctx, db, lookup, course, student.Id)
+		if err != nil {
+			return nil, err
+		}
+		byTerm[course.Term] = append(byTerm[course.Term], result)
+	}
+
+	transcript := &reports.Transcript{
+		Student:     reportStudentInfo(student),
+		GeneratedAt: time.Now().Format(time.RFC3339),
+	}
+
+	var all []reports.CourseResult
+	for term, results := range byTerm {
+		gpa, credits := gradePointAverage(results)
+		transcript.Terms = append(transcript.Terms, reports.TermResult{Term: term, Courses: results, GPA: gpa, Credits: credits})
+		all = append(all, results...)
+	}
+	sort.Slice(transcript.Terms, func(i, j int) bool { return transcript.Terms[i].Term < transcript.Terms[j].Term })
+	transcript.CumulativeGPA, transcript.TotalCredits = gradePointAverage(all)
+
+	return transcript, nil
+}
+
+// SetReportCardCommentDBHandler stores the comment of a student for a term, one per course plus the class teacher comment.
+// a teacherID limits the write to courses the teacher teaches, or to the students of their class for the class teacher comment.
+func SetReportCardCommentDBHandler(ctx context.Context, pbComment *pb.ReportCardComment, teacherID, authorID string) (*pb.ReportCardComment, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	comment := MapPBToModelReportCardComment(pbComment)
+	if comment.StudentId == "" || comment.Term == "" {
+		return nil, fmt.Errorf("%w: student_id and term are required", ErrReports)
+	}
+
+	student, err := findReportStudent(ctx, db, comment.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.CourseId != "" {
+		_, err = findTaughtCourse(ctx, db, comment.CourseId, teacherID)
+		if err != nil {
+			return nil, err
+		}
+		enrolled, err := courseStudents(ctx, db, comment.CourseId, []string{student.Id})
+		if err != nil {
+			return nil, err
+		}
+		if !enrolled[student.Id] {
+			return nil, fmt.Errorf("%w: student %s is not enrolled in course %s", ErrReports, student.Id, comment.CourseId)
+		}
+	} else if teacherID != "" {
+		class, err := findHomeroomClass(ctx, db, teacherID)
+		if err != nil {
+			return nil, err
+		}
+		if class == nil || class.Id != student.ClassId {
+			return nil, fmt.Errorf("%w: only the class teacher can write the class teacher comment", ErrNotCourseTeacher)
+		}
+	}
+
+	comment.AuthorId = authorID
+	comment.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	var stored models.ReportCardComment
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err = db.Collection("report_comments").FindOneAndUpdate(ctx,
+		bson.M{"student_id": comment.StudentId, "term": comment.Term, "course_id": comment.CourseId},
+		bson.M{"$set": bson.M{"comment": comment.Comment, "author_id": comment.AuthorId, "updated_at": comment.UpdatedAt}},
+		opts).Decode(&stored)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to save the comment")
+	}
+
+	return MapModelToPbReportCardComment(&stored), nil
+}
+
+// reportLookup caches subjects and teachers while reports are assembled, a class batch looks them up once
+type reportLookup struct {
+	subjects map[string]*models.Subject
+	teachers map[string]*models.Teacher
+}
+
+func newReportLookup() *reportLookup {
+	return &reportLookup{subjects: map[string]*models.Subject{}, teachers: map[string]*models.Teacher{}}
+}
+
+// buildReportCard assembles the report card of an already loaded student
+func buildReportCard(ctx context.Context, db *mongo.Database, lookup *reportLookup, student *models.Student, term, fromDate, toDate string) (*reports.ReportCard, error) {
+	card := &reports.ReportCard{
+		Student:     reportStudentInfo(student),
+		Term:        term,
+		FromDate:    fromDate,
+		ToDate:      toDate,
+		GeneratedAt: time.Now().Format(time.RFC3339),
+	}
+
+	courses, err := studentCourses(ctx, db, student.Id, term)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := reportComments(ctx, db, student.Id, term)
+	if err != nil {
+		return nil, err
+	}
+	card.Comment = comments[""]
+
+	for _, course := range courses {
+		result, err := courseResult(ctx, db, lookup, course, student.Id)
+		if err != nil {
+			return nil, err
+		}
+		result.Comment = comments[course.Id]
+		card.Courses = append(card.Courses, result)
+	}
+	card.GPA, _ = gradePointAverage(card.Courses)
+
+	summaries, err := attendanceSummaries(ctx, db, AttendanceDateFilter(bson.M{"student_id": student.Id}, fromDate, toDate))
+	if err != nil {
+		return nil, err
+	}
+	summary, ok := summaries[student.Id]
+	if !ok {
+		summary = &pb.AttendanceSummary{}
+		setAttendanceRate(summary)
+	}
+	card.Attendance = reports.AttendanceResult{
+		Present:        summary.Present,
+		Absent:         summary.Absent,
+		Late:           summary.Late,
+		Excused:        summary.Excused,
+		Total:          summary.Total,
+		AttendanceRate: summary.AttendanceRate,
+	}
+
+	return card, nil
+}
+
+// studentCourses returns the courses the student is enrolled in or completed, in one term or in every term when term is empty.
+// courses of earlier terms may already be soft deleted, they still belong on the student's record.
+func studentCourses(ctx context.Context, db *mongo.Database, studentID, term string) ([]*models.Course, error) {
+	courseIDs, err := db.Collection("enrollments").Distinct(ctx, "course_id", bson.M{
+		"student_id": studentID,
+		"status":     bson.M{"$in": bson.A{EnrollmentEnrolled, EnrollmentCompleted}},
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(courseIDs))
+	for _, id := range courseIDs {
+		if courseID, ok := id.(string); ok {
+			ids = append(ids, mustObjectID(courseID))
+		}
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	if term != "" {
+		filter["term"] = term
+	}
+
+	cursor, err := db.Collection("courses").Find(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var courses []*models.Course
+	err = cursor.All(ctx, &courses)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return courses, nil
+}
+
+// courseResult is the line of one course on a report card or transcript
+func courseResult(ctx context.Context, db *mongo.Database, lookup *reportLookup, course *models.Course, studentID string) (reports.CourseResult, error) {
+	result := reports.CourseResult{}
+
+	subject, ok := lookup.subjects[course.SubjectId]
+	if !ok {
+		subject = &models.Subject{}
+		err := db.Collection("subjects").FindOne(ctx, bson.M{"_id": mustObjectID(course.SubjectId)}).Decode(subject)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return result, utils.ErrorHandler(err, "Internal error")
+		}
+		lookup.subjects[course.SubjectId] = subject
+	}
+	result.SubjectCode = subject.Code
+	result.SubjectName = subject.Name
+	result.Credits = subject.Credits
+
+	teacher, ok := lookup.teachers[course.TeacherId]
+	if !ok {
+		teacher = &models.Teacher{}
+		err := db.Collection("teachers").FindOne(ctx, bson.M{"_id": mustObjectID(course.TeacherId)}).Decode(teacher)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return result, utils.ErrorHandler(err, "Internal error")
+		}
+		lookup.teachers[course.TeacherId] = teacher
+	}
+	if teacher.FirstName != "" || teacher.LastName != "" {
+		result.Teacher = teacher.FirstName + " " + teacher.LastName
+	}
+
+	grades, err := courseGrades(ctx, db, course, studentID)
+	if err != nil {
+		return result, err
+	}
+	if len(grades) == 1 && grades[0].LetterGrade != "" {
+		result.Graded = true
+		result.WeightedAverage = grades[0].WeightedAverage
+		result.LetterGrade = grades[0].LetterGrade
+	}
+
+	return result, nil
+}
+
+// gradePointAverage weights the grade points of the graded courses by their credits, courses without credits count as one.
+// the returned credits are those of the graded courses.
+func gradePointAverage(results []reports.CourseResult) (float64, int32) {
+	var points, weights float64
+	var credits int32
+	for _, result := range results {
+		if !result.Graded {
+			continue
+		}
+		weight := float64(result.Credits)
+		if weight <= 0 {
+			weight = 1
+		}
+		points += gradePoints(result.WeightedAverage) * weight
+		weights += weight
+		credits += result.Credits
+	}
+	if weights == 0 {
+		return 0, 0
+	}
+	return math.Round(points/weights*100) / 100, credits
+}
+
+// reportComments returns the comments of a student for a term keyed by course id, "" is the class teacher comment
+func reportComments(ctx context.Context, db *mongo.Database, studentID, term string) (map[string]string, error) {
+	cursor, err := db.Collection("report_comments").Find(ctx, bson.M{"student_id": studentID, "term": term})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var comments []models.ReportCardComment
+	err = cursor.All(ctx, &comments)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	byCourse := make(map[string]string, len(comments))
+	for _, comment := range comments {
+		byCourse[comment.CourseId] = comment.Comment
+	}
+	return byCourse, nil
+}
+
+// findReportStudent loads an active student by id
+func findReportStudent(ctx context.Context, db *mongo.Database, id string) (*models.Student, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid student id: %v", id))
+	}
+
+	var student models.Student
+	err = db.Collection("students").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&student)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: student %s does not exist", ErrReports, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &student, nil
+}
+
+func reportStudentInfo(student *models.Student) reports.StudentInfo {
+	return reports.StudentInfo{
+		Id:        student.Id,
+		FirstName: student.FirstName,
+		LastName:  student.LastName,
+		Class:     student.Class,
+	}
+}
@@ -0,0 +1,402 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	RolloverPromoted  = "promoted"
+	RolloverHeldBack  = "held_back"
+	RolloverGraduated = "graduated"
+)
+
+// the final grade level graduates when the request does not name one
+const defaultFinalGradeLevel = 12
+
+type gradeSection struct {
+	grade   int32
+	section string
+}
+
+/*
+RolloverYearDBHandler ends an academic year. Every class of the old year is replaced by a class of the next grade level in
+the new year, the students and the homeroom teacher move along with their class. The old classes are soft deleted, the
+class the students and teachers had stays in class_memberships of the closed term.
+
+The plan is built from reads only, with dryRun it is returned as it is. Otherwise every write runs in one transaction so
+a failed rollover leaves the old year untouched.
+*/
+func RolloverYearDBHandler(ctx context.Context, req *pb.RolloverRequest, rolledBy string) (*pb.RolloverPlan, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	// the term that is closed, every open term before it is closed too
+	closing, err := rolloverTerm(ctx, db, req.GetCloseTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	fromYear := req.GetFromAcademicYear()
+	if fromYear == "" {
+		year, err := findAcademicYear(ctx, db, closing.AcademicYearId)
+		if err != nil {
+			return nil, err
+		}
+		fromYear = year.Name
+	}
+	if req.GetToAcademicYear() == "" || req.GetToAcademicYear() == fromYear {
+		return nil, fmt.Errorf("%w: to_academic_year is required and has to differ from %s", ErrClassIntegrity, fromYear)
+	}
+
+	target, err := gradeTargets(req.GetRules(), req.GetFinalGradeLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	// classes without a year are treated as classes of the year that ends
+	cursor, err := db.Collection("classes").Find(ctx,
+		bson.M{"academic_year": bson.M{"$in": bson.A{fromYear, nil}}, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "grade_level", Value: 1}, {Key: "section", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var oldClasses []models.Class
+	err = cursor.All(ctx, &oldClasses)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if len(oldClasses) == 0 {
+		return nil, fmt.Errorf("%w: there are no classes in academic year %s", ErrClassIntegrity, fromYear)
+	}
+
+	plan := &pb.RolloverPlan{DryRun: req.GetDryRun()}
+
+	// planning the new classes, one per grade level and section
+	oldClassByID := make(map[string]*models.Class, len(oldClasses))
+	oldClassIDs := make([]string, 0, len(oldClasses))
+	newClasses := map[gradeSection]*models.Class{}
+	newClassOf := map[string]*models.Class{}
+	for i := range oldClasses {
+		old := &oldClasses[i]
+		oldClassByID[old.Id] = old
+		oldClassIDs = append(oldClassIDs, old.Id)
+
+		rolled := &pb.RolloverClass{FromClassId: old.Id, FromClass: old.Name}
+		plan.Classes = append(plan.Classes, rolled)
+
+		grade, graduate := target(old.GradeLevel)
+		if graduate {
+			continue
+		}
+
+		key := gradeSection{grade: grade, section: old.Section}
+		if other, ok := newClasses[key]; ok {
+			return nil, fmt.Errorf("%w: classes %s and %s would both become class %d%s", ErrClassIntegrity, other.Name, old.Name, grade, old.Section)
+		}
+		class := &models.Class{
+			Name:              fmt.Sprintf("%d%s", grade, old.Section),
+			GradeLevel:        grade,
+			Section:           old.Section,
+			HomeroomTeacherId: old.HomeroomTeacherId,
+			Room:              old.Room,
+			Capacity:          old.Capacity,
+			AcademicYear:      req.GetToAcademicYear(),
+		}
+		newClasses[key] = class
+		newClassOf[old.Id] = class
+	}
+
+	err = checkRolloverClassNames(ctx, db, newClasses, oldClassIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// planning the students
+	cursor, err = db.Collection("students").Find(ctx,
+		bson.M{"class_id": bson.M{"$in": oldClassIDs}, "graduated_at": nil, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "class", Value: 1}, {Key: "last_name", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var students []models.Student
+	err = cursor.All(ctx, &students)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	inOldClass := make(map[string]bool, len(students))
+	for _, student := range students {
+		inOldClass[student.Id] = true
+	}
+	holdBack := make(map[string]bool, len(req.GetHoldBackStudentIds()))
+	for _, id := range req.GetHoldBackStudentIds() {
+		if !inOldClass[id] {
+			return nil, fmt.Errorf("%w: held back student %s is not in a class of %s", ErrClassIntegrity, id, fromYear)
+		}
+		holdBack[id] = true
+	}
+
+	moves := map[*models.Class][]primitive.ObjectID{}
+	var graduates []primitive.ObjectID
+	for _, student := range students {
+		old := oldClassByID[student.ClassId]
+		rolled := &pb.RolloverStudent{StudentId: student.Id, FromClass: old.Name}
+
+		var to *models.Class
+		switch {
+		case holdBack[student.Id]:
+			to = newClasses[gradeSection{grade: old.GradeLevel, section: old.Section}]
+			if to == nil {
+				return nil, fmt.Errorf("%w: there is no class %d%s in %s for held back student %s", ErrClassIntegrity, old.GradeLevel, old.Section, req.GetToAcademicYear(), student.Id)
+			}
+			rolled.Action = RolloverHeldBack
+		case newClassOf[old.Id] == nil:
+			graduates = append(graduates, mustObjectID(student.Id))
+			rolled.Action = RolloverGraduated
+		default:
+			to = newClassOf[old.Id]
+			rolled.Action = RolloverPromoted
+		}
+
+		if to != nil {
+			rolled.ToClass = to.Name
+			moves[to] = append(moves[to], mustObjectID(student.Id))
+		}
+		plan.Students = append(plan.Students, rolled)
+	}
+
+	// closing the terms and the enrollments of their courses
+	terms, err := db.Collection("terms").Distinct(ctx, "name",
+		bson.M{"start_date": bson.M{"$lte": closing.StartDate}, "closed_at": nil, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	for _, term := range terms {
+		if name, ok := term.(string); ok {
+			plan.ClosedTerms = append(plan.ClosedTerms, name)
+		}
+	}
+
+	courseIDs, err := db.Collection("courses").Distinct(ctx, "_id", bson.M{"term": bson.M{"$in": plan.ClosedTerms}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	courses := make(bson.A, 0, len(courseIDs))
+	for _, id := range courseIDs {
+		if objectID, ok := id.(primitive.ObjectID); ok {
+			courses = append(courses, objectID.Hex())
+		}
+	}
+	enrolledFilter := bson.M{"course_id": bson.M{"$in": courses}, "status": EnrollmentEnrolled}
+	waitlistedFilter := bson.M{"course_id": bson.M{"$in": courses}, "status": EnrollmentWaitlisted}
+
+	if req.GetDryRun() {
+		for _, rolled := range plan.Classes {
+			if class := newClassOf[rolled.FromClassId]; class != nil {
+				rolled.Class = MapModelToPbClass(class)
+			}
+		}
+		completed, err := db.Collection("enrollments").CountDocuments(ctx, enrolledFilter)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		dropped, err := db.Collection("enrollments").CountDocuments(ctx, waitlistedFilter)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		plan.CompletedEnrollments = int32(completed)
+		plan.DroppedEnrollments = int32(dropped)
+		return plan, nil
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	oldClassFilter := bson.M{"class_id": bson.M{"$in": oldClassIDs}, "deleted_at": nil}
+
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		// the classes of the year that ends are kept for the closing term before anyone moves
+		for _, memberType := range []string{MemberStudent, MemberTeacher} {
+			err := recordTermMemberships(sc, db, closing.Name, memberType, oldClassFilter)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := db.Collection("terms").UpdateMany(sc,
+			bson.M{"name": bson.M{"$in": plan.ClosedTerms}, "closed_at": nil, "deleted_at": nil},
+			bson.M{"$set": bson.M{"closed_at": now}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to close terms")
+		}
+
+		res, err := db.Collection("enrollments").UpdateMany(sc, enrolledFilter, bson.M{"$set": bson.M{"status": EnrollmentCompleted}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to complete enrollments")
+		}
+		plan.CompletedEnrollments = int32(res.ModifiedCount)
+
+		res, err = db.Collection("enrollments").UpdateMany(sc, waitlistedFilter, bson.M{
+			"$set":   bson.M{"status": EnrollmentDropped, "dropped_at": now},
+			"$unset": bson.M{"waitlist_position": ""},
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to drop waitlisted enrollments")
+		}
+		plan.DroppedEnrollments = int32(res.ModifiedCount)
+
+		// retiring the old classes and releasing their homeroom teachers
+		_, err = db.Collection("classes").UpdateMany(sc,
+			bson.M{"_id": bson.M{"$in": mustObjectIDs(oldClassIDs)}, "deleted_at": nil},
+			bson.M{"$set": bson.M{"deleted_at": time.Now().UTC().Format(time.RFC3339), "deleted_by": rolledBy}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to retire old classes")
+		}
+		_, err = db.Collection("teachers").UpdateMany(sc, bson.M{"class_id": bson.M{"$in": oldClassIDs}}, bson.M{"$unset": bson.M{"class": "", "class_id": ""}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to release homeroom teachers")
+		}
+
+		// the new classes, the homeroom teacher follows the class
+		for _, rolled := range plan.Classes {
+			class := newClassOf[rolled.FromClassId]
+			if class == nil {
+				continue
+			}
+
+			teacherID := class.HomeroomTeacherId
+			class.HomeroomTeacherId = ""
+			result, err := db.Collection("classes").InsertOne(sc, class)
+			if err != nil {
+				return utils.ErrorHandler(err, "Failed to add class "+class.Name)
+			}
+			if objectID, ok := result.InsertedID.(primitive.ObjectID); ok {
+				class.Id = objectID.Hex()
+			}
+
+			if teacherID != "" {
+				err = setHomeroomTeacher(sc, db, class, teacherID)
+				if err != nil {
+					return err
+				}
+			}
+			rolled.Class = MapModelToPbClass(class)
+		}
+
+		// moving the students
+		for class, ids := range moves {
+			_, err = db.Collection("students").UpdateMany(sc,
+				bson.M{"_id": bson.M{"$in": ids}},
+				bson.M{"$set": bson.M{"class": class.Name, "class_id": class.Id}})
+			if err != nil {
+				return utils.ErrorHandler(err, "Failed to move students to class "+class.Name)
+			}
+			err = recordClassMemberships(sc, db, MemberStudent, bson.M{"_id": bson.M{"$in": ids}})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(graduates) > 0 {
+			_, err = db.Collection("students").UpdateMany(sc,
+				bson.M{"_id": bson.M{"$in": graduates}},
+				bson.M{"$set": bson.M{"graduated_at": now, "enrollment_status": StudentGraduated}, "$unset": bson.M{"class": "", "class_id": ""}})
+			if err != nil {
+				return utils.ErrorHandler(err, "Failed to graduate students")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// rolloverTerm loads the term to close, the current term when no name is given
+func rolloverTerm(ctx context.Context, db *mongo.Database, name string) (*models.Term, error) {
+	if name != "" {
+		term, err := findTerm(ctx, db, name)
+		if err != nil {
+			return nil, err
+		}
+		if term.ClosedAt != "" {
+			return nil, fmt.Errorf("%w: term %s is already closed", ErrCalendar, name)
+		}
+		return term, nil
+	}
+
+	term, err := currentTerm(ctx, db, "")
+	if err != nil {
+		return nil, err
+	}
+	if term == nil {
+		return nil, fmt.Errorf("%w: there is no current term to close, pass close_term", ErrCalendar)
+	}
+	return term, nil
+}
+
+// gradeTargets turns the rules into a function that tells the next grade level of a class or that it graduates
+func gradeTargets(rules []*pb.GradeRule, finalGradeLevel int32) (func(int32) (int32, bool), error) {
+	if finalGradeLevel == 0 {
+		finalGradeLevel = defaultFinalGradeLevel
+	}
+
+	byGrade := make(map[int32]*pb.GradeRule, len(rules))
+	for _, rule := range rules {
+		if _, ok := byGrade[rule.FromGradeLevel]; ok {
+			return nil, fmt.Errorf("%w: grade level %d has more than one rule", ErrClassIntegrity, rule.FromGradeLevel)
+		}
+		byGrade[rule.FromGradeLevel] = rule
+	}
+
+	return func(grade int32) (int32, bool) {
+		if rule, ok := byGrade[grade]; ok {
+			return rule.ToGradeLevel, rule.Graduate
+		}
+		if grade >= finalGradeLevel {
+			return 0, true
+		}
+		return grade + 1, false
+	}, nil
+}
+
+// checkRolloverClassNames makes sure the new classes do not take the name of a class that is not rolled over
+func checkRolloverClassNames(ctx context.Context, db *mongo.Database, newClasses map[gradeSection]*models.Class, oldClassIDs []string) error {
+	names := make(bson.A, 0, len(newClasses))
+	for _, class := range newClasses {
+		names = append(names, class.Name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	var taken models.Class
+	err := db.Collection("classes").FindOne(ctx, bson.M{
+		"name":       bson.M{"$in": names},
+		"_id":        bson.M{"$nin": mustObjectIDs(oldClassIDs)},
+		"deleted_at": nil,
+	}).Decode(&taken)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return fmt.Errorf("%w: class %s already exists in academic year %s", ErrClassIntegrity, taken.Name, taken.AcademicYear)
+}
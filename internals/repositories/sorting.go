@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrSort is returned for sort fields a list can not be sorted by
+var ErrSort = errors.New("invalid sort field")
+
+/*
+The lists can only be sorted by one of the fields below, each of them has an index together with _id (see
+EnsureIndexesDBHandler), so a sort never scans the collection and the keyset pages after it find their place in the
+index. Sorts by more than one field are refused, there is no index behind their combinations. Clients name the fields
+by the json name of the proto field like firstName, the proto name and the bson name like first_name are accepted too,
+the bson name is what the lists took before. id sorts by _id, which is also added as the last sort key of every list
+so documents with the same values always come in the same order. _id takes the direction of the key before it, a
+descending sort then walks the index backwards instead of sorting in memory.
+*/
+
+// sortable fields of the lists by collection, json name of the proto field -> bson name
+var sortFields = map[string]map[string]string{
+	"students": {
+		"firstName":        "first_name",
+		"lastName":         "last_name",
+		"email":            "email",
+		"class":            "class",
+		"dateOfBirth":      "date_of_birth",
+		"admissionNumber":  "admission_number",
+		"enrollmentStatus": "enrollment_status",
+		"admissionDate":    "admission_date",
+	},
+	"teachers": {
+		"firstName": "first_name",
+		"lastName":  "last_name",
+		"email":     "email",
+		"class":     "class",
+		"subject":   "subject",
+	},
+	"execs": {
+		"firstName":     "first_name",
+		"lastName":      "last_name",
+		"email":         "email",
+		"username":      "username",
+		"role":          "role",
+		"userCreatedAt": "user_created_at",
+	},
+	"guardians": {
+		"firstName": "first_name",
+		"lastName":  "last_name",
+		"email":     "email",
+	},
+	"classes": {
+		"name":         "name",
+		"gradeLevel":   "grade_level",
+		"academicYear": "academic_year",
+	},
+	"subjects": {
+		"code":       "code",
+		"name":       "name",
+		"department": "department",
+	},
+	"courses": {
+		"subjectId": "subject_id",
+		"classId":   "class_id",
+		"teacherId": "teacher_id",
+		"term":      "term",
+	},
+	"academic_years": {
+		"name":      "name",
+		"startDate": "start_date",
+	},
+	"terms": {
+		"name":      "name",
+		"startDate": "start_date",
+	},
+	"sessions": {
+		"day":       "day",
+		"startTime": "start_time",
+		"room":      "room",
+	},
+	"announcements": {
+		"title":       "title",
+		"publishAt":   "publish_at",
+		"publishedAt": "published_at",
+		"createdAt":   "created_at",
+	},
+	"webhook_endpoints": {
+		"name":      "name",
+		"createdAt": "created_at",
+	},
+}
+
+// SortKey is the bson name of a field the list of the collection can be sorted by, the field is named by its json name,
+// its proto name or its bson name
+func SortKey(collection, field string) (string, error) {
+	if field == "id" || field == "_id" {
+		return "_id", nil
+	}
+
+	fields := sortFields[collection]
+	if key, ok := fields[field]; ok {
+		return key, nil
+	}
+	// the proto names of the sortable fields are their bson names or the same as their json names
+	for _, key := range fields {
+		if key == field {
+			return key, nil
+		}
+	}
+
+	names := slices.Sorted(maps.Keys(fields))
+	return "", fmt.Errorf("%w: %s can not be sorted by %q, use one of id, %s", ErrSort, collection, field, strings.Join(names, ", "))
+}
+
+// sortIndexes are the indexes behind the sortable fields, the field with _id for the order of equal values. Read
+// backwards they serve the descending sorts, whose _id is descending too
+func sortIndexes(collection string) []mongo.IndexModel {
+	var indexes []mongo.IndexModel
+	for _, key := range sortFields[collection] {
+		indexes = append(indexes, mongo.IndexModel{Keys: bson.D{{Key: key, Value: 1}, {Key: "_id", Value: 1}}})
+	}
+	return indexes
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+
+	pb "school_project_grpc/proto/gen"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// the messages of the lists, the keys of sortFields have to be the json names of their fields
+var sortMessages = map[string]proto.Message{
+	"students":          &pb.Student{},
+	"teachers":          &pb.Teacher{},
+	"execs":             &pb.Exec{},
+	"guardians":         &pb.Guardian{},
+	"classes":           &pb.Class{},
+	"subjects":          &pb.Subject{},
+	"courses":           &pb.Course{},
+	"academic_years":    &pb.AcademicYear{},
+	"terms":             &pb.Term{},
+	"sessions":          &pb.Session{},
+	"announcements":     &pb.Announcement{},
+	"webhook_endpoints": &pb.WebhookEndpoint{},
+}
+
+func TestSortFieldsAreProtoFields(t *testing.T) {
+	for collection, fields := range sortFields {
+		message, ok := sortMessages[collection]
+		if !ok {
+			t.Errorf("no message for the sortable fields of %s", collection)
+			continue
+		}
+		descriptor := message.ProtoReflect().Descriptor()
+
+		for jsonName, bsonName := range fields {
+			field := descriptor.Fields().ByJSONName(jsonName)
+			if field == nil {
+				t.Errorf("%s: %s is not the json name of a field of %s", collection, jsonName, descriptor.Name())
+				continue
+			}
+
+			// every name of the field leads to the bson name
+			for _, name := range []string{jsonName, string(field.Name()), bsonName} {
+				key, err := SortKey(collection, name)
+				if err != nil || key != bsonName {
+					t.Errorf("%s: SortKey(%q) = %q, %v, want %q", collection, name, key, err, bsonName)
+				}
+			}
+		}
+	}
+}
+
+func TestSortKey(t *testing.T) {
+	tests := []struct {
+		collection string
+		field      string
+		want       string
+	}{
+		{"students", "firstName", "first_name"},
+		{"students", "first_name", "first_name"},
+		{"students", "id", "_id"},
+		{"students", "_id", "_id"},
+		{"execs", "userCreatedAt", "user_created_at"},
+		{"execs", "user_created_at", "user_created_at"},
+	}
+	for _, tt := range tests {
+		key, err := SortKey(tt.collection, tt.field)
+		if err != nil || key != tt.want {
+			t.Errorf("SortKey(%s, %s) = %q, %v, want %q", tt.collection, tt.field, key, err, tt.want)
+		}
+	}
+
+	for _, field := range []string{"password", "medical_notes", "medicalNotes", "", "first_name.x", "$natural"} {
+		if _, err := SortKey("students", field); !errors.Is(err, ErrSort) {
+			t.Errorf("SortKey(students, %q) = %v, want an ErrSort", field, err)
+		}
+	}
+	if _, err := SortKey("execs", "password"); !errors.Is(err, ErrSort) {
+		t.Errorf("execs can be sorted by password: %v", err)
+	}
+}
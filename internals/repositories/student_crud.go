@@ -8,10 +8,11 @@ import (
 	"school_project_grpc/internals/repositories/mongodb"
 	"school_project_grpc/pkg/utils"
 	pb "school_project_grpc/proto/gen"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func AddStudentsDBHandler(ctx context.Context, studentsFromReq []*pb.Student) ([]*pb.Student, error) {
@@ -35,14 +36,49 @@ func AddStudentsDBHandler(ctx context.Context, studentsFromReq []*pb.Student) ([
 			continue
 		}
 
-		result, err := client.Database("school").Collection("students").InsertOne(ctx, student)
+		// students only graduate through the year-end rollover
+		student.GraduatedAt = ""
+
+		err = prepareNewStudent(ctx, client.Database("school"), student)
+		if err != nil {
+			return nil, err
+		}
+
+		// the class is referenced by id, the name is copied from the class document. Like on update, students can only
+		// be added to a class that has a homeroom teacher
+		class, err := resolveClassRef(ctx, client.Database("school"), &student.ClassId, &student.Class)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Error adding value into database")
+			return nil, err
+		}
+		err = checkStudentClass(class)
+		if err != nil {
+			return nil, err
 		}
 
-		objectID, ok := result.InsertedID.(primitive.ObjectID)
-		if ok {
-			student.Id = objectID.Hex()
+		// the student.created event is written with the student
+		student.Id = ""
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			result, err := client.Database("school").Collection("students").InsertOne(sc, student)
+			if err != nil {
+				if mongo.IsDuplicateKeyError(err) {
+					return fmt.Errorf("%w: admission number %s is already used", ErrStudent, student.AdmissionNumber)
+				}
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				student.Id = objectID.Hex()
+			}
+
+			err = recordMembershipsByID(sc, client.Database("school"), MemberStudent, student.Id)
+			if err != nil {
+				return err
+			}
+			return recordEntityEvents(sc, client.Database("school"), "students", ActionCreated, []string{student.Id})
+		})
+		if err != nil {
+			return nil, err
 		}
 
 		pbStudent := MapModelToPbStudent(student)
@@ -52,39 +88,17 @@ func AddStudentsDBHandler(ctx context.Context, studentsFromReq []*pb.Student) ([
 	return addedStudent, nil
 }
 
-func GetStudentsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Student, error) {
+func GetStudentsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, page PageRequest) ([]*pb.Student, PageInfo, error) {
 	client, err := mongodb.CreatMongoClient()
 	if err != nil {
-		return nil, err
+		return nil, PageInfo{}, err
 	}
 	defer client.Disconnect(ctx)
 
 	// getting collection of the execs
 	coll := client.Database("school").Collection("students")
 
-	findOptions := options.Find()
-
-	findOptions.SetSkip(int64((pageNumber - 1) * pageSize))
-	findOptions.SetLimit(int64(pageSize))
-
-	if len(sortOption) > 0 {
-		findOptions.SetSort(sortOption)
-	}
-	cursor, err := coll.Find(ctx, filter, findOptions)
-
-	// cheking the error from above coll.find
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
-	}
-	defer cursor.Close(ctx)
-
-	// decode mongo documents to pb.students
-	students, err := DecodedEntities(ctx, cursor, func() *models.Student { return &models.Student{} }, func() *pb.Student { return &pb.Student{} })
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
-	}
-
-	return students, nil
+	return findKeysetPage(ctx, coll, filter, sortOption, page, func() *models.Student { return &models.Student{} }, func() *pb.Student { return &pb.Student{} })
 }
 
 // Update students in MongoDB
@@ -113,6 +127,26 @@ func UpdateStudentsDBHandler(ctx context.Context, pbStudents []*pb.Student) ([]*
 			return nil, utils.ErrorHandler(err, "invalid id")
 		}
 
+		// the enrollment status decides whether the student can be in a class
+		current, err := findStudent(ctx, client.Database("school"), modelStudent.Id)
+		if err != nil {
+			return nil, err
+		}
+		unset, err := studentStatusChange(current, modelStudent)
+		if err != nil {
+			return nil, err
+		}
+
+		// students can only be moved into a class that has a homeroom teacher
+		class, err := resolveClassRef(ctx, client.Database("school"), &modelStudent.ClassId, &modelStudent.Class)
+		if err != nil {
+			return nil, err
+		}
+		err = checkStudentClass(class)
+		if err != nil {
+			return nil, err
+		}
+
 		// Convert model -> bson
 		mstudent, err := bson.Marshal(modelStudent)
 		if err != nil {
@@ -125,14 +159,36 @@ func UpdateStudentsDBHandler(ctx context.Context, pbStudents []*pb.Student) ([]*
 			return nil, utils.ErrorHandler(err, "Internal error")
 		}
 
-		// Remove _id from update
+		// Remove _id, the soft delete fields and the graduation (set by the rollover) from update
 		delete(updateDoc, "_id")
+		delete(updateDoc, "deleted_at")
+		delete(updateDoc, "deleted_by")
+		delete(updateDoc, "graduated_at")
 
-		// Update in MongoDB
-		_, err = client.Database("school").Collection("students").
-			UpdateOne(ctx, bson.M{"_id": obj}, bson.M{"$set": updateDoc})
+		update := bson.M{"$set": updateDoc}
+		if len(unset) > 0 {
+			update["$unset"] = unset
+		}
+
+		// Update in MongoDB, together with the student.updated event
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			_, err := client.Database("school").Collection("students").
+				UpdateOne(sc, bson.M{"_id": obj, "deleted_at": nil}, update)
+			if err != nil {
+				if mongo.IsDuplicateKeyError(err) {
+					return fmt.Errorf("%w: admission number %s is already used", ErrStudent, modelStudent.AdmissionNumber)
+				}
+				return utils.ErrorHandler(err, fmt.Sprintf("error updating student id: %s", student.Id))
+			}
+
+			err = recordMembershipsByID(sc, client.Database("school"), MemberStudent, modelStudent.Id)
+			if err != nil {
+				return err
+			}
+			return recordEntityEvents(sc, client.Database("school"), "students", ActionUpdated, []string{modelStudent.Id})
+		})
 		if err != nil {
-			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating student id: %s", student.Id))
+			return nil, err
 		}
 
 		// Convert model -> pb for response
@@ -144,7 +200,7 @@ func UpdateStudentsDBHandler(ctx context.Context, pbStudents []*pb.Student) ([]*
 }
 
 // delete Student in mongoDB by user id
-func DeleteStudentsDBHandler(ctx context.Context, idstodelete []string) ([]string, error) {
+func DeleteStudentsDBHandler(ctx context.Context, idstodelete []string, deletedBy string) ([]string, error) {
 
 	client, err := mongodb.CreatMongoClient()
 	if err != nil {
@@ -162,22 +218,86 @@ func DeleteStudentsDBHandler(ctx context.Context, idstodelete []string) ([]strin
 		objectIds = append(objectIds, objectId)
 	}
 
-	// Delete many by IDs
-	filter := bson.M{"_id": bson.M{"$in": objectIds}}
+	// Soft delete many by IDs, the documents stay in the collection until the purge job removes them
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	// only the ids that were not deleted yet are deleted and returned
+	var deletedIds []string
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		coll := client.Database("school").Collection("students")
+		ids, err := matchingIDs(sc, coll, filter)
+		if err != nil {
+			return err
+		}
+
+		res, err := coll.UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no documents matched the ids"), "No Students were deleted")
+		}
+		deletedIds = ids
+		return recordEntityEvents(sc, client.Database("school"), "students", ActionDeleted, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedIds, nil
+}
+
+// restore soft deleted Student in mongoDB by user id
+func RestoreStudentsDBHandler(ctx context.Context, idsToRestore []string) ([]string, error) {
 
-	res, err := client.Database("school").Collection("students").DeleteMany(ctx, filter)
+	client, err := mongodb.CreatMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
+	defer client.Disconnect(ctx)
 
-	if res.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(err, "No Students were deleted")
+	// Convert to Mongo ObjectIDs
+	objectIds := make([]primitive.ObjectID, 0, len(idsToRestore))
+	for _, id := range idsToRestore {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+		}
+		objectIds = append(objectIds, objectId)
 	}
 
-	// Return deleted IDs
-	deletedIds := make([]string, 0, len(objectIds))
-	for _, v := range objectIds {
-		deletedIds = append(deletedIds, v.Hex())
+	// only documents that are still soft deleted can be restored
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{"$unset": bson.M{"deleted_at": "", "deleted_by": ""}}
+
+	// only the ids that were soft deleted are restored and returned
+	var restoredIds []string
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		coll := client.Database("school").Collection("students")
+		ids, err := matchingIDs(sc, coll, filter)
+		if err != nil {
+			return err
+		}
+
+		res, err := coll.UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no soft deleted documents matched the ids"), "No Students were restored")
+		}
+		restoredIds = ids
+		return recordEntityEvents(sc, client.Database("school"), "students", ActionRestored, ids)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return deletedIds, nil
+
+	return restoredIds, nil
 }
@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrStudent is returned when a student profile is invalid or its enrollment status does not allow the change
+var ErrStudent = errors.New("student profile invalid")
+
+const (
+	StudentActive      = "active"
+	StudentTransferred = "transferred"
+	StudentGraduated   = "graduated"
+	StudentWithdrawn   = "withdrawn"
+)
+
+var studentGenders = map[string]bool{"female": true, "male": true, "other": true}
+
+var studentStatuses = map[string]bool{StudentActive: true, StudentTransferred: true, StudentGraduated: true, StudentWithdrawn: true}
+
+// admissionPattern is the pattern of generated admission numbers, it is replaced at startup by SetAdmissionNumberPattern.
+// {YYYY} and {YY} are the year of the admission date, {SEQ:n} is a sequence padded to n digits that starts again
+// for every text around it, so a pattern with a year counts per year
+var admissionPattern = "S{YYYY}{SEQ:4}"
+
+var admissionSequence = regexp.MustCompile(`\{SEQ(?::([1-9]))?\}`)
+
+// SetAdmissionNumberPattern replaces the admission number pattern, an empty pattern keeps the default.
+// the pattern needs exactly one {SEQ} or {SEQ:n}
+func SetAdmissionNumberPattern(pattern string) error {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return nil
+	}
+
+	if len(admissionSequence.FindAllString(pattern, -1)) != 1 {
+		return fmt.Errorf("admission number pattern %q needs exactly one {SEQ} or {SEQ:n}", pattern)
+	}
+	rest := strings.NewReplacer("{YYYY}", "", "{YY}", "").Replace(admissionSequence.ReplaceAllString(pattern, ""))
+	if strings.ContainsAny(rest, "{}") {
+		return fmt.Errorf("admission number pattern %q has an unknown placeholder, use {YYYY}, {YY} and {SEQ:n}", pattern)
+	}
+
+	admissionPattern = pattern
+	return nil
+}
+
+// nextAdmissionNumber generates the next free admission number for a student admitted on admissionDate (YYYY-MM-DD).
+// the sequences are kept in the counters collection
+func nextAdmissionNumber(ctx context.Context, db *mongo.Database, admissionDate string) (string, error) {
+	year := admissionDate[:4]
+	prefix := strings.NewReplacer("{YYYY}", year, "{YY}", year[2:]).Replace(admissionPattern)
+
+	width := 1
+	if match := admissionSequence.FindStringSubmatch(prefix); match[1] != "" {
+		width, _ = strconv.Atoi(match[1])
+	}
+
+	// numbers given by hand can already use a value of the sequence, those are skipped
+	for {
+		var counter struct {
+			Seq int64 `bson:"seq"`
+		}
+		err := db.Collection("counters").FindOneAndUpdate(ctx,
+			bson.M{"_id": "admission_number:" + prefix},
+			bson.M{"$inc": bson.M{"seq": 1}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).Decode(&counter)
+		if err != nil {
+			return "", utils.ErrorHandler(err, "Failed to generate admission number")
+		}
+
+		number := admissionSequence.ReplaceAllLiteralString(prefix, fmt.Sprintf("%0*d", width, counter.Seq))
+		count, err := db.Collection("students").CountDocuments(ctx, bson.M{"admission_number": number})
+		if err != nil {
+			return "", utils.ErrorHandler(err, "Internal error")
+		}
+		if count == 0 {
+			return number, nil
+		}
+	}
+}
+
+// prepareNewStudent checks the profile of a new student and fills in the status, admission date and admission number
+func prepareNewStudent(ctx context.Context, db *mongo.Database, student *models.Student) error {
+	err := checkNewStudent(student)
+	if err != nil {
+		return err
+	}
+
+	if student.AdmissionNumber == "" {
+		student.AdmissionNumber, err = nextAdmissionNumber(ctx, db, student.AdmissionDate)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNewStudent checks the profile of a new student and fills in the status and admission date, it does not draw
+// an admission number so the dry run of an import can use it
+func checkNewStudent(student *models.Student) error {
+	err := checkStudentProfile(student)
+	if err != nil {
+		return err
+	}
+
+	if student.EnrollmentStatus == "" {
+		student.EnrollmentStatus = StudentActive
+	}
+	if student.EnrollmentStatus == StudentGraduated {
+		return fmt.Errorf("%w: students only graduate through the year-end rollover", ErrStudent)
+	}
+	if student.EnrollmentStatus != StudentActive && (student.ClassId != "" || student.Class != "") {
+		return fmt.Errorf("%w: only active students can be in a class", ErrStudent)
+	}
+
+	if student.AdmissionDate == "" {
+		student.AdmissionDate = time.Now().Format(time.DateOnly)
+	}
+	return nil
+}
+
+// studentStatusChange checks an update of the student against the stored student and returns the fields to unset.
+// students that leave the school leave their class, a graduated student that becomes active again is no longer graduated
+func studentStatusChange(current, update *models.Student) (bson.M, error) {
+	err := checkStudentProfile(update)
+	if err != nil {
+		return nil, err
+	}
+
+	status := update.EnrollmentStatus
+	if status == "" {
+		status = current.EnrollmentStatus
+	}
+	if status == "" {
+		status = StudentActive
+	}
+
+	if update.EnrollmentStatus == StudentGraduated && current.GraduatedAt == "" {
+		return nil, fmt.Errorf("%w: students only graduate through the year-end rollover", ErrStudent)
+	}
+	if status != StudentActive && (update.ClassId != "" || update.Class != "") {
+		return nil, fmt.Errorf("%w: student %s is %s and can not be put into a class", ErrStudent, current.Id, status)
+	}
+
+	unset := bson.M{}
+	if status != StudentActive {
+		unset["class"] = ""
+		unset["class_id"] = ""
+	}
+	if status == StudentActive && current.GraduatedAt != "" {
+		unset["graduated_at"] = ""
+	}
+	return unset, nil
+}
+
+// checkStudentProfile checks the formats the repositories rely on, the proto validation rules are not enforced
+func checkStudentProfile(student *models.Student) error {
+	if student.Gender != "" && !studentGenders[student.Gender] {
+		return fmt.Errorf("%w: gender %q is not one of female, male, other", ErrStudent, student.Gender)
+	}
+	if student.EnrollmentStatus != "" && !studentStatuses[student.EnrollmentStatus] {
+		return fmt.Errorf("%w: enrollment status %q is not one of active, transferred, graduated, withdrawn", ErrStudent, student.EnrollmentStatus)
+	}
+	if student.DateOfBirth != "" {
+		born, err := time.Parse(time.DateOnly, student.DateOfBirth)
+		if err != nil {
+			return fmt.Errorf("%w: date_of_birth %q is not a YYYY-MM-DD date", ErrStudent, student.DateOfBirth)
+		}
+		if born.After(time.Now()) {
+			return fmt.Errorf("%w: date_of_birth %s is in the future", ErrStudent, student.DateOfBirth)
+		}
+	}
+	if student.AdmissionDate != "" {
+		_, err := time.Parse(time.DateOnly, student.AdmissionDate)
+		if err != nil {
+			return fmt.Errorf("%w: admission_date %q is not a YYYY-MM-DD date", ErrStudent, student.AdmissionDate)
+		}
+	}
+	return nil
+}
+
+// findStudent loads a student that is not deleted by id
+func findStudent(ctx context.Context, db *mongo.Database, id string) (*models.Student, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid student id: %v", id))
+	}
+
+	var student models.Student
+	err = db.Collection("students").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&student)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: student %s does not exist", ErrStudent, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &student, nil
+}
+
+// MigrateStudentProfilesDBHandler fills in the enrollment status, admission date and admission number of students
+// stored before the profile fields existed. Graduated students get the graduated status, the admission date is the
+// day the document was created and the numbers follow the order the students were added. It can be run more than once.
+func MigrateStudentProfilesDBHandler(ctx context.Context) (int, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	cursor, err := db.Collection("students").Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"enrollment_status": nil},
+		bson.M{"admission_date": nil},
+		bson.M{"admission_number": nil},
+	}}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Failed to read students")
+	}
+	var students []models.Student
+	err = cursor.All(ctx, &students)
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Failed to read students")
+	}
+
+	migrated := 0
+	for _, student := range students {
+		set := bson.M{}
+		if student.EnrollmentStatus == "" {
+			set["enrollment_status"] = StudentActive
+			if student.GraduatedAt != "" {
+				set["enrollment_status"] = StudentGraduated
+			}
+		}
+		admissionDate := student.AdmissionDate
+		if admissionDate == "" {
+			admissionDate = mustObjectID(student.Id).Timestamp().Format(time.DateOnly)
+			set["admission_date"] = admissionDate
+		}
+		if student.AdmissionNumber == "" {
+			set["admission_number"], err = nextAdmissionNumber(ctx, db, admissionDate)
+			if err != nil {
+				return migrated, err
+			}
+		}
+
+		_, err = db.Collection("students").UpdateOne(ctx, bson.M{"_id": mustObjectID(student.Id)}, bson.M{"$set": set})
+		if err != nil {
+			return migrated, utils.ErrorHandler(err, "Failed to migrate student "+student.Id)
+		}
+		migrated++
+	}
+	return migrated, nil
+}
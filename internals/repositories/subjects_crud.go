@@ -0,0 +1,250 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrCourseIntegrity is returned when a subject or course write would point to something that does not exist or is still in use
+var ErrCourseIntegrity = errors.New("course integrity violation")
+
+// Add subjects to MongoDB
+func AddSubjectsDBHandler(ctx context.Context, subjectsFromReq []*pb.Subject) ([]*pb.Subject, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		utils.ErrorHandler(err, "internal error")
+		return nil, err
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedSubjects []*pb.Subject
+
+	for _, pbSubject := range subjectsFromReq {
+		subject := MapPBToModelSubject(pbSubject)
+
+		if subject.Code == "" || subject.Name == "" {
+			return nil, fmt.Errorf("%w: subject code and name are required", ErrCourseIntegrity)
+		}
+
+		err = checkSubjectCodeFree(ctx, db, subject.Code, "")
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkPrerequisites(ctx, db, subject.PrerequisiteSubjectIds, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// the subject.created event is written with the subject
+		subject.Id = ""
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			result, err := db.Collection("subjects").InsertOne(sc, subject)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				subject.Id = objectID.Hex()
+			}
+			return recordEntityEvents(sc, db, "subjects", ActionCreated, []string{subject.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		addedSubjects = append(addedSubjects, MapModelToPbSubject(subject))
+	}
+
+	return addedSubjects, nil
+}
+
+// Get subjects from MongoDB with optional sorting
+func GetSubjectsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Subject, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findPage(ctx, client.Database("school").Collection("subjects"), filter, sortOption, pageSize, pageNumber,
+		func() *models.Subject { return &models.Subject{} }, func() *pb.Subject { return &pb.Subject{} })
+}
+
+// Update subjects in MongoDB
+func UpdateSubjectsDBHandler(ctx context.Context, pbSubjects []*pb.Subject) ([]*pb.Subject, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedSubjects []*pb.Subject
+
+	for _, pbSubject := range pbSubjects {
+
+		// Validate ID
+		if pbSubject.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		modelSubject := MapPBToModelSubject(pbSubject)
+
+		obj, err := primitive.ObjectIDFromHex(modelSubject.Id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "invalid id")
+		}
+
+		if modelSubject.Code != "" {
+			err = checkSubjectCodeFree(ctx, db, modelSubject.Code, modelSubject.Id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = checkPrerequisites(ctx, db, modelSubject.PrerequisiteSubjectIds, modelSubject.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		updateDoc, err := updateDocFromModel(modelSubject)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			_, err := db.Collection("subjects").UpdateOne(sc, bson.M{"_id": obj, "deleted_at": nil}, bson.M{"$set": updateDoc})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("error updating subject id: %s", pbSubject.Id))
+			}
+			return recordEntityEvents(sc, db, "subjects", ActionUpdated, []string{modelSubject.Id})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		updatedSubjects = append(updatedSubjects, MapModelToPbSubject(modelSubject))
+	}
+
+	return updatedSubjects, nil
+}
+
+// delete subjects in mongoDB by id (soft delete), subjects that are still offered as a course are refused
+func DeleteSubjectsDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := db.Collection("courses").CountDocuments(ctx, bson.M{"subject_id": bson.M{"$in": idsToDelete}, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("%w: subjects are still used by %d courses", ErrCourseIntegrity, count)
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		ids, err := matchingIDs(sc, db.Collection("subjects"), filter)
+		if err != nil {
+			return err
+		}
+
+		res, err := db.Collection("subjects").UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no subjects matched the ids"), "No subjects were deleted")
+		}
+		return recordEntityEvents(sc, db, "subjects", ActionDeleted, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hexIDs(objectIds), nil
+}
+
+// subject codes are unique among active subjects
+func checkSubjectCodeFree(ctx context.Context, db *mongo.Database, code, exceptID string) error {
+	filter := bson.M{"code": code, "deleted_at": nil}
+	if exceptID != "" {
+		objectID, err := primitive.ObjectIDFromHex(exceptID)
+		if err != nil {
+			return utils.ErrorHandler(err, "Invalid id")
+		}
+		filter["_id"] = bson.M{"$ne": objectID}
+	}
+
+	count, err := db.Collection("subjects").CountDocuments(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: subject code %s already exists", ErrCourseIntegrity, code)
+	}
+	return nil
+}
+
+// findSubject loads an active subject by id
+func findSubject(ctx context.Context, db *mongo.Database, id string) (*models.Subject, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid subject id: %v", id))
+	}
+
+	var subject models.Subject
+	err = db.Collection("subjects").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&subject)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: subject %s does not exist", ErrCourseIntegrity, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &subject, nil
+}
+
+// prerequisites must be existing subjects and a subject can not require itself
+func checkPrerequisites(ctx context.Context, db *mongo.Database, prerequisiteIDs []string, subjectID string) error {
+	for _, id := range prerequisiteIDs {
+		if id == subjectID {
+			return fmt.Errorf("%w: subject %s can not be its own prerequisite", ErrCourseIntegrity, id)
+		}
+		_, err := findSubject(ctx, db, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
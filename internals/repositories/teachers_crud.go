@@ -8,11 +8,11 @@ import (
 	"school_project_grpc/internals/repositories/mongodb"
 	"school_project_grpc/pkg/utils"
 	pb "school_project_grpc/proto/gen"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // Add teachers to MongoDB
@@ -37,16 +37,39 @@ func AddTeachersDBHandler(ctx context.Context, teacherFromReq []*pb.Teacher) ([]
 			continue
 		}
 
-		// Insert into MongoDB
-		result, err := client.Database("school").Collection("teachers").InsertOne(ctx, teacher)
+		// the class is referenced by id, a new teacher can only take a class without homeroom teacher
+		class, err := resolveClassRef(ctx, client.Database("school"), &teacher.ClassId, &teacher.Class)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Error adding value into database")
+			return nil, err
+		}
+		if class != nil && class.HomeroomTeacherId != "" {
+			return nil, fmt.Errorf("%w: class %s already has homeroom teacher %s", ErrClassIntegrity, class.Name, class.HomeroomTeacherId)
 		}
 
-		// Save generated Mongo ID
-		objectID, ok := result.InsertedID.(primitive.ObjectID)
-		if ok {
-			teacher.Id = objectID.Hex()
+		// Insert into MongoDB, together with the teacher.created event
+		teacher.Id = ""
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			result, err := client.Database("school").Collection("teachers").InsertOne(sc, teacher)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+
+			// Save generated Mongo ID
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				teacher.Id = objectID.Hex()
+			}
+
+			if class != nil {
+				err = setHomeroomTeacher(sc, client.Database("school"), class, teacher.Id)
+				if err != nil {
+					return err
+				}
+			}
+			return recordEntityEvents(sc, client.Database("school"), "teachers", ActionCreated, []string{teacher.Id})
+		})
+		if err != nil {
+			return nil, err
 		}
 
 		// Convert model -> pb for response
@@ -58,42 +81,16 @@ func AddTeachersDBHandler(ctx context.Context, teacherFromReq []*pb.Teacher) ([]
 }
 
 // Get teachers from MongoDB with optional sorting
-func GetTeachersDBhandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Teacher, error) {
+func GetTeachersDBhandler(ctx context.Context, sortOption bson.D, filter bson.M, page PageRequest) ([]*pb.Teacher, PageInfo, error) {
 	client, err := mongodb.CreatMongoClient()
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
+		return nil, PageInfo{}, utils.ErrorHandler(err, "Internal error")
 	}
 	defer client.Disconnect(ctx)
 
 	coll := client.Database("school").Collection("teachers")
 
-	findOptions := options.Find()
-
-	findOptions.SetSkip(int64((pageNumber - 1) * pageSize))
-	findOptions.SetLimit(int64(pageSize))
-
-	if len(sortOption) > 0 {
-		findOptions.SetSort(sortOption)
-	}
-	cursor, err := coll.Find(ctx, filter, findOptions)
-
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
-	}
-	defer cursor.Close(ctx)
-
-	// Decode Mongo documents -> pb teachers
-	teachers, err := DecodedEntities(
-		ctx,
-		cursor,
-		func() *models.Teacher { return &models.Teacher{} },
-		func() *pb.Teacher { return &pb.Teacher{} },
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return teachers, nil
+	return findKeysetPage(ctx, coll, filter, sortOption, page, func() *models.Teacher { return &models.Teacher{} }, func() *pb.Teacher { return &pb.Teacher{} })
 }
 
 // Update teachers in MongoDB
@@ -122,6 +119,16 @@ func UpdateTeachersDBHandler(ctx context.Context, pbTeachers []*pb.Teacher) ([]*
 			return nil, utils.ErrorHandler(err, "invalid id")
 		}
 
+		// a class change must not leave students without their homeroom teacher
+		class, err := resolveClassRef(ctx, client.Database("school"), &modelTeacher.ClassId, &modelTeacher.Class)
+		if err != nil {
+			return nil, err
+		}
+		err = checkTeacherClassChange(ctx, client.Database("school"), obj, class)
+		if err != nil {
+			return nil, err
+		}
+
 		// Convert model -> bson
 		mteacher, err := bson.Marshal(modelTeacher)
 		if err != nil {
@@ -134,14 +141,29 @@ func UpdateTeachersDBHandler(ctx context.Context, pbTeachers []*pb.Teacher) ([]*
 			return nil, utils.ErrorHandler(err, "Internal error")
 		}
 
-		// Remove _id from update
+		// Remove _id and the soft delete fields from update
 		delete(updateDoc, "_id")
-
-		// Update in MongoDB
-		_, err = client.Database("school").Collection("teachers").
-			UpdateOne(ctx, bson.M{"_id": obj}, bson.M{"$set": updateDoc})
+		delete(updateDoc, "deleted_at")
+		delete(updateDoc, "deleted_by")
+
+		// Update in MongoDB, together with the teacher.updated event
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			_, err := client.Database("school").Collection("teachers").
+				UpdateOne(sc, bson.M{"_id": obj, "deleted_at": nil}, bson.M{"$set": updateDoc})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("error updating teacher id: %s", teacher.Id))
+			}
+
+			if class != nil {
+				err = setHomeroomTeacher(sc, client.Database("school"), class, modelTeacher.Id)
+				if err != nil {
+					return err
+				}
+			}
+			return recordEntityEvents(sc, client.Database("school"), "teachers", ActionUpdated, []string{modelTeacher.Id})
+		})
 		if err != nil {
-			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating teacher id: %s", teacher.Id))
+			return nil, err
 		}
 
 		// Convert model -> pb for response
@@ -153,7 +175,8 @@ func UpdateTeachersDBHandler(ctx context.Context, pbTeachers []*pb.Teacher) ([]*
 }
 
 // delete teacher in mongoDB by user id
-func DeleteTeachersDBHandler(ctx context.Context, idsTodelete []string) ([]string, error) {
+// a teacher who owns a class is only deleted when the class goes to reassignTo or when force is set
+func DeleteTeachersDBHandler(ctx context.Context, idsTodelete []string, deletedBy, reassignTo string, force bool) ([]string, error) {
 
 	client, err := mongodb.CreatMongoClient()
 	if err != nil {
@@ -171,24 +194,182 @@ func DeleteTeachersDBHandler(ctx context.Context, idsTodelete []string) ([]strin
 		objectIds = append(objectIds, objectId)
 	}
 
-	// Delete many by IDs
-	filter := bson.M{"_id": bson.M{"$in": objectIds}}
+	db := client.Database("school")
+
+	// Soft delete many by IDs, the documents stay in the collection until the purge job removes them
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": time.Now().UTC().Format(time.RFC3339),
+		"deleted_by": deletedBy,
+	}}
+
+	// the homeroom classes of the teachers are looked up and handed over or left without homeroom teacher, and the
+	// teachers are deleted together with their teacher.deleted events, all in one transaction. Only the ids that were
+	// not deleted yet are deleted and returned
+	var deletedIds []string
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		// finding the classes owned by the teachers that are going to be deleted
+		var owners []models.Teacher
+		cursor, err := db.Collection("teachers").Find(sc, bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": nil, "class": bson.M{"$nin": bson.A{nil, ""}}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		err = cursor.All(sc, &owners)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if len(owners) > 0 && !force {
+			err = handOverHomeroom(sc, db, owners, idsTodelete, reassignTo)
+			if err != nil {
+				return err
+			}
+		}
+
+		ids, err := matchingIDs(sc, db.Collection("teachers"), filter)
+		if err != nil {
+			return err
+		}
+
+		res, err := db.Collection("teachers").UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no documents matched the ids"), "No teachers were deleted")
+		}
+		deletedIds = ids
 
-	res, err := client.Database("school").Collection("teachers").DeleteMany(ctx, filter)
+		// forced delete leaves the classes of the deleted teachers without homeroom teacher
+		_, err = db.Collection("classes").UpdateMany(sc, bson.M{"homeroom_teacher_id": bson.M{"$in": idsTodelete}}, bson.M{"$unset": bson.M{"homeroom_teacher_id": ""}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		return recordEntityEvents(sc, db, "teachers", ActionDeleted, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedIds, nil
+}
+
+// handOverHomeroom gives the homeroom class of the teachers that are deleted to the reassignTo teacher, it runs in the
+// transaction of DeleteTeachersDBHandler
+func handOverHomeroom(sc mongo.SessionContext, db *mongo.Database, owners []models.Teacher, idsTodelete []string, reassignTo string) error {
+	if reassignTo == "" {
+		return fmt.Errorf("%w: teacher %s owns class %s, pass reassign_to or force", ErrClassIntegrity, owners[0].Id, owners[0].Class)
+	}
+
+	for _, id := range idsTodelete {
+		if id == reassignTo {
+			return fmt.Errorf("%w: reassign_to teacher %s is being deleted", ErrClassIntegrity, reassignTo)
+		}
+	}
+
+	for _, owner := range owners {
+		if owner.ClassId != owners[0].ClassId {
+			return fmt.Errorf("%w: reassign_to can only take over one class, got %s and %s", ErrClassIntegrity, owners[0].Class, owner.Class)
+		}
+	}
+
+	class, err := findClass(sc, db, owners[0].ClassId, owners[0].Class)
+	if err != nil {
+		return err
+	}
+
+	target, err := findActiveTeacher(sc, db, reassignTo)
+	if err != nil {
+		return err
+	}
+	if target.ClassId != "" && target.ClassId != class.Id {
+		return fmt.Errorf("%w: teacher %s already owns class %s", ErrClassIntegrity, target.Id, target.Class)
+	}
+
+	return setHomeroomTeacher(sc, db, class, target.Id)
+}
+
+// restore soft deleted teacher in mongoDB by user id
+func RestoreTeachersDBHandler(ctx context.Context, idsToRestore []string) ([]string, error) {
+
+	client, err := mongodb.CreatMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
+	defer client.Disconnect(ctx)
 
-	if res.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(err, "No teachers were deleted")
+	// Convert to Mongo ObjectIDs
+	objectIds := make([]primitive.ObjectID, 0, len(idsToRestore))
+	for _, id := range idsToRestore {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid id: %v", id))
+		}
+		objectIds = append(objectIds, objectId)
 	}
 
-	// Return deleted IDs
-	deletedIds := make([]string, 0, len(objectIds))
-	for _, v := range objectIds {
-		deletedIds = append(deletedIds, v.Hex())
+	// only documents that are still soft deleted can be restored
+	filter := bson.M{"_id": bson.M{"$in": objectIds}, "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{"$unset": bson.M{"deleted_at": "", "deleted_by": ""}}
+
+	// the teachers are restored together with their classes and teacher.restored events, only the ids that were soft
+	// deleted are restored and returned
+	var restoredIds []string
+	err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+		// loading the teachers before restoring them to know which classes they owned
+		var restored []models.Teacher
+		cursor, err := client.Database("school").Collection("teachers").Find(sc, filter)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		err = cursor.All(sc, &restored)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		res, err := client.Database("school").Collection("teachers").UpdateMany(sc, filter, update)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		if res.ModifiedCount == 0 {
+			return utils.ErrorHandler(errors.New("no soft deleted documents matched the ids"), "No teachers were restored")
+		}
+
+		// a restored teacher gets the class back unless another teacher took it over in the meantime
+		ids := make([]string, 0, len(restored))
+		for _, teacher := range restored {
+			ids = append(ids, teacher.Id)
+			if teacher.ClassId == "" {
+				continue
+			}
+			teacherID, err := primitive.ObjectIDFromHex(teacher.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+
+			class, err := findClass(sc, client.Database("school"), teacher.ClassId, "")
+			if err == nil && (class.HomeroomTeacherId == "" || class.HomeroomTeacherId == teacher.Id) {
+				err = setHomeroomTeacher(sc, client.Database("school"), class, teacher.Id)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			_, err = client.Database("school").Collection("teachers").UpdateOne(sc, bson.M{"_id": teacherID}, bson.M{"$unset": bson.M{"class": "", "class_id": ""}})
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+		}
+		restoredIds = ids
+		return recordEntityEvents(sc, client.Database("school"), "teachers", ActionRestored, ids)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return deletedIds, nil
+
+	return restoredIds, nil
 }
 
 func GetStudentCountByTeacherIDDBhandler(ctx context.Context, id string) ([]*pb.Student, error) {
@@ -207,7 +388,7 @@ func GetStudentCountByTeacherIDDBhandler(ctx context.Context, id string) ([]*pb.
 
 	// retriving the Teacher from data base
 	var teacher models.Teacher
-	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objectID}).Decode(&teacher)
+	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&teacher)
 	if err != nil {
 		if err == mongo.ErrNoDocuments { // if teacher is not found return invalid id message
 			return nil, utils.ErrorHandler(err, "Invalid ID")
@@ -215,7 +396,16 @@ func GetStudentCountByTeacherIDDBhandler(ctx context.Context, id string) ([]*pb.
 		return nil, utils.ErrorHandler(err, "Failed to retrive teacher")
 	}
 
-	cursor, err := client.Database("school").Collection("students").Find(ctx, bson.M{"calss": teacher.Class})
+	// the class the teacher is homeroom teacher of
+	class, err := findHomeroomClass(ctx, client.Database("school"), id)
+	if err != nil {
+		return nil, err
+	}
+	if class == nil { // teacher has no class so there are no students
+		return nil, nil
+	}
+
+	cursor, err := client.Database("school").Collection("students").Find(ctx, classStudentsFilter(class.Id))
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal Error")
 	}
@@ -247,7 +437,7 @@ func GetStudentCountByTeacherDBHandler(ctx context.Context, id string) (int64, e
 	}
 
 	var teacher models.Teacher
-	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objectID}).Decode(&teacher)
+	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&teacher)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return 0, utils.ErrorHandler(err, "Teacher not found")
@@ -255,7 +445,15 @@ func GetStudentCountByTeacherDBHandler(ctx context.Context, id string) (int64, e
 		return 0, utils.ErrorHandler(err, "Internal error")
 	}
 
-	count, err := client.Database("school").Collection("students").CountDocuments(ctx, bson.M{"class": teacher.Class})
+	class, err := findHomeroomClass(ctx, client.Database("school"), id)
+	if err != nil {
+		return 0, err
+	}
+	if class == nil {
+		return 0, nil
+	}
+
+	count, err := client.Database("school").Collection("students").CountDocuments(ctx, classStudentsFilter(class.Id))
 	if err != nil {
 		return 0, utils.ErrorHandler(err, "Internal Error")
 	}
@@ -0,0 +1,640 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/ical"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrTimetable is returned when a session does not fit the timetable
+var ErrTimetable = errors.New("timetable conflict")
+
+// Add sessions to MongoDB, a session that overlaps a session of the same teacher, room or class is refused
+func AddSessionsDBHandler(ctx context.Context, sessionsFromReq []*pb.Session) ([]*pb.Session, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedSessions []*pb.Session
+
+	// the sessions are checked one by one after the previous one was added, so a request can not conflict with itself
+	for _, pbSession := range sessionsFromReq {
+		session := MapPBToModelSession(pbSession)
+
+		err = prepareSession(ctx, db, session)
+		if err != nil {
+			return nil, err
+		}
+
+		// the check and the insert run in one transaction on the lock of the day, see lockSessionDay
+		var insertedID string
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			err := lockSessionDay(sc, db, session.Term, session.Day)
+			if err != nil {
+				return err
+			}
+			err = checkSessionConflicts(sc, db, session, nil)
+			if err != nil {
+				return err
+			}
+
+			result, err := db.Collection("sessions").InsertOne(sc, session)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error adding value into database")
+			}
+			objectID, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				insertedID = objectID.Hex()
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		session.Id = insertedID
+
+		addedSessions = append(addedSessions, MapModelToPbSession(session))
+	}
+
+	return addedSessions, nil
+}
+
+// Get sessions from MongoDB with optional sorting
+func GetSessionsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.Session, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	return findPage(ctx, client.Database("school").Collection("sessions"), filter, sortOption, pageSize, pageNumber,
+		func() *models.Session { return &models.Session{} }, func() *pb.Session { return &pb.Session{} })
+}
+
+// Update sessions in MongoDB, the session is checked for conflicts as it will be saved
+func UpdateSessionsDBHandler(ctx context.Context, pbSessions []*pb.Session) ([]*pb.Session, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedSessions []*pb.Session
+
+	for _, pbSession := range pbSessions {
+
+		// Validate ID
+		if pbSession.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findSession(ctx, db, pbSession.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		modelSession := MapPBToModelSession(pbSession)
+		merged := *current
+		if modelSession.CourseId != "" {
+			merged.CourseId = modelSession.CourseId
+		}
+		if modelSession.Day != 0 {
+			merged.Day = modelSession.Day
+		}
+		// a period replaces the times, times replace the period
+		if modelSession.Period != 0 {
+			merged.Period = modelSession.Period
+		} else if modelSession.StartTime != "" || modelSession.EndTime != "" {
+			merged.Period = 0
+			if modelSession.StartTime != "" {
+				merged.StartTime = modelSession.StartTime
+			}
+			if modelSession.EndTime != "" {
+				merged.EndTime = modelSession.EndTime
+			}
+		}
+		if modelSession.Room != "" {
+			merged.Room = modelSession.Room
+		}
+
+		err = prepareSession(ctx, db, &merged)
+		if err != nil {
+			return nil, err
+		}
+		saved := merged
+		saved.Id = ""
+		updateDoc, err := updateDocFromModel(&saved)
+		if err != nil {
+			return nil, err
+		}
+		update := bson.M{"$set": updateDoc}
+		if merged.Period == 0 {
+			update["$unset"] = bson.M{"period": ""}
+		}
+
+		err = mongodb.RunTransaction(ctx, client, func(sc mongo.SessionContext) error {
+			err := lockSessionDay(sc, db, merged.Term, merged.Day)
+			if err != nil {
+				return err
+			}
+			err = checkSessionConflicts(sc, db, &merged, []primitive.ObjectID{mustObjectID(current.Id)})
+			if err != nil {
+				return err
+			}
+
+			_, err = db.Collection("sessions").UpdateOne(sc, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, update)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("error updating session id: %s", pbSession.Id))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		updatedSessions = append(updatedSessions, MapModelToPbSession(&merged))
+	}
+
+	return updatedSessions, nil
+}
+
+// delete sessions in mongoDB by id (soft delete)
+func DeleteSessionsDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	return softDeleteByIDs(ctx, client.Database("school").Collection("sessions"), objectIds, deletedBy, "sessions")
+}
+
+// WeekDBHandler returns the sessions of a teacher or class (key is teacher_id or class_id) in a term grouped by day
+func WeekDBHandler(ctx context.Context, key, id, term string) (*pb.Week, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	sessions, err := termSessions(ctx, client.Database("school"), bson.M{key: id}, term)
+	if err != nil {
+		return nil, err
+	}
+
+	week := &pb.Week{Term: term}
+	for i := range sessions {
+		session := &sessions[i]
+		if len(week.Days) == 0 || week.Days[len(week.Days)-1].Day != session.Day {
+			week.Days = append(week.Days, &pb.WeekDay{Day: session.Day})
+		}
+		day := week.Days[len(week.Days)-1]
+		day.Sessions = append(day.Sessions, MapModelToPbSession(session))
+	}
+	return week, nil
+}
+
+// FreeRoomsDBHandler returns the rooms that have no session overlapping the time on the day. The rooms are the rooms
+// of the active classes and every room a session was ever planned in.
+func FreeRoomsDBHandler(ctx context.Context, term string, day, period int32, startTime, endTime string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	startTime, endTime, err = sessionTimes(period, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	classRooms, err := db.Collection("classes").Distinct(ctx, "room", bson.M{"deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	sessionRooms, err := db.Collection("sessions").Distinct(ctx, "room", bson.M{"deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	busyRooms, err := db.Collection("sessions").Distinct(ctx, "room", bson.M{
+		"term":       term,
+		"day":        day,
+		"start_time": bson.M{"$lt": endTime},
+		"end_time":   bson.M{"$gt": startTime},
+		"deleted_at": nil,
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	busy := map[string]bool{}
+	for _, room := range busyRooms {
+		if name, ok := room.(string); ok {
+			busy[name] = true
+		}
+	}
+
+	listed := map[string]bool{}
+	var free []string
+	for _, room := range append(classRooms, sessionRooms...) {
+		name, ok := room.(string)
+		if !ok || name == "" || busy[name] || listed[name] {
+			continue
+		}
+		listed[name] = true
+		free = append(free, name)
+	}
+	sort.Strings(free)
+	return free, nil
+}
+
+// CalendarDBHandler exports the sessions of a teacher, class or room (key is teacher_id, class_id or room) in a term as
+// an iCalendar file. Every session is a weekly event from the first day of the term to the last, holidays are left out.
+func CalendarDBHandler(ctx context.Context, key, value, term string) (string, []byte, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	calendarTerm, err := findTerm(ctx, db, term)
+	if err != nil {
+		if errors.Is(err, ErrCalendar) {
+			return "", nil, fmt.Errorf("%w: term %s is not in the academic calendar", ErrTimetable, term)
+		}
+		return "", nil, err
+	}
+	termStart, err := time.Parse(time.DateOnly, calendarTerm.StartDate)
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Invalid term start date")
+	}
+	termEnd, err := time.Parse(time.DateOnly, calendarTerm.EndDate)
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Invalid term end date")
+	}
+
+	sessions, err := termSessions(ctx, db, bson.M{key: value}, term)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cursor, err := db.Collection("holidays").Find(ctx, bson.M{
+		"start_date": bson.M{"$lte": calendarTerm.EndDate},
+		"end_date":   bson.M{"$gte": calendarTerm.StartDate},
+		"deleted_at": nil,
+	})
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var holidays []models.Holiday
+	err = cursor.All(ctx, &holidays)
+	if err != nil {
+		return "", nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	label, err := calendarLabel(ctx, db, key, value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// subjects and classes are loaded once per id for the event titles
+	courses := map[string]*models.Course{}
+	subjects := map[string]*models.Subject{}
+	classes := map[string]*models.Class{}
+
+	events := make([]ical.Event, 0, len(sessions))
+	for _, session := range sessions {
+		course, ok := courses[session.CourseId]
+		if !ok {
+			course, _ = findCourse(ctx, db, session.CourseId)
+			courses[session.CourseId] = course
+		}
+		summary := "Lesson"
+		if course != nil {
+			subject, ok := subjects[course.SubjectId]
+			if !ok {
+				subject, _ = findSubject(ctx, db, course.SubjectId)
+				subjects[course.SubjectId] = subject
+			}
+			if subject != nil {
+				summary = subject.Name
+			}
+		}
+		class, ok := classes[session.ClassId]
+		if !ok {
+			class, _ = findClass(ctx, db, session.ClassId, "")
+			classes[session.ClassId] = class
+		}
+		if class != nil {
+			summary += " " + class.Name
+		}
+
+		first := termStart
+		for isoWeekday(first) != session.Day {
+			first = first.AddDate(0, 0, 1)
+		}
+		if first.After(termEnd) {
+			continue
+		}
+
+		event := ical.Event{
+			UID:      session.Id + "@school_project_grpc",
+			Summary:  summary,
+			Location: session.Room,
+			Start:    atClock(first, session.StartTime),
+			End:      atClock(first, session.EndTime),
+			Weekly:   true,
+			Until:    atClock(termEnd, "23:59"),
+		}
+		for _, holiday := range holidays {
+			for _, date := range holidayDates(holiday, session.Day) {
+				event.Except = append(event.Except, atClock(date, session.StartTime))
+			}
+		}
+		events = append(events, event)
+	}
+
+	name := fmt.Sprintf("%s %s", label, term)
+	fileName := "timetable_" + strings.ReplaceAll(name, " ", "_") + ".ics"
+	return fileName, ical.Calendar(name, events), nil
+}
+
+// checkCourseSessions makes sure the sessions of a course still fit when the course moves to another teacher, class or
+// term. It runs in the transaction of the course update and takes the locks of the days the sessions are on
+func checkCourseSessions(sc mongo.SessionContext, db *mongo.Database, course *models.Course) error {
+	sessions, err := courseSessions(sc, db, course.Id)
+	if err != nil {
+		return err
+	}
+
+	except := make([]primitive.ObjectID, 0, len(sessions))
+	for _, session := range sessions {
+		except = append(except, mustObjectID(session.Id))
+	}
+	for i := range sessions {
+		session := sessions[i]
+		session.TeacherId = course.TeacherId
+		session.ClassId = course.ClassId
+		session.Term = course.Term
+		err = lockSessionDay(sc, db, session.Term, session.Day)
+		if err != nil {
+			return err
+		}
+		err = checkSessionConflicts(sc, db, &session, except)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncCourseSessions copies the teacher, class and term of the course onto its sessions
+func syncCourseSessions(ctx context.Context, db *mongo.Database, course *models.Course) error {
+	_, err := db.Collection("sessions").UpdateMany(ctx,
+		bson.M{"course_id": course.Id, "deleted_at": nil},
+		bson.M{"$set": bson.M{"teacher_id": course.TeacherId, "class_id": course.ClassId, "term": course.Term}})
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to update the sessions of the course")
+	}
+	return nil
+}
+
+// prepareSession copies teacher, class and term from the course and fills the times from the period.
+// a session without a room takes place in the room of the class
+func prepareSession(ctx context.Context, db *mongo.Database, session *models.Session) error {
+	if session.CourseId == "" {
+		return fmt.Errorf("%w: course_id is required", ErrTimetable)
+	}
+	if session.Day < 1 || session.Day > 7 {
+		return fmt.Errorf("%w: day has to be 1 (monday) to 7 (sunday)", ErrTimetable)
+	}
+
+	course, err := findCourse(ctx, db, session.CourseId)
+	if err != nil {
+		if errors.Is(err, ErrCourseIntegrity) {
+			return fmt.Errorf("%w: course %s does not exist", ErrTimetable, session.CourseId)
+		}
+		return err
+	}
+	closed, err := termClosed(ctx, db, course.Term)
+	if err != nil {
+		return err
+	}
+	if closed {
+		return fmt.Errorf("%w: term %s of the course is closed", ErrTimetable, course.Term)
+	}
+
+	session.StartTime, session.EndTime, err = sessionTimes(session.Period, session.StartTime, session.EndTime)
+	if err != nil {
+		return err
+	}
+
+	session.TeacherId = course.TeacherId
+	session.ClassId = course.ClassId
+	session.Term = course.Term
+
+	if session.Room == "" {
+		class, err := findClass(ctx, db, course.ClassId, "")
+		if err == nil {
+			session.Room = class.Room
+		}
+	}
+	return nil
+}
+
+// lockSessionDay writes the lock document of a day of a term in the transaction of a session write. Mongo does not
+// lock what a transaction only read, so two writes checking the same day could both miss the other's session. Both
+// update the lock document first, mongo aborts the second with a write conflict and RunTransaction retries it, and the
+// retry sees the committed session
+func lockSessionDay(sc mongo.SessionContext, db *mongo.Database, term string, day int32) error {
+	_, err := db.Collection("timetable_locks").UpdateOne(sc, bson.M{"_id": fmt.Sprintf("%s/%d", term, day)},
+		bson.M{"$inc": bson.M{"writes": 1}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to lock the timetable")
+	}
+	return nil
+}
+
+// checkSessionConflicts refuses a session that overlaps a session of the same teacher, room or class on the same day
+func checkSessionConflicts(ctx context.Context, db *mongo.Database, session *models.Session, except []primitive.ObjectID) error {
+	// a session without a teacher or room does not clash with the other sessions without one
+	sameResource := bson.A{bson.M{"class_id": session.ClassId}}
+	if session.TeacherId != "" {
+		sameResource = append(sameResource, bson.M{"teacher_id": session.TeacherId})
+	}
+	if session.Room != "" {
+		sameResource = append(sameResource, bson.M{"room": session.Room})
+	}
+
+	filter := bson.M{
+		"term":       session.Term,
+		"day":        session.Day,
+		"start_time": bson.M{"$lt": session.EndTime},
+		"end_time":   bson.M{"$gt": session.StartTime},
+		"deleted_at": nil,
+		"$or":        sameResource,
+	}
+	if len(except) > 0 {
+		filter["_id"] = bson.M{"$nin": except}
+	}
+
+	var other models.Session
+	err := db.Collection("sessions").FindOne(ctx, filter).Decode(&other)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	when := fmt.Sprintf("on day %d from %s to %s (session %s)", other.Day, other.StartTime, other.EndTime, other.Id)
+	switch {
+	case session.TeacherId != "" && other.TeacherId == session.TeacherId:
+		return fmt.Errorf("%w: teacher %s already teaches %s", ErrTimetable, session.TeacherId, when)
+	case session.Room != "" && other.Room == session.Room:
+		return fmt.Errorf("%w: room %s is already booked %s", ErrTimetable, session.Room, when)
+	default:
+		return fmt.Errorf("%w: class %s already has a session %s", ErrTimetable, session.ClassId, when)
+	}
+}
+
+// termSessions returns the active sessions matching the filter in a term ordered by day and start time
+func termSessions(ctx context.Context, db *mongo.Database, filter bson.M, term string) ([]models.Session, error) {
+	filter["term"] = term
+	filter["deleted_at"] = nil
+
+	opts := options.Find().SetSort(bson.D{{Key: "day", Value: 1}, {Key: "start_time", Value: 1}})
+	cursor, err := db.Collection("sessions").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var sessions []models.Session
+	err = cursor.All(ctx, &sessions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return sessions, nil
+}
+
+// courseSessions returns the active sessions of a course
+func courseSessions(ctx context.Context, db *mongo.Database, courseID string) ([]models.Session, error) {
+	cursor, err := db.Collection("sessions").Find(ctx, bson.M{"course_id": courseID, "deleted_at": nil})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var sessions []models.Session
+	err = cursor.All(ctx, &sessions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return sessions, nil
+}
+
+// findSession loads an active session by id
+func findSession(ctx context.Context, db *mongo.Database, id string) (*models.Session, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid session id: %v", id))
+	}
+
+	var session models.Session
+	err = db.Collection("sessions").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&session)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%w: session %s does not exist", ErrTimetable, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &session, nil
+}
+
+// calendarLabel names the owner of an exported timetable
+func calendarLabel(ctx context.Context, db *mongo.Database, key, value string) (string, error) {
+	switch key {
+	case "teacher_id":
+		teacher, err := findActiveTeacher(ctx, db, value)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return "", fmt.Errorf("%w: teacher %s does not exist", ErrTimetable, value)
+			}
+			return "", err
+		}
+		return teacher.FirstName + " " + teacher.LastName, nil
+	case "class_id":
+		class, err := findClass(ctx, db, value, "")
+		if err != nil {
+			if errors.Is(err, ErrClassIntegrity) {
+				return "", fmt.Errorf("%w: class %s does not exist", ErrTimetable, value)
+			}
+			return "", err
+		}
+		return "Class " + class.Name, nil
+	default:
+		return "Room " + value, nil
+	}
+}
+
+// holidayDates returns the dates of the holiday that fall on the weekday (1 monday to 7 sunday)
+func holidayDates(holiday models.Holiday, day int32) []time.Time {
+	start, err := time.Parse(time.DateOnly, holiday.StartDate)
+	if err != nil {
+		return nil
+	}
+	end, err := time.Parse(time.DateOnly, holiday.EndDate)
+	if err != nil {
+		return nil
+	}
+
+	var dates []time.Time
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		if isoWeekday(date) == day {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// isoWeekday numbers the weekdays from 1 (monday) to 7 (sunday) like the sessions do
+func isoWeekday(date time.Time) int32 {
+	if date.Weekday() == time.Sunday {
+		return 7
+	}
+	return int32(date.Weekday())
+}
+
+// atClock returns the date at the HH:MM clock time
+func atClock(date time.Time, clock string) time.Time {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return date
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, date.Location())
+}
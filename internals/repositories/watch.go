@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrWatch is returned when a watch can not be resumed from the resume token of the client
+var ErrWatch = errors.New("watch invalid")
+
+// operations of the watch events, deleted and restored are the soft delete and its undo
+const (
+	WatchCreated  = "created"
+	WatchUpdated  = "updated"
+	WatchDeleted  = "deleted"
+	WatchRestored = "restored"
+)
+
+/*
+The Watch rpcs follow a collection with a mongo change stream, so like the transactions they need mongo to run as a
+replica set. The filter of the request is matched against the document as it is after the change, looked up when the
+event is read. Every event carries the resume token of the change stream, a client that reconnects with the token of
+the last event it got continues right after it, as long as the oplog still has the change. Soft deletes are updates to
+mongo, they are told apart by deleted_at. Changes to soft deleted documents and hard deletes by the purge job are not
+sent, the documents were already reported deleted.
+
+Only the document after the change is matched, the stream does not know what it looked like before. A document that is
+changed so it no longer matches the filter (a student moved out of the watched class) sends no event at all, clients
+that filter on fields that can change have to reload with the Get rpcs from time to time to drop such documents.
+There is no polling fallback, the server only runs on mongo and refuses to start when mongo is not a replica set
+(mongodb.CheckReplicaSet), so a change stream is always available.
+*/
+
+// changeEvent is the part of a change stream event the watches read
+type changeEvent struct {
+	OperationType     string              `bson:"operationType"`
+	ClusterTime       primitive.Timestamp `bson:"clusterTime"`
+	FullDocument      bson.Raw            `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.M   `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+// WatchStudentsDBHandler sends the changes of the students matching the filter until ctx is done or send fails
+func WatchStudentsDBHandler(ctx context.Context, filter bson.M, resumeToken string, send func(*pb.StudentEvent) error) error {
+	return watchCollection(ctx, "students", filter, resumeToken, func() *models.Student { return &models.Student{} },
+		func(operation string, student *models.Student, token, occurredAt string) error {
+			return send(&pb.StudentEvent{Operation: operation, Student: MapModelToPbStudent(student), ResumeToken: token, OccurredAt: occurredAt})
+		})
+}
+
+// WatchTeachersDBHandler sends the changes of the teachers matching the filter until ctx is done or send fails
+func WatchTeachersDBHandler(ctx context.Context, filter bson.M, resumeToken string, send func(*pb.TeacherEvent) error) error {
+	return watchCollection(ctx, "teachers", filter, resumeToken, func() *models.Teacher { return &models.Teacher{} },
+		func(operation string, teacher *models.Teacher, token, occurredAt string) error {
+			return send(&pb.TeacherEvent{Operation: operation, Teacher: MapModelToPbTeacher(teacher), ResumeToken: token, OccurredAt: occurredAt})
+		})
+}
+
+// WatchExecsDBHandler sends the changes of the execs matching the filter until ctx is done or send fails, without
+// their passwords and tokens
+func WatchExecsDBHandler(ctx context.Context, filter bson.M, resumeToken string, send func(*pb.ExecEvent) error) error {
+	return watchCollection(ctx, "execs", filter, resumeToken, func() *models.Exec { return &models.Exec{} },
+		func(operation string, exec *models.Exec, token, occurredAt string) error {
+			entity := MapModelToPbExec(exec)
+			entity.Password = ""
+			entity.PasswordResetToken = ""
+			entity.PasswordTokenExp = ""
+			return send(&pb.ExecEvent{Operation: operation, Exec: entity, ResumeToken: token, OccurredAt: occurredAt})
+		})
+}
+
+// watchCollection opens a change stream on the collection and hands every change of a document matching the filter
+// to send, decoded into a new model
+func watchCollection[M any](ctx context.Context, collection string, filter bson.M, resumeToken string, newmodel func() *M, send func(operation string, model *M, token, occurredAt string) error) error {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(context.Background())
+
+	match := bson.M{"operationType": bson.M{"$in": bson.A{"insert", "update", "replace"}}}
+	for key, value := range filter {
+		match["fullDocument."+key] = value
+	}
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		opts.SetResumeAfter(bson.M{"_data": resumeToken})
+	}
+
+	stream, err := client.Database("school").Collection(collection).Watch(ctx, mongo.Pipeline{{{Key: "$match", Value: match}}}, opts)
+	if err != nil {
+		if resumeToken != "" {
+			return fmt.Errorf("%w: the watch can not be resumed from %q, start a new one: %v", ErrWatch, resumeToken, err)
+		}
+		return utils.ErrorHandler(err, "Failed to watch "+collection)
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event changeEvent
+		err = stream.Decode(&event)
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to read the change of "+collection)
+		}
+
+		// the document was removed before the event could be read
+		if event.FullDocument == nil {
+			continue
+		}
+
+		_, deleted := event.FullDocument.Lookup("deleted_at").StringValueOK()
+		operation := WatchCreated
+		switch {
+		case event.OperationType == "insert":
+			// created
+		case event.UpdateDescription.UpdatedFields["deleted_at"] != nil:
+			operation = WatchDeleted
+		case slices.Contains(event.UpdateDescription.RemovedFields, "deleted_at"):
+			operation = WatchRestored
+		case deleted:
+			continue
+		default:
+			operation = WatchUpdated
+		}
+
+		model := newmodel()
+		err = bson.Unmarshal(event.FullDocument, model)
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to read the change of "+collection)
+		}
+
+		token, _ := stream.ResumeToken().Lookup("_data").StringValueOK()
+		occurredAt := time.Unix(int64(event.ClusterTime.T), 0).Format(time.RFC3339)
+
+		err = send(operation, model, token, occurredAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	// a client that went away ends the watch
+	if ctx.Err() != nil {
+		return nil
+	}
+	if stream.Err() != nil {
+		return utils.ErrorHandler(stream.Err(), "Failed to watch "+collection)
+	}
+	return nil
+}
@@ -0,0 +1,625 @@
+package repositories
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"net/url"
+	"school_project_grpc/internals/models"
+	"school_project_grpc/internals/repositories/mongodb"
+	"school_project_grpc/pkg/utils"
+	pb "school_project_grpc/proto/gen"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrWebhook is returned when a webhook endpoint is invalid or a delivery can not be replayed
+var ErrWebhook = errors.New("webhook invalid")
+
+const (
+	DeliveryPending    = "pending"
+	DeliveryDelivering = "delivering"
+	DeliveryDelivered  = "delivered"
+	DeliveryFailed     = "failed"
+)
+
+// WebhookAllEvents subscribes an endpoint to every event
+const WebhookAllEvents = "*"
+
+/*
+Webhook endpoints subscribe to the entity events of the event outbox. The webhook dispatcher turns every pending event
+into one delivery per subscribed endpoint and posts the deliveries that are due. Every POST is signed with the secret of
+the endpoint, X-Webhook-Signature is "sha256=" and the hex HMAC-SHA256 of X-Webhook-Timestamp, a dot and the body.
+X-Webhook-Id is the id of the event, a receiver uses it to drop events it already has. A failed POST is tried again
+after a backoff that doubles with every attempt like the mails, after WEBHOOK_MAX_ATTEMPTS attempts the delivery
+failed. Every attempt is kept in the attempt log of the delivery.
+*/
+
+const (
+	webhookLease        = time.Minute
+	webhookRetryBackoff = 30 * time.Second
+	webhookMaxBackoff   = 6 * time.Hour
+	webhookFanOutBatch  = 100
+)
+
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// Add webhook endpoints to MongoDB, each one gets a new secret that is only returned here and by RotateWebhookSecret
+func AddWebhookEndpointsDBHandler(ctx context.Context, endpointsFromReq []*pb.WebhookEndpoint, createdBy string) ([]*pb.WebhookEndpoint, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var addedEndpoints []*pb.WebhookEndpoint
+
+	for _, pbEndpoint := range endpointsFromReq {
+		endpoint := MapPBToModelWebhookEndpoint(pbEndpoint)
+
+		err = checkWebhookEndpoint(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint.Secret, err = newWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		endpoint.CreatedBy = createdBy
+		endpoint.CreatedAt = time.Now().Format(time.RFC3339)
+		endpoint.DeletedAt = ""
+		endpoint.DeletedBy = ""
+
+		result, err := db.Collection("webhook_endpoints").InsertOne(ctx, endpoint)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding value into database")
+		}
+
+		objectID, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			endpoint.Id = objectID.Hex()
+		}
+
+		addedEndpoints = append(addedEndpoints, MapModelToPbWebhookEndpoint(endpoint))
+	}
+
+	return addedEndpoints, nil
+}
+
+// Get webhook endpoints from MongoDB with optional sorting, without their secrets
+func GetWebhookEndpointsDBHandler(ctx context.Context, sortOption bson.D, filter bson.M, pageSize, pageNumber uint32) ([]*pb.WebhookEndpoint, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	endpoints, err := findPage(ctx, client.Database("school").Collection("webhook_endpoints"), filter, sortOption, pageSize, pageNumber,
+		func() *models.WebhookEndpoint { return &models.WebhookEndpoint{} }, func() *pb.WebhookEndpoint { return &pb.WebhookEndpoint{} })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, endpoint := range endpoints {
+		endpoint.Secret = ""
+	}
+	return endpoints, nil
+}
+
+// Update webhook endpoints in MongoDB, the secret only changes with RotateWebhookSecret
+func UpdateWebhookEndpointsDBHandler(ctx context.Context, pbEndpoints []*pb.WebhookEndpoint) ([]*pb.WebhookEndpoint, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "failed to created monogdb client")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	var updatedEndpoints []*pb.WebhookEndpoint
+
+	for _, pbEndpoint := range pbEndpoints {
+
+		// Validate ID
+		if pbEndpoint.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("Missing id: invalid request"), "ID cannot be blank")
+		}
+
+		current, err := findWebhookEndpoint(ctx, db, pbEndpoint.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		modelEndpoint := MapPBToModelWebhookEndpoint(pbEndpoint)
+		modelEndpoint.Secret = ""
+		modelEndpoint.CreatedBy = ""
+		modelEndpoint.CreatedAt = ""
+
+		updateDoc, err := updateDocFromModel(modelEndpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		// the events of the request replace the subscribed events
+		merged := *current
+		raw, err := bson.Marshal(updateDoc)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		if len(modelEndpoint.Events) > 0 {
+			merged.Events = nil
+		}
+		err = bson.Unmarshal(raw, &merged)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		err = checkWebhookEndpoint(&merged)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = db.Collection("webhook_endpoints").UpdateOne(ctx, bson.M{"_id": mustObjectID(current.Id), "deleted_at": nil}, bson.M{"$set": updateDoc})
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("error updating webhook endpoint id: %s", pbEndpoint.Id))
+		}
+
+		merged.Secret = ""
+		updatedEndpoints = append(updatedEndpoints, MapModelToPbWebhookEndpoint(&merged))
+	}
+
+	return updatedEndpoints, nil
+}
+
+// delete webhook endpoints in mongoDB by id (soft delete), their open deliveries fail on their next attempt
+func DeleteWebhookEndpointsDBHandler(ctx context.Context, idsToDelete []string, deletedBy string) ([]string, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds, err := toObjectIDs(idsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	return softDeleteByIDs(ctx, client.Database("school").Collection("webhook_endpoints"), objectIds, deletedBy, "webhook endpoints")
+}
+
+// RotateWebhookSecretDBHandler gives the endpoint a new secret, deliveries are signed with it from the next attempt on
+func RotateWebhookSecretDBHandler(ctx context.Context, id string) (*pb.WebhookEndpoint, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	endpoint, err := findWebhookEndpoint(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.Secret, err = newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Collection("webhook_endpoints").UpdateOne(ctx, bson.M{"_id": mustObjectID(id), "deleted_at": nil},
+		bson.M{"$set": bson.M{"secret": endpoint.Secret}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to rotate webhook secret")
+	}
+
+	return MapModelToPbWebhookEndpoint(endpoint), nil
+}
+
+// ListDeliveriesDBHandler lists the delivery log, newest first. Empty arguments do not filter
+func ListDeliveriesDBHandler(ctx context.Context, endpointID, status, event string, pageSize, pageNumber uint32) ([]*pb.WebhookDelivery, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{}
+	if endpointID != "" {
+		filter["endpoint_id"] = endpointID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	if event != "" {
+		filter["event"] = event
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64((pageNumber - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	cursor, err := client.Database("school").Collection("webhook_deliveries").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to fetch data from db")
+	}
+	var deliveries []models.WebhookDelivery
+	err = cursor.All(ctx, &deliveries)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	entities := make([]*pb.WebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		entities = append(entities, MapModelToPbWebhookDelivery(&deliveries[i]))
+	}
+	return entities, nil
+}
+
+// ReplayDeliveryDBHandler sends a delivered or failed delivery again as a new delivery, signed with the current secret
+func ReplayDeliveryDBHandler(ctx context.Context, id string) (*pb.WebhookDelivery, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid delivery id: %v", id))
+	}
+
+	var original models.WebhookDelivery
+	err = db.Collection("webhook_deliveries").FindOne(ctx, bson.M{"_id": objectID}).Decode(&original)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: delivery %s does not exist", ErrWebhook, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if original.Status != DeliveryDelivered && original.Status != DeliveryFailed {
+		return nil, fmt.Errorf("%w: delivery %s is still %s", ErrWebhook, id, original.Status)
+	}
+
+	_, err = findWebhookEndpoint(ctx, db, original.EndpointId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	replay := models.WebhookDelivery{
+		EndpointId:    original.EndpointId,
+		EventId:       original.EventId,
+		Event:         original.Event,
+		Payload:       original.Payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		ReplayOf:      original.Id,
+	}
+
+	result, err := db.Collection("webhook_deliveries").InsertOne(ctx, replay)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to replay delivery")
+	}
+	if objectID, ok := result.InsertedID.(primitive.ObjectID); ok {
+		replay.Id = objectID.Hex()
+	}
+
+	return MapModelToPbWebhookDelivery(&replay), nil
+}
+
+// DispatchWebhooksDBHandler turns the pending events of the outbox into deliveries and posts every delivery that is
+// due, it returns how many were delivered
+func DispatchWebhooksDBHandler(ctx context.Context, maxAttempts int32) (int, error) {
+	client, err := mongodb.CreatMongoClient()
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+
+	err = fanOutEvents(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for {
+		delivery, err := claimDelivery(ctx, db)
+		if err != nil {
+			return delivered, utils.ErrorHandler(err, "Failed to read the webhook deliveries")
+		}
+		if delivery == nil {
+			return delivered, nil
+		}
+
+		err = deliverWebhook(ctx, db, delivery, maxAttempts)
+		if err != nil {
+			// the delivery waits for its next attempt, the others are still posted
+			log.Printf("Failed to deliver webhook %s of event %s (attempt %d): %v\n", delivery.Id, delivery.EventId, delivery.Attempts, err)
+			continue
+		}
+		delivered++
+	}
+}
+
+// RunWebhookDispatcher runs forever and dispatches the events of the outbox to the webhook endpoints on every interval
+func RunWebhookDispatcher(interval time.Duration, maxAttempts int32) {
+	for {
+		time.Sleep(interval)
+
+		delivered, err := DispatchWebhooksDBHandler(context.Background(), maxAttempts)
+		if err != nil {
+			continue // error is already logged by the error handler, try again on the next tick
+		}
+		if delivered > 0 {
+			log.Printf("Webhook dispatcher delivered %d webhooks\n", delivered)
+		}
+	}
+}
+
+// fanOutEvents creates the deliveries of the pending events for the endpoints subscribed to them and marks the events
+// dispatched. The deliveries are upserted by event and endpoint, an event that was fanned out before a crash is not
+// delivered twice
+func fanOutEvents(ctx context.Context, db *mongo.Database) error {
+	var endpoints []models.WebhookEndpoint
+	cursor, err := db.Collection("webhook_endpoints").Find(ctx, bson.M{"deleted_at": nil})
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to read the webhook endpoints")
+	}
+	err = cursor.All(ctx, &endpoints)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to read the webhook endpoints")
+	}
+
+	for {
+		var events []models.OutboxEvent
+		cursor, err := db.Collection("event_outbox").Find(ctx, bson.M{"status": EventPending},
+			options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).SetLimit(webhookFanOutBatch))
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to read the event outbox")
+		}
+		err = cursor.All(ctx, &events)
+		if err != nil {
+			return utils.ErrorHandler(err, "Failed to read the event outbox")
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		for _, event := range events {
+			now := time.Now().Format(time.RFC3339)
+			for _, endpoint := range endpoints {
+				if !slices.Contains(endpoint.Events, event.Event) && !slices.Contains(endpoint.Events, WebhookAllEvents) {
+					continue
+				}
+
+				_, err = db.Collection("webhook_deliveries").UpdateOne(ctx,
+					bson.M{"event_id": event.Id, "endpoint_id": endpoint.Id, "replay_of": bson.M{"$exists": false}},
+					bson.M{"$setOnInsert": bson.M{
+						"event":           event.Event,
+						"payload":         event.Payload,
+						"status":          DeliveryPending,
+						"next_attempt_at": now,
+						"created_at":      now,
+					}},
+					options.Update().SetUpsert(true))
+				if err != nil {
+					return utils.ErrorHandler(err, "Failed to create webhook delivery")
+				}
+			}
+
+			_, err = db.Collection("event_outbox").UpdateOne(ctx, bson.M{"_id": mustObjectID(event.Id)},
+				bson.M{"$set": bson.M{"status": EventDispatched, "dispatched_at": now}})
+			if err != nil {
+				return utils.ErrorHandler(err, "Failed to update the event outbox")
+			}
+		}
+	}
+}
+
+// claimDelivery takes the next due delivery for this dispatcher, it returns nil when nothing is due
+func claimDelivery(ctx context.Context, db *mongo.Database) (*models.WebhookDelivery, error) {
+	now := time.Now()
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": DeliveryPending, "next_attempt_at": bson.M{"$lte": now.Format(time.RFC3339)}},
+		bson.M{"status": DeliveryDelivering, "locked_until": bson.M{"$lt": now.Format(time.RFC3339)}},
+	}}
+	update := bson.M{
+		"$set": bson.M{"status": DeliveryDelivering, "locked_until": now.Add(webhookLease).Format(time.RFC3339)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var delivery models.WebhookDelivery
+	err := db.Collection("webhook_deliveries").FindOneAndUpdate(ctx, filter, update, opts).Decode(&delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// deliverWebhook posts a claimed delivery to its endpoint and records the attempt, a failed delivery is pending again
+// or, out of attempts, failed. Deliveries of deleted endpoints fail without a POST
+func deliverWebhook(ctx context.Context, db *mongo.Database, delivery *models.WebhookDelivery, maxAttempts int32) error {
+	filter := bson.M{"_id": mustObjectID(delivery.Id), "status": DeliveryDelivering}
+
+	endpoint, err := findWebhookEndpoint(ctx, db, delivery.EndpointId)
+	if err != nil && !errors.Is(err, ErrWebhook) {
+		return err
+	}
+	if err != nil {
+		_, uerr := db.Collection("webhook_deliveries").UpdateOne(ctx, filter, bson.M{
+			"$set":   bson.M{"status": DeliveryFailed, "last_error": "the endpoint was deleted"},
+			"$unset": bson.M{"locked_until": "", "next_attempt_at": ""},
+		})
+		if uerr != nil {
+			return uerr
+		}
+		return err
+	}
+
+	start := time.Now()
+	statusCode, err := postWebhook(ctx, endpoint, delivery)
+	attempt := models.WebhookAttempt{
+		AttemptedAt: start.Format(time.RFC3339),
+		StatusCode:  statusCode,
+		DurationMs:  time.Since(start).Milliseconds(),
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set":   bson.M{"status": DeliveryDelivered, "delivered_at": now.Format(time.RFC3339)},
+		"$unset": bson.M{"locked_until": "", "last_error": "", "next_attempt_at": ""},
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+		set := bson.M{"status": DeliveryPending, "last_error": err.Error(), "next_attempt_at": now.Add(retryBackoff(delivery.Attempts, webhookRetryBackoff, webhookMaxBackoff)).Format(time.RFC3339)}
+		unset := bson.M{"locked_until": ""}
+		if delivery.Attempts >= maxAttempts {
+			set = bson.M{"status": DeliveryFailed, "last_error": err.Error()}
+			unset["next_attempt_at"] = ""
+			log.Printf("Webhook %s of event %s to %s failed after %d attempts: %v\n", delivery.Id, delivery.EventId, endpoint.Url, delivery.Attempts, err)
+		}
+		update = bson.M{"$set": set, "$unset": unset}
+	}
+	update["$push"] = bson.M{"attempt_log": attempt}
+
+	_, uerr := db.Collection("webhook_deliveries").UpdateOne(ctx, filter, update)
+	if uerr != nil {
+		return uerr
+	}
+	return err
+}
+
+// postWebhook posts the payload of the delivery signed with the secret of the endpoint, it returns the status code of
+// the answer, 0 when there was none
+func postWebhook(ctx context.Context, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) (int32, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.Url, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", delivery.EventId)
+	req.Header.Set("X-Webhook-Delivery", delivery.Id)
+	req.Header.Set("X-Webhook-Event", delivery.Event)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", "sha256="+signWebhook(endpoint.Secret, timestamp, delivery.Payload))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return int32(resp.StatusCode), fmt.Errorf("endpoint answered %s", resp.Status)
+	}
+	return int32(resp.StatusCode), nil
+}
+
+// signWebhook is the hex HMAC-SHA256 of the timestamp, a dot and the payload
+func signWebhook(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookSecret is 32 random bytes in hex
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Failed to generate webhook secret")
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// checkWebhookEndpoint validates the url and the subscribed events of the endpoint
+func checkWebhookEndpoint(endpoint *models.WebhookEndpoint) error {
+	if endpoint.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrWebhook)
+	}
+
+	u, err := url.Parse(endpoint.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url %q is not an http or https url", ErrWebhook, endpoint.Url)
+	}
+
+	if len(endpoint.Events) == 0 {
+		return fmt.Errorf("%w: the endpoint has to subscribe to at least one event", ErrWebhook)
+	}
+	for _, event := range endpoint.Events {
+		if !validWebhookEvent(event) {
+			return fmt.Errorf("%w: unknown event %q", ErrWebhook, event)
+		}
+	}
+	return nil
+}
+
+// validWebhookEvent reports whether the event is one the outbox records, or "*"
+func validWebhookEvent(event string) bool {
+	if event == WebhookAllEvents {
+		return true
+	}
+
+	entity, action, ok := strings.Cut(event, ".")
+	if !ok || !slices.Contains(slices.Collect(maps.Values(eventEntities)), entity) {
+		return false
+	}
+	switch action {
+	case ActionCreated, ActionUpdated, ActionDeleted:
+		return true
+	case ActionRestored:
+		return entity == "student" || entity == "teacher"
+	}
+	return false
+}
+
+// findWebhookEndpoint loads an endpoint that is not deleted
+func findWebhookEndpoint(ctx context.Context, db *mongo.Database, id string) (*models.WebhookEndpoint, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, fmt.Sprintf("Invalid webhook endpoint id: %v", id))
+	}
+
+	var endpoint models.WebhookEndpoint
+	err = db.Collection("webhook_endpoints").FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&endpoint)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: webhook endpoint %s does not exist", ErrWebhook, id)
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &endpoint, nil
+}
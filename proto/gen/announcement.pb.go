@@ -0,0 +1,1061 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: announcement.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a file of an announcement, the file itself is stored elsewhere and linked by url
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_announcement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// audience is one of school, grade, class, role. grade_level goes with grade, class with class (the class name on
+// students and teachers) and role with role (the role of the account, like student, teacher or manager).
+// status is draft, scheduled or published and is only changed by PublishAnnouncement. publish_at and expires_at are
+// RFC3339 times, an expired announcement is no longer on the bulletin board
+type Announcement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Audience      string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`
+	GradeLevel    int32                  `protobuf:"varint,5,opt,name=grade_level,json=gradeLevel,proto3" json:"grade_level,omitempty"`
+	Class         string                 `protobuf:"bytes,6,opt,name=class,proto3" json:"class,omitempty"`
+	Role          string                 `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt     string                 `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	PublishedAt   string                 `protobuf:"bytes,10,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Pinned        bool                   `protobuf:"varint,12,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,13,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Read          bool                   `protobuf:"varint,16,opt,name=read,proto3" json:"read,omitempty"` // whether the caller has read it, only set on the bulletin board
+	DeletedAt     string                 `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,18,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_announcement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Announcement) GetGradeLevel() int32 {
+	if x != nil {
+		return x.GradeLevel
+	}
+	return 0
+}
+
+func (x *Announcement) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Announcement) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Announcement) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Announcement) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Announcement) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Announcement) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Announcement) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Announcement) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type Announcements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcements) Reset() {
+	*x = Announcements{}
+	mi := &file_announcement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcements) ProtoMessage() {}
+
+func (x *Announcements) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcements.ProtoReflect.Descriptor instead.
+func (*Announcements) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Announcements) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type AnnouncementIds struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementIds []string               `protobuf:"bytes,1,rep,name=announcementIds,proto3" json:"announcementIds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AnnouncementIds) Reset() {
+	*x = AnnouncementIds{}
+	mi := &file_announcement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementIds) ProtoMessage() {}
+
+func (x *AnnouncementIds) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementIds.ProtoReflect.Descriptor instead.
+func (*AnnouncementIds) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AnnouncementIds) GetAnnouncementIds() []string {
+	if x != nil {
+		return x.AnnouncementIds
+	}
+	return nil
+}
+
+type DeleteAnnouncementsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementsConfirm) Reset() {
+	*x = DeleteAnnouncementsConfirm{}
+	mi := &file_announcement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementsConfirm) ProtoMessage() {}
+
+func (x *DeleteAnnouncementsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementsConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementsConfirm) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteAnnouncementsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAnnouncementsConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetAnnouncementsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Announcement   *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementsRequest) Reset() {
+	*x = GetAnnouncementsRequest{}
+	mi := &file_announcement_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAnnouncementsRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetAnnouncementsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAnnouncementsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// without publish_at the announcement is published now, a later publish_at schedules it
+type PublishAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt     string                 `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishAnnouncementRequest) Reset() {
+	*x = PublishAnnouncementRequest{}
+	mi := &file_announcement_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishAnnouncementRequest) ProtoMessage() {}
+
+func (x *PublishAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*PublishAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PublishAnnouncementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishAnnouncementRequest) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+type PinAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinAnnouncementRequest) Reset() {
+	*x = PinAnnouncementRequest{}
+	mi := &file_announcement_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinAnnouncementRequest) ProtoMessage() {}
+
+func (x *PinAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*PinAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PinAnnouncementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PinAnnouncementRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+// the published announcements addressed to the caller that have not expired, pinned ones first
+type BulletinBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadOnly    bool                   `protobuf:"varint,1,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	PageNum       uint32                 `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulletinBoardRequest) Reset() {
+	*x = BulletinBoardRequest{}
+	mi := &file_announcement_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulletinBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulletinBoardRequest) ProtoMessage() {}
+
+func (x *BulletinBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulletinBoardRequest.ProtoReflect.Descriptor instead.
+func (*BulletinBoardRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BulletinBoardRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *BulletinBoardRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *BulletinBoardRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type MarkAnnouncementsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadIds       []string               `protobuf:"bytes,1,rep,name=read_ids,json=readIds,proto3" json:"read_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAnnouncementsReadResponse) Reset() {
+	*x = MarkAnnouncementsReadResponse{}
+	mi := &file_announcement_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnnouncementsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnnouncementsReadResponse) ProtoMessage() {}
+
+func (x *MarkAnnouncementsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnnouncementsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAnnouncementsReadResponse) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarkAnnouncementsReadResponse) GetReadIds() []string {
+	if x != nil {
+		return x.ReadIds
+	}
+	return nil
+}
+
+type ReadReceiptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadReceiptsRequest) Reset() {
+	*x = ReadReceiptsRequest{}
+	mi := &file_announcement_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceiptsRequest) ProtoMessage() {}
+
+func (x *ReadReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*ReadReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadReceiptsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReadReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	ReadAt        string                 `protobuf:"bytes,4,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	mi := &file_announcement_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadReceipt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetReadAt() string {
+	if x != nil {
+		return x.ReadAt
+	}
+	return ""
+}
+
+// audience_count is the number of accounts the announcement is addressed to
+type ReadReceipts struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId string                 `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	ReadCount      int64                  `protobuf:"varint,2,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`
+	AudienceCount  int64                  `protobuf:"varint,3,opt,name=audience_count,json=audienceCount,proto3" json:"audience_count,omitempty"`
+	Receipts       []*ReadReceipt         `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadReceipts) Reset() {
+	*x = ReadReceipts{}
+	mi := &file_announcement_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipts) ProtoMessage() {}
+
+func (x *ReadReceipts) ProtoReflect() protoreflect.Message {
+	mi := &file_announcement_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipts.ProtoReflect.Descriptor instead.
+func (*ReadReceipts) Descriptor() ([]byte, []int) {
+	return file_announcement_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReadReceipts) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+func (x *ReadReceipts) GetReadCount() int64 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *ReadReceipts) GetAudienceCount() int64 {
+	if x != nil {
+		return x.AudienceCount
+	}
+	return 0
+}
+
+func (x *ReadReceipts) GetReceipts() []*ReadReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+var File_announcement_proto protoreflect.FileDescriptor
+
+const file_announcement_proto_rawDesc = "" +
+	"\n" +
+	"\x12announcement.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"\x93\x01\n" +
+	"\n" +
+	"Attachment\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x04name\x12\x1a\n" +
+	"\x03url\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x03url\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12&\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tsizeBytes\"\xf9\x04\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\x05title\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05title\x12\x1c\n" +
+	"\x04body\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x90NR\x04body\x12?\n" +
+	"\baudience\x18\x04 \x01(\tB#\xfaB r\x1eR\x00R\x06schoolR\x05gradeR\x05classR\x04roleR\baudience\x12(\n" +
+	"\vgrade_level\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"gradeLevel\x12,\n" +
+	"\x05class\x18\x06 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x05class\x12#\n" +
+	"\x04role\x18\a \x01(\tB\x0f\xfaB\fr\n" +
+	"2\b^[a-z]*$R\x04role\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\tR\tpublishAt\x12!\n" +
+	"\fpublished_at\x18\n" +
+	" \x01(\tR\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\tR\texpiresAt\x12\x16\n" +
+	"\x06pinned\x18\f \x01(\bR\x06pinned\x12<\n" +
+	"\vattachments\x18\r \x03(\v2\x10.main.AttachmentB\b\xfaB\x05\x92\x01\x02\x10\x14R\vattachments\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04read\x18\x10 \x01(\bR\x04read\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x12 \x01(\tR\tdeletedBy\"I\n" +
+	"\rAnnouncements\x128\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x12.main.AnnouncementR\rannouncements\"E\n" +
+	"\x0fAnnouncementIds\x122\n" +
+	"\x0fannouncementIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\x0fannouncementIds\"U\n" +
+	"\x1aDeleteAnnouncementsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xdc\x01\n" +
+	"\x17GetAnnouncementsRequest\x126\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x12.main.AnnouncementR\fannouncement\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"i\n" +
+	"\x1aPublishAnnouncementRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\tR\tpublishAt\"^\n" +
+	"\x16PinAnnouncementRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\"o\n" +
+	"\x14BulletinBoardRequest\x12\x1f\n" +
+	"\vunread_only\x18\x01 \x01(\bR\n" +
+	"unreadOnly\x12\x19\n" +
+	"\bpage_num\x18\x02 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\":\n" +
+	"\x1dMarkAnnouncementsReadResponse\x12\x19\n" +
+	"\bread_ids\x18\x01 \x03(\tR\areadIds\"C\n" +
+	"\x13ReadReceiptsRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\"o\n" +
+	"\vReadReceipt\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x17\n" +
+	"\aread_at\x18\x04 \x01(\tR\x06readAt\"\xac\x01\n" +
+	"\fReadReceipts\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId\x12\x1d\n" +
+	"\n" +
+	"read_count\x18\x02 \x01(\x03R\treadCount\x12%\n" +
+	"\x0eaudience_count\x18\x03 \x01(\x03R\raudienceCount\x12-\n" +
+	"\breceipts\x18\x04 \x03(\v2\x11.main.ReadReceiptR\breceipts2\x9b\x05\n" +
+	"\x14AnnouncementsService\x12F\n" +
+	"\x10GetAnnouncements\x12\x1d.main.GetAnnouncementsRequest\x1a\x13.main.Announcements\x12<\n" +
+	"\x10AddAnnouncements\x12\x13.main.Announcements\x1a\x13.main.Announcements\x12?\n" +
+	"\x13UpdateAnnouncements\x12\x13.main.Announcements\x1a\x13.main.Announcements\x12N\n" +
+	"\x13DeleteAnnouncements\x12\x15.main.AnnouncementIds\x1a .main.DeleteAnnouncementsConfirm\x12K\n" +
+	"\x13PublishAnnouncement\x12 .main.PublishAnnouncementRequest\x1a\x12.main.Announcement\x12C\n" +
+	"\x0fPinAnnouncement\x12\x1c.main.PinAnnouncementRequest\x1a\x12.main.Announcement\x12C\n" +
+	"\x10GetBulletinBoard\x12\x1a.main.BulletinBoardRequest\x1a\x13.main.Announcements\x12S\n" +
+	"\x15MarkAnnouncementsRead\x12\x15.main.AnnouncementIds\x1a#.main.MarkAnnouncementsReadResponse\x12@\n" +
+	"\x0fGetReadReceipts\x12\x19.main.ReadReceiptsRequest\x1a\x12.main.ReadReceiptsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_announcement_proto_rawDescOnce sync.Once
+	file_announcement_proto_rawDescData []byte
+)
+
+func file_announcement_proto_rawDescGZIP() []byte {
+	file_announcement_proto_rawDescOnce.Do(func() {
+		file_announcement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_announcement_proto_rawDesc), len(file_announcement_proto_rawDesc)))
+	})
+	return file_announcement_proto_rawDescData
+}
+
+var file_announcement_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_announcement_proto_goTypes = []any{
+	(*Attachment)(nil),                    // 0: main.Attachment
+	(*Announcement)(nil),                  // 1: main.Announcement
+	(*Announcements)(nil),                 // 2: main.Announcements
+	(*AnnouncementIds)(nil),               // 3: main.AnnouncementIds
+	(*DeleteAnnouncementsConfirm)(nil),    // 4: main.DeleteAnnouncementsConfirm
+	(*GetAnnouncementsRequest)(nil),       // 5: main.GetAnnouncementsRequest
+	(*PublishAnnouncementRequest)(nil),    // 6: main.PublishAnnouncementRequest
+	(*PinAnnouncementRequest)(nil),        // 7: main.PinAnnouncementRequest
+	(*BulletinBoardRequest)(nil),          // 8: main.BulletinBoardRequest
+	(*MarkAnnouncementsReadResponse)(nil), // 9: main.MarkAnnouncementsReadResponse
+	(*ReadReceiptsRequest)(nil),           // 10: main.ReadReceiptsRequest
+	(*ReadReceipt)(nil),                   // 11: main.ReadReceipt
+	(*ReadReceipts)(nil),                  // 12: main.ReadReceipts
+	(*SortField)(nil),                     // 13: main.SortField
+}
+var file_announcement_proto_depIdxs = []int32{
+	0,  // 0: main.Announcement.attachments:type_name -> main.Attachment
+	1,  // 1: main.Announcements.announcements:type_name -> main.Announcement
+	1,  // 2: main.GetAnnouncementsRequest.announcement:type_name -> main.Announcement
+	13, // 3: main.GetAnnouncementsRequest.sort_by:type_name -> main.SortField
+	11, // 4: main.ReadReceipts.receipts:type_name -> main.ReadReceipt
+	5,  // 5: main.AnnouncementsService.GetAnnouncements:input_type -> main.GetAnnouncementsRequest
+	2,  // 6: main.AnnouncementsService.AddAnnouncements:input_type -> main.Announcements
+	2,  // 7: main.AnnouncementsService.UpdateAnnouncements:input_type -> main.Announcements
+	3,  // 8: main.AnnouncementsService.DeleteAnnouncements:input_type -> main.AnnouncementIds
+	6,  // 9: main.AnnouncementsService.PublishAnnouncement:input_type -> main.PublishAnnouncementRequest
+	7,  // 10: main.AnnouncementsService.PinAnnouncement:input_type -> main.PinAnnouncementRequest
+	8,  // 11: main.AnnouncementsService.GetBulletinBoard:input_type -> main.BulletinBoardRequest
+	3,  // 12: main.AnnouncementsService.MarkAnnouncementsRead:input_type -> main.AnnouncementIds
+	10, // 13: main.AnnouncementsService.GetReadReceipts:input_type -> main.ReadReceiptsRequest
+	2,  // 14: main.AnnouncementsService.GetAnnouncements:output_type -> main.Announcements
+	2,  // 15: main.AnnouncementsService.AddAnnouncements:output_type -> main.Announcements
+	2,  // 16: main.AnnouncementsService.UpdateAnnouncements:output_type -> main.Announcements
+	4,  // 17: main.AnnouncementsService.DeleteAnnouncements:output_type -> main.DeleteAnnouncementsConfirm
+	1,  // 18: main.AnnouncementsService.PublishAnnouncement:output_type -> main.Announcement
+	1,  // 19: main.AnnouncementsService.PinAnnouncement:output_type -> main.Announcement
+	2,  // 20: main.AnnouncementsService.GetBulletinBoard:output_type -> main.Announcements
+	9,  // 21: main.AnnouncementsService.MarkAnnouncementsRead:output_type -> main.MarkAnnouncementsReadResponse
+	12, // 22: main.AnnouncementsService.GetReadReceipts:output_type -> main.ReadReceipts
+	14, // [14:23] is the sub-list for method output_type
+	5,  // [5:14] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_announcement_proto_init() }
+func file_announcement_proto_init() {
+	if File_announcement_proto != nil {
+		return
+	}
+	file_student_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_announcement_proto_rawDesc), len(file_announcement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_announcement_proto_goTypes,
+		DependencyIndexes: file_announcement_proto_depIdxs,
+		MessageInfos:      file_announcement_proto_msgTypes,
+	}.Build()
+	File_announcement_proto = out.File
+	file_announcement_proto_goTypes = nil
+	file_announcement_proto_depIdxs = nil
+}
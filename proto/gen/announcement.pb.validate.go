@@ -0,0 +1,1786 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: announcement.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := AttachmentValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = AttachmentValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := AttachmentValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ContentType
+
+	if m.GetSizeBytes() < 0 {
+		err := AttachmentValidationError{
+			field:  "SizeBytes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on Announcement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Announcement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Announcement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnnouncementMultiError, or
+// nil if none found.
+func (m *Announcement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Announcement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetTitle()) > 200 {
+		err := AnnouncementValidationError{
+			field:  "Title",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBody()) > 10000 {
+		err := AnnouncementValidationError{
+			field:  "Body",
+			reason: "value length must be at most 10000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Announcement_Audience_InLookup[m.GetAudience()]; !ok {
+		err := AnnouncementValidationError{
+			field:  "Audience",
+			reason: "value must be in list [ school grade class role]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGradeLevel() < 0 {
+		err := AnnouncementValidationError{
+			field:  "GradeLevel",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Announcement_Class_Pattern.MatchString(m.GetClass()) {
+		err := AnnouncementValidationError{
+			field:  "Class",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Announcement_Role_Pattern.MatchString(m.GetRole()) {
+		err := AnnouncementValidationError{
+			field:  "Role",
+			reason: "value does not match regex pattern \"^[a-z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for PublishAt
+
+	// no validation rules for PublishedAt
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for Pinned
+
+	if len(m.GetAttachments()) > 20 {
+		err := AnnouncementValidationError{
+			field:  "Attachments",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnnouncementValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnnouncementValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnnouncementValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Read
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return AnnouncementMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementMultiError is an error wrapping multiple validation errors
+// returned by Announcement.ValidateAll() if the designated constraints aren't met.
+type AnnouncementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementMultiError) AllErrors() []error { return m }
+
+// AnnouncementValidationError is the validation error returned by
+// Announcement.Validate if the designated constraints aren't met.
+type AnnouncementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementValidationError) ErrorName() string { return "AnnouncementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnouncementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementValidationError{}
+
+var _Announcement_Audience_InLookup = map[string]struct{}{
+	"":       {},
+	"school": {},
+	"grade":  {},
+	"class":  {},
+	"role":   {},
+}
+
+var _Announcement_Class_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
+
+var _Announcement_Role_Pattern = regexp.MustCompile("^[a-z]*$")
+
+// Validate checks the field values on Announcements with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Announcements) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Announcements with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnnouncementsMultiError, or
+// nil if none found.
+func (m *Announcements) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Announcements) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAnnouncements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnnouncementsValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnnouncementsValidationError{
+						field:  fmt.Sprintf("Announcements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnnouncementsValidationError{
+					field:  fmt.Sprintf("Announcements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnnouncementsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementsMultiError is an error wrapping multiple validation errors
+// returned by Announcements.ValidateAll() if the designated constraints
+// aren't met.
+type AnnouncementsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementsMultiError) AllErrors() []error { return m }
+
+// AnnouncementsValidationError is the validation error returned by
+// Announcements.Validate if the designated constraints aren't met.
+type AnnouncementsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementsValidationError) ErrorName() string { return "AnnouncementsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnouncementsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncements.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementsValidationError{}
+
+// Validate checks the field values on AnnouncementIds with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnnouncementIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnnouncementIds with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnnouncementIdsMultiError, or nil if none found.
+func (m *AnnouncementIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnnouncementIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAnnouncementIds()) < 1 {
+		err := AnnouncementIdsValidationError{
+			field:  "AnnouncementIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AnnouncementIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnouncementIdsMultiError is an error wrapping multiple validation errors
+// returned by AnnouncementIds.ValidateAll() if the designated constraints
+// aren't met.
+type AnnouncementIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnouncementIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnouncementIdsMultiError) AllErrors() []error { return m }
+
+// AnnouncementIdsValidationError is the validation error returned by
+// AnnouncementIds.Validate if the designated constraints aren't met.
+type AnnouncementIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnouncementIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnouncementIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnouncementIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnouncementIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnouncementIdsValidationError) ErrorName() string { return "AnnouncementIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnouncementIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnouncementIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnouncementIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnouncementIdsValidationError{}
+
+// Validate checks the field values on DeleteAnnouncementsConfirm with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAnnouncementsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAnnouncementsConfirm with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAnnouncementsConfirmMultiError, or nil if none found.
+func (m *DeleteAnnouncementsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAnnouncementsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteAnnouncementsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAnnouncementsConfirmMultiError is an error wrapping multiple
+// validation errors returned by DeleteAnnouncementsConfirm.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteAnnouncementsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAnnouncementsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAnnouncementsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteAnnouncementsConfirmValidationError is the validation error returned
+// by DeleteAnnouncementsConfirm.Validate if the designated constraints aren't met.
+type DeleteAnnouncementsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAnnouncementsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAnnouncementsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAnnouncementsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAnnouncementsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAnnouncementsConfirmValidationError) ErrorName() string {
+	return "DeleteAnnouncementsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAnnouncementsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAnnouncementsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAnnouncementsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAnnouncementsConfirmValidationError{}
+
+// Validate checks the field values on GetAnnouncementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAnnouncementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAnnouncementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAnnouncementsRequestMultiError, or nil if none found.
+func (m *GetAnnouncementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAnnouncementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnouncement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAnnouncementsRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAnnouncementsRequestValidationError{
+					field:  "Announcement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnouncement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAnnouncementsRequestValidationError{
+				field:  "Announcement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAnnouncementsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAnnouncementsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAnnouncementsRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetAnnouncementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAnnouncementsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAnnouncementsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAnnouncementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAnnouncementsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAnnouncementsRequestMultiError) AllErrors() []error { return m }
+
+// GetAnnouncementsRequestValidationError is the validation error returned by
+// GetAnnouncementsRequest.Validate if the designated constraints aren't met.
+type GetAnnouncementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAnnouncementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAnnouncementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAnnouncementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAnnouncementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAnnouncementsRequestValidationError) ErrorName() string {
+	return "GetAnnouncementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAnnouncementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAnnouncementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAnnouncementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAnnouncementsRequestValidationError{}
+
+// Validate checks the field values on PublishAnnouncementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishAnnouncementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishAnnouncementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishAnnouncementRequestMultiError, or nil if none found.
+func (m *PublishAnnouncementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishAnnouncementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := PublishAnnouncementRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_PublishAnnouncementRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PublishAnnouncementRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PublishAt
+
+	if len(errors) > 0 {
+		return PublishAnnouncementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishAnnouncementRequestMultiError is an error wrapping multiple
+// validation errors returned by PublishAnnouncementRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PublishAnnouncementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishAnnouncementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishAnnouncementRequestMultiError) AllErrors() []error { return m }
+
+// PublishAnnouncementRequestValidationError is the validation error returned
+// by PublishAnnouncementRequest.Validate if the designated constraints aren't met.
+type PublishAnnouncementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishAnnouncementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishAnnouncementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishAnnouncementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishAnnouncementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishAnnouncementRequestValidationError) ErrorName() string {
+	return "PublishAnnouncementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishAnnouncementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishAnnouncementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishAnnouncementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishAnnouncementRequestValidationError{}
+
+var _PublishAnnouncementRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on PinAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PinAnnouncementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinAnnouncementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinAnnouncementRequestMultiError, or nil if none found.
+func (m *PinAnnouncementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinAnnouncementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := PinAnnouncementRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_PinAnnouncementRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PinAnnouncementRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Pinned
+
+	if len(errors) > 0 {
+		return PinAnnouncementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinAnnouncementRequestMultiError is an error wrapping multiple validation
+// errors returned by PinAnnouncementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PinAnnouncementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinAnnouncementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinAnnouncementRequestMultiError) AllErrors() []error { return m }
+
+// PinAnnouncementRequestValidationError is the validation error returned by
+// PinAnnouncementRequest.Validate if the designated constraints aren't met.
+type PinAnnouncementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinAnnouncementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinAnnouncementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinAnnouncementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinAnnouncementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinAnnouncementRequestValidationError) ErrorName() string {
+	return "PinAnnouncementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinAnnouncementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinAnnouncementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinAnnouncementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinAnnouncementRequestValidationError{}
+
+var _PinAnnouncementRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on BulletinBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulletinBoardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulletinBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulletinBoardRequestMultiError, or nil if none found.
+func (m *BulletinBoardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulletinBoardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnreadOnly
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return BulletinBoardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulletinBoardRequestMultiError is an error wrapping multiple validation
+// errors returned by BulletinBoardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BulletinBoardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulletinBoardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulletinBoardRequestMultiError) AllErrors() []error { return m }
+
+// BulletinBoardRequestValidationError is the validation error returned by
+// BulletinBoardRequest.Validate if the designated constraints aren't met.
+type BulletinBoardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulletinBoardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulletinBoardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulletinBoardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulletinBoardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulletinBoardRequestValidationError) ErrorName() string {
+	return "BulletinBoardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulletinBoardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulletinBoardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulletinBoardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulletinBoardRequestValidationError{}
+
+// Validate checks the field values on MarkAnnouncementsReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkAnnouncementsReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkAnnouncementsReadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkAnnouncementsReadResponseMultiError, or nil if none found.
+func (m *MarkAnnouncementsReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkAnnouncementsReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MarkAnnouncementsReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkAnnouncementsReadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkAnnouncementsReadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MarkAnnouncementsReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkAnnouncementsReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkAnnouncementsReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkAnnouncementsReadResponseValidationError is the validation error
+// returned by MarkAnnouncementsReadResponse.Validate if the designated
+// constraints aren't met.
+type MarkAnnouncementsReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkAnnouncementsReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkAnnouncementsReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkAnnouncementsReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkAnnouncementsReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkAnnouncementsReadResponseValidationError) ErrorName() string {
+	return "MarkAnnouncementsReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkAnnouncementsReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkAnnouncementsReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkAnnouncementsReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkAnnouncementsReadResponseValidationError{}
+
+// Validate checks the field values on ReadReceiptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadReceiptsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceiptsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadReceiptsRequestMultiError, or nil if none found.
+func (m *ReadReceiptsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceiptsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := ReadReceiptsRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ReadReceiptsRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := ReadReceiptsRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReadReceiptsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadReceiptsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadReceiptsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptsRequestMultiError) AllErrors() []error { return m }
+
+// ReadReceiptsRequestValidationError is the validation error returned by
+// ReadReceiptsRequest.Validate if the designated constraints aren't met.
+type ReadReceiptsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptsRequestValidationError) ErrorName() string {
+	return "ReadReceiptsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceiptsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptsRequestValidationError{}
+
+var _ReadReceiptsRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptMultiError, or
+// nil if none found.
+func (m *ReadReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Role
+
+	// no validation rules for ReadAt
+
+	if len(errors) > 0 {
+		return ReadReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipt.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptMultiError) AllErrors() []error { return m }
+
+// ReadReceiptValidationError is the validation error returned by
+// ReadReceipt.Validate if the designated constraints aren't met.
+type ReadReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptValidationError) ErrorName() string { return "ReadReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptValidationError{}
+
+// Validate checks the field values on ReadReceipts with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipts with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptsMultiError, or
+// nil if none found.
+func (m *ReadReceipts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AnnouncementId
+
+	// no validation rules for ReadCount
+
+	// no validation rules for AudienceCount
+
+	for idx, item := range m.GetReceipts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadReceiptsValidationError{
+						field:  fmt.Sprintf("Receipts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadReceiptsValidationError{
+						field:  fmt.Sprintf("Receipts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadReceiptsValidationError{
+					field:  fmt.Sprintf("Receipts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadReceiptsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptsMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipts.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptsMultiError) AllErrors() []error { return m }
+
+// ReadReceiptsValidationError is the validation error returned by
+// ReadReceipts.Validate if the designated constraints aren't met.
+type ReadReceiptsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptsValidationError) ErrorName() string { return "ReadReceiptsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptsValidationError{}
@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: announcement.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnnouncementsService_GetAnnouncements_FullMethodName      = "/main.AnnouncementsService/GetAnnouncements"
+	AnnouncementsService_AddAnnouncements_FullMethodName      = "/main.AnnouncementsService/AddAnnouncements"
+	AnnouncementsService_UpdateAnnouncements_FullMethodName   = "/main.AnnouncementsService/UpdateAnnouncements"
+	AnnouncementsService_DeleteAnnouncements_FullMethodName   = "/main.AnnouncementsService/DeleteAnnouncements"
+	AnnouncementsService_PublishAnnouncement_FullMethodName   = "/main.AnnouncementsService/PublishAnnouncement"
+	AnnouncementsService_PinAnnouncement_FullMethodName       = "/main.AnnouncementsService/PinAnnouncement"
+	AnnouncementsService_GetBulletinBoard_FullMethodName      = "/main.AnnouncementsService/GetBulletinBoard"
+	AnnouncementsService_MarkAnnouncementsRead_FullMethodName = "/main.AnnouncementsService/MarkAnnouncementsRead"
+	AnnouncementsService_GetReadReceipts_FullMethodName       = "/main.AnnouncementsService/GetReadReceipts"
+)
+
+// AnnouncementsServiceClient is the client API for AnnouncementsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnnouncementsServiceClient interface {
+	GetAnnouncements(ctx context.Context, in *GetAnnouncementsRequest, opts ...grpc.CallOption) (*Announcements, error)
+	AddAnnouncements(ctx context.Context, in *Announcements, opts ...grpc.CallOption) (*Announcements, error)
+	UpdateAnnouncements(ctx context.Context, in *Announcements, opts ...grpc.CallOption) (*Announcements, error)
+	DeleteAnnouncements(ctx context.Context, in *AnnouncementIds, opts ...grpc.CallOption) (*DeleteAnnouncementsConfirm, error)
+	PublishAnnouncement(ctx context.Context, in *PublishAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	PinAnnouncement(ctx context.Context, in *PinAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	GetBulletinBoard(ctx context.Context, in *BulletinBoardRequest, opts ...grpc.CallOption) (*Announcements, error)
+	MarkAnnouncementsRead(ctx context.Context, in *AnnouncementIds, opts ...grpc.CallOption) (*MarkAnnouncementsReadResponse, error)
+	GetReadReceipts(ctx context.Context, in *ReadReceiptsRequest, opts ...grpc.CallOption) (*ReadReceipts, error)
+}
+
+type announcementsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnouncementsServiceClient(cc grpc.ClientConnInterface) AnnouncementsServiceClient {
+	return &announcementsServiceClient{cc}
+}
+
+func (c *announcementsServiceClient) GetAnnouncements(ctx context.Context, in *GetAnnouncementsRequest, opts ...grpc.CallOption) (*Announcements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, AnnouncementsService_GetAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) AddAnnouncements(ctx context.Context, in *Announcements, opts ...grpc.CallOption) (*Announcements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, AnnouncementsService_AddAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) UpdateAnnouncements(ctx context.Context, in *Announcements, opts ...grpc.CallOption) (*Announcements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, AnnouncementsService_UpdateAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) DeleteAnnouncements(ctx context.Context, in *AnnouncementIds, opts ...grpc.CallOption) (*DeleteAnnouncementsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAnnouncementsConfirm)
+	err := c.cc.Invoke(ctx, AnnouncementsService_DeleteAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) PublishAnnouncement(ctx context.Context, in *PublishAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, AnnouncementsService_PublishAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) PinAnnouncement(ctx context.Context, in *PinAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, AnnouncementsService_PinAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) GetBulletinBoard(ctx context.Context, in *BulletinBoardRequest, opts ...grpc.CallOption) (*Announcements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, AnnouncementsService_GetBulletinBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) MarkAnnouncementsRead(ctx context.Context, in *AnnouncementIds, opts ...grpc.CallOption) (*MarkAnnouncementsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAnnouncementsReadResponse)
+	err := c.cc.Invoke(ctx, AnnouncementsService_MarkAnnouncementsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) GetReadReceipts(ctx context.Context, in *ReadReceiptsRequest, opts ...grpc.CallOption) (*ReadReceipts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadReceipts)
+	err := c.cc.Invoke(ctx, AnnouncementsService_GetReadReceipts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnnouncementsServiceServer is the server API for AnnouncementsService service.
+// All implementations must embed UnimplementedAnnouncementsServiceServer
+// for forward compatibility.
+type AnnouncementsServiceServer interface {
+	GetAnnouncements(context.Context, *GetAnnouncementsRequest) (*Announcements, error)
+	AddAnnouncements(context.Context, *Announcements) (*Announcements, error)
+	UpdateAnnouncements(context.Context, *Announcements) (*Announcements, error)
+	DeleteAnnouncements(context.Context, *AnnouncementIds) (*DeleteAnnouncementsConfirm, error)
+	PublishAnnouncement(context.Context, *PublishAnnouncementRequest) (*Announcement, error)
+	PinAnnouncement(context.Context, *PinAnnouncementRequest) (*Announcement, error)
+	GetBulletinBoard(context.Context, *BulletinBoardRequest) (*Announcements, error)
+	MarkAnnouncementsRead(context.Context, *AnnouncementIds) (*MarkAnnouncementsReadResponse, error)
+	GetReadReceipts(context.Context, *ReadReceiptsRequest) (*ReadReceipts, error)
+	mustEmbedUnimplementedAnnouncementsServiceServer()
+}
+
+// UnimplementedAnnouncementsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnouncementsServiceServer struct{}
+
+func (UnimplementedAnnouncementsServiceServer) GetAnnouncements(context.Context, *GetAnnouncementsRequest) (*Announcements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) AddAnnouncements(context.Context, *Announcements) (*Announcements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) UpdateAnnouncements(context.Context, *Announcements) (*Announcements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) DeleteAnnouncements(context.Context, *AnnouncementIds) (*DeleteAnnouncementsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) PublishAnnouncement(context.Context, *PublishAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) PinAnnouncement(context.Context, *PinAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) GetBulletinBoard(context.Context, *BulletinBoardRequest) (*Announcements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulletinBoard not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) MarkAnnouncementsRead(context.Context, *AnnouncementIds) (*MarkAnnouncementsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAnnouncementsRead not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) GetReadReceipts(context.Context, *ReadReceiptsRequest) (*ReadReceipts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadReceipts not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) mustEmbedUnimplementedAnnouncementsServiceServer() {}
+func (UnimplementedAnnouncementsServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeAnnouncementsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnouncementsServiceServer will
+// result in compilation errors.
+type UnsafeAnnouncementsServiceServer interface {
+	mustEmbedUnimplementedAnnouncementsServiceServer()
+}
+
+func RegisterAnnouncementsServiceServer(s grpc.ServiceRegistrar, srv AnnouncementsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnnouncementsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnouncementsService_ServiceDesc, srv)
+}
+
+func _AnnouncementsService_GetAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).GetAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_GetAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).GetAnnouncements(ctx, req.(*GetAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_AddAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Announcements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).AddAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_AddAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).AddAnnouncements(ctx, req.(*Announcements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_UpdateAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Announcements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).UpdateAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_UpdateAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).UpdateAnnouncements(ctx, req.(*Announcements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_DeleteAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnouncementIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).DeleteAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_DeleteAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).DeleteAnnouncements(ctx, req.(*AnnouncementIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_PublishAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).PublishAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_PublishAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).PublishAnnouncement(ctx, req.(*PublishAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_PinAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).PinAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_PinAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).PinAnnouncement(ctx, req.(*PinAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_GetBulletinBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulletinBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).GetBulletinBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_GetBulletinBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).GetBulletinBoard(ctx, req.(*BulletinBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_MarkAnnouncementsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnouncementIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).MarkAnnouncementsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_MarkAnnouncementsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).MarkAnnouncementsRead(ctx, req.(*AnnouncementIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_GetReadReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).GetReadReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_GetReadReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).GetReadReceipts(ctx, req.(*ReadReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnnouncementsService_ServiceDesc is the grpc.ServiceDesc for AnnouncementsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnouncementsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AnnouncementsService",
+	HandlerType: (*AnnouncementsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAnnouncements",
+			Handler:    _AnnouncementsService_GetAnnouncements_Handler,
+		},
+		{
+			MethodName: "AddAnnouncements",
+			Handler:    _AnnouncementsService_AddAnnouncements_Handler,
+		},
+		{
+			MethodName: "UpdateAnnouncements",
+			Handler:    _AnnouncementsService_UpdateAnnouncements_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncements",
+			Handler:    _AnnouncementsService_DeleteAnnouncements_Handler,
+		},
+		{
+			MethodName: "PublishAnnouncement",
+			Handler:    _AnnouncementsService_PublishAnnouncement_Handler,
+		},
+		{
+			MethodName: "PinAnnouncement",
+			Handler:    _AnnouncementsService_PinAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetBulletinBoard",
+			Handler:    _AnnouncementsService_GetBulletinBoard_Handler,
+		},
+		{
+			MethodName: "MarkAnnouncementsRead",
+			Handler:    _AnnouncementsService_MarkAnnouncementsRead_Handler,
+		},
+		{
+			MethodName: "GetReadReceipts",
+			Handler:    _AnnouncementsService_GetReadReceipts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "announcement.proto",
+}
@@ -0,0 +1,987 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// status is one of present, absent, late, excused
+// period 0 is the daily record, periods 1 and up are lessons of that day
+type AttendanceRecord struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId     string                  `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId       string                  `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Date          string                  `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Period        int32                   `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	Status        string                  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Note          string                  `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	MarkedBy      string                  `protobuf:"bytes,8,opt,name=marked_by,json=markedBy,proto3" json:"marked_by,omitempty"`
+	MarkedAt      string                  `protobuf:"bytes,9,opt,name=marked_at,json=markedAt,proto3" json:"marked_at,omitempty"`
+	Corrections   []*AttendanceCorrection `protobuf:"bytes,10,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	Term          string                  `protobuf:"bytes,11,opt,name=term,proto3" json:"term,omitempty"` // the term the date lies in, set by the server
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecord) Reset() {
+	*x = AttendanceRecord{}
+	mi := &file_attendance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecord) ProtoMessage() {}
+
+func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
+func (*AttendanceRecord) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttendanceRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetMarkedBy() string {
+	if x != nil {
+		return x.MarkedBy
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetMarkedAt() string {
+	if x != nil {
+		return x.MarkedAt
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetCorrections() []*AttendanceCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *AttendanceRecord) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type AttendanceRecords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AttendanceRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecords) Reset() {
+	*x = AttendanceRecords{}
+	mi := &file_attendance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecords) ProtoMessage() {}
+
+func (x *AttendanceRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecords.ProtoReflect.Descriptor instead.
+func (*AttendanceRecords) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttendanceRecords) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// every correction of a record is kept with its reason
+type AttendanceCorrection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PreviousStatus string                 `protobuf:"bytes,1,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CorrectedBy    string                 `protobuf:"bytes,4,opt,name=corrected_by,json=correctedBy,proto3" json:"corrected_by,omitempty"`
+	CorrectedAt    string                 `protobuf:"bytes,5,opt,name=corrected_at,json=correctedAt,proto3" json:"corrected_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttendanceCorrection) Reset() {
+	*x = AttendanceCorrection{}
+	mi := &file_attendance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceCorrection) ProtoMessage() {}
+
+func (x *AttendanceCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceCorrection.ProtoReflect.Descriptor instead.
+func (*AttendanceCorrection) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttendanceCorrection) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetCorrectedBy() string {
+	if x != nil {
+		return x.CorrectedBy
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetCorrectedAt() string {
+	if x != nil {
+		return x.CorrectedAt
+	}
+	return ""
+}
+
+type MarkAttendanceResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Records []*AttendanceRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// students that already had a record for the date and period, those have to be corrected instead
+	SkippedStudentIds []string `protobuf:"bytes,2,rep,name=skipped_student_ids,json=skippedStudentIds,proto3" json:"skipped_student_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarkAttendanceResponse) Reset() {
+	*x = MarkAttendanceResponse{}
+	mi := &file_attendance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAttendanceResponse) ProtoMessage() {}
+
+func (x *MarkAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*MarkAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarkAttendanceResponse) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *MarkAttendanceResponse) GetSkippedStudentIds() []string {
+	if x != nil {
+		return x.SkippedStudentIds
+	}
+	return nil
+}
+
+type StudentAttendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentAttendance) Reset() {
+	*x = StudentAttendance{}
+	mi := &file_attendance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAttendance) ProtoMessage() {}
+
+func (x *StudentAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAttendance.ProtoReflect.Descriptor instead.
+func (*StudentAttendance) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StudentAttendance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentAttendance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StudentAttendance) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// marks every student of the class of the given class teacher, students not listed in exceptions get default_status
+type ClassAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Period        int32                  `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	DefaultStatus string                 `protobuf:"bytes,4,opt,name=default_status,json=defaultStatus,proto3" json:"default_status,omitempty"`
+	Exceptions    []*StudentAttendance   `protobuf:"bytes,5,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassAttendanceRequest) Reset() {
+	*x = ClassAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassAttendanceRequest) ProtoMessage() {}
+
+func (x *ClassAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ClassAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClassAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ClassAttendanceRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ClassAttendanceRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *ClassAttendanceRequest) GetDefaultStatus() string {
+	if x != nil {
+		return x.DefaultStatus
+	}
+	return ""
+}
+
+func (x *ClassAttendanceRequest) GetExceptions() []*StudentAttendance {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type AttendanceCorrectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceCorrectionRequest) Reset() {
+	*x = AttendanceCorrectionRequest{}
+	mi := &file_attendance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceCorrectionRequest) ProtoMessage() {}
+
+func (x *AttendanceCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceCorrectionRequest.ProtoReflect.Descriptor instead.
+func (*AttendanceCorrectionRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AttendanceCorrectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceCorrectionRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendanceCorrectionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetAttendanceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	FromDate  string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate    string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Status    string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// without term and dates the records of the current term are returned
+	Term          string `protobuf:"bytes,6,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// id is a student id for GetStudentAttendanceSummary and a class id for GetClassAttendanceSummary
+type AttendanceSummaryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromDate string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate   string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	// without term and dates the current term is summarized
+	Term          string `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceSummaryRequest) Reset() {
+	*x = AttendanceSummaryRequest{}
+	mi := &file_attendance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceSummaryRequest) ProtoMessage() {}
+
+func (x *AttendanceSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceSummaryRequest.ProtoReflect.Descriptor instead.
+func (*AttendanceSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AttendanceSummaryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceSummaryRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *AttendanceSummaryRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *AttendanceSummaryRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// attendance_rate is (present + late) / (total - excused) in percent, 100 when there is nothing to count
+type AttendanceSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Present        int32                  `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
+	Absent         int32                  `protobuf:"varint,3,opt,name=absent,proto3" json:"absent,omitempty"`
+	Late           int32                  `protobuf:"varint,4,opt,name=late,proto3" json:"late,omitempty"`
+	Excused        int32                  `protobuf:"varint,5,opt,name=excused,proto3" json:"excused,omitempty"`
+	Total          int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	AttendanceRate float64                `protobuf:"fixed64,7,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttendanceSummary) Reset() {
+	*x = AttendanceSummary{}
+	mi := &file_attendance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceSummary) ProtoMessage() {}
+
+func (x *AttendanceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceSummary.ProtoReflect.Descriptor instead.
+func (*AttendanceSummary) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttendanceSummary) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceSummary) GetPresent() int32 {
+	if x != nil {
+		return x.Present
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetAbsent() int32 {
+	if x != nil {
+		return x.Absent
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetExcused() int32 {
+	if x != nil {
+		return x.Excused
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+type ClassAttendanceSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Totals        *AttendanceSummary     `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	Students      []*AttendanceSummary   `protobuf:"bytes,3,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassAttendanceSummary) Reset() {
+	*x = ClassAttendanceSummary{}
+	mi := &file_attendance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassAttendanceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassAttendanceSummary) ProtoMessage() {}
+
+func (x *ClassAttendanceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassAttendanceSummary.ProtoReflect.Descriptor instead.
+func (*ClassAttendanceSummary) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClassAttendanceSummary) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassAttendanceSummary) GetTotals() *AttendanceSummary {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *ClassAttendanceSummary) GetStudents() []*AttendanceSummary {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+var File_attendance_proto protoreflect.FileDescriptor
+
+const file_attendance_proto_rawDesc = "" +
+	"\n" +
+	"\x10attendance.proto\x12\x04main\x1a\x17validate/validate.proto\"\xe0\x03\n" +
+	"\x10AttendanceRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tstudentId\x126\n" +
+	"\bclass_id\x18\x03 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12:\n" +
+	"\x04date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x04date\x12!\n" +
+	"\x06period\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\x06period\x12?\n" +
+	"\x06status\x18\x06 \x01(\tB'\xfaB$r\"R\x00R\apresentR\x06absentR\x04lateR\aexcusedR\x06status\x12\x1c\n" +
+	"\x04note\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\x12\x1b\n" +
+	"\tmarked_by\x18\b \x01(\tR\bmarkedBy\x12\x1b\n" +
+	"\tmarked_at\x18\t \x01(\tR\bmarkedAt\x12<\n" +
+	"\vcorrections\x18\n" +
+	" \x03(\v2\x1a.main.AttendanceCorrectionR\vcorrections\x12\x12\n" +
+	"\x04term\x18\v \x01(\tR\x04term\"E\n" +
+	"\x11AttendanceRecords\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.main.AttendanceRecordR\arecords\"\xb5\x01\n" +
+	"\x14AttendanceCorrection\x12'\n" +
+	"\x0fprevious_status\x18\x01 \x01(\tR\x0epreviousStatus\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\fcorrected_by\x18\x04 \x01(\tR\vcorrectedBy\x12!\n" +
+	"\fcorrected_at\x18\x05 \x01(\tR\vcorrectedAt\"z\n" +
+	"\x16MarkAttendanceResponse\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.main.AttendanceRecordR\arecords\x12.\n" +
+	"\x13skipped_student_ids\x18\x02 \x03(\tR\x11skippedStudentIds\"\xad\x01\n" +
+	"\x11StudentAttendance\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12=\n" +
+	"\x06status\x18\x02 \x01(\tB%\xfaB\"r R\apresentR\x06absentR\x04lateR\aexcusedR\x06status\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\"\xba\x02\n" +
+	"\x16ClassAttendanceRequest\x12;\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tteacherId\x127\n" +
+	"\x04date\x18\x02 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x04date\x12!\n" +
+	"\x06period\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\x06period\x12N\n" +
+	"\x0edefault_status\x18\x04 \x01(\tB'\xfaB$r\"R\x00R\apresentR\x06absentR\x04lateR\aexcusedR\rdefaultStatus\x127\n" +
+	"\n" +
+	"exceptions\x18\x05 \x03(\v2\x17.main.StudentAttendanceR\n" +
+	"exceptions\"\xae\x01\n" +
+	"\x1bAttendanceCorrectionRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12=\n" +
+	"\x06status\x18\x02 \x01(\tB%\xfaB\"r R\apresentR\x06absentR\x04lateR\aexcusedR\x06status\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xf4\x03R\x06reason\"\xfe\x02\n" +
+	"\x14GetAttendanceRequest\x12:\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tstudentId\x126\n" +
+	"\bclass_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12C\n" +
+	"\tfrom_date\x18\x03 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\bfromDate\x12?\n" +
+	"\ato_date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x06toDate\x12?\n" +
+	"\x06status\x18\x05 \x01(\tB'\xfaB$r\"R\x00R\apresentR\x06absentR\x04lateR\aexcusedR\x06status\x12+\n" +
+	"\x04term\x18\x06 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\"\xfb\x01\n" +
+	"\x18AttendanceSummaryRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12C\n" +
+	"\tfrom_date\x18\x02 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\bfromDate\x12?\n" +
+	"\ato_date\x18\x03 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x06toDate\x12+\n" +
+	"\x04term\x18\x04 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\"\xd1\x01\n" +
+	"\x11AttendanceSummary\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\apresent\x18\x02 \x01(\x05R\apresent\x12\x16\n" +
+	"\x06absent\x18\x03 \x01(\x05R\x06absent\x12\x12\n" +
+	"\x04late\x18\x04 \x01(\x05R\x04late\x12\x18\n" +
+	"\aexcused\x18\x05 \x01(\x05R\aexcused\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x12'\n" +
+	"\x0fattendance_rate\x18\a \x01(\x01R\x0eattendanceRate\"\x99\x01\n" +
+	"\x16ClassAttendanceSummary\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12/\n" +
+	"\x06totals\x18\x02 \x01(\v2\x17.main.AttendanceSummaryR\x06totals\x123\n" +
+	"\bstudents\x18\x03 \x03(\v2\x17.main.AttendanceSummaryR\bstudents2\xf8\x03\n" +
+	"\x11AttendanceService\x12G\n" +
+	"\x0eMarkAttendance\x12\x17.main.AttendanceRecords\x1a\x1c.main.MarkAttendanceResponse\x12Q\n" +
+	"\x13MarkClassAttendance\x12\x1c.main.ClassAttendanceRequest\x1a\x1c.main.MarkAttendanceResponse\x12N\n" +
+	"\x11CorrectAttendance\x12!.main.AttendanceCorrectionRequest\x1a\x16.main.AttendanceRecord\x12D\n" +
+	"\rGetAttendance\x12\x1a.main.GetAttendanceRequest\x1a\x17.main.AttendanceRecords\x12V\n" +
+	"\x1bGetStudentAttendanceSummary\x12\x1e.main.AttendanceSummaryRequest\x1a\x17.main.AttendanceSummary\x12Y\n" +
+	"\x19GetClassAttendanceSummary\x12\x1e.main.AttendanceSummaryRequest\x1a\x1c.main.ClassAttendanceSummaryB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_attendance_proto_rawDescOnce sync.Once
+	file_attendance_proto_rawDescData []byte
+)
+
+func file_attendance_proto_rawDescGZIP() []byte {
+	file_attendance_proto_rawDescOnce.Do(func() {
+		file_attendance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)))
+	})
+	return file_attendance_proto_rawDescData
+}
+
+var file_attendance_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_attendance_proto_goTypes = []any{
+	(*AttendanceRecord)(nil),            // 0: main.AttendanceRecord
+	(*AttendanceRecords)(nil),           // 1: main.AttendanceRecords
+	(*AttendanceCorrection)(nil),        // 2: main.AttendanceCorrection
+	(*MarkAttendanceResponse)(nil),      // 3: main.MarkAttendanceResponse
+	(*StudentAttendance)(nil),           // 4: main.StudentAttendance
+	(*ClassAttendanceRequest)(nil),      // 5: main.ClassAttendanceRequest
+	(*AttendanceCorrectionRequest)(nil), // 6: main.AttendanceCorrectionRequest
+	(*GetAttendanceRequest)(nil),        // 7: main.GetAttendanceRequest
+	(*AttendanceSummaryRequest)(nil),    // 8: main.AttendanceSummaryRequest
+	(*AttendanceSummary)(nil),           // 9: main.AttendanceSummary
+	(*ClassAttendanceSummary)(nil),      // 10: main.ClassAttendanceSummary
+}
+var file_attendance_proto_depIdxs = []int32{
+	2,  // 0: main.AttendanceRecord.corrections:type_name -> main.AttendanceCorrection
+	0,  // 1: main.AttendanceRecords.records:type_name -> main.AttendanceRecord
+	0,  // 2: main.MarkAttendanceResponse.records:type_name -> main.AttendanceRecord
+	4,  // 3: main.ClassAttendanceRequest.exceptions:type_name -> main.StudentAttendance
+	9,  // 4: main.ClassAttendanceSummary.totals:type_name -> main.AttendanceSummary
+	9,  // 5: main.ClassAttendanceSummary.students:type_name -> main.AttendanceSummary
+	1,  // 6: main.AttendanceService.MarkAttendance:input_type -> main.AttendanceRecords
+	5,  // 7: main.AttendanceService.MarkClassAttendance:input_type -> main.ClassAttendanceRequest
+	6,  // 8: main.AttendanceService.CorrectAttendance:input_type -> main.AttendanceCorrectionRequest
+	7,  // 9: main.AttendanceService.GetAttendance:input_type -> main.GetAttendanceRequest
+	8,  // 10: main.AttendanceService.GetStudentAttendanceSummary:input_type -> main.AttendanceSummaryRequest
+	8,  // 11: main.AttendanceService.GetClassAttendanceSummary:input_type -> main.AttendanceSummaryRequest
+	3,  // 12: main.AttendanceService.MarkAttendance:output_type -> main.MarkAttendanceResponse
+	3,  // 13: main.AttendanceService.MarkClassAttendance:output_type -> main.MarkAttendanceResponse
+	0,  // 14: main.AttendanceService.CorrectAttendance:output_type -> main.AttendanceRecord
+	1,  // 15: main.AttendanceService.GetAttendance:output_type -> main.AttendanceRecords
+	9,  // 16: main.AttendanceService.GetStudentAttendanceSummary:output_type -> main.AttendanceSummary
+	10, // 17: main.AttendanceService.GetClassAttendanceSummary:output_type -> main.ClassAttendanceSummary
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_attendance_proto_init() }
+func file_attendance_proto_init() {
+	if File_attendance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attendance_proto_goTypes,
+		DependencyIndexes: file_attendance_proto_depIdxs,
+		MessageInfos:      file_attendance_proto_msgTypes,
+	}.Build()
+	File_attendance_proto = out.File
+	file_attendance_proto_goTypes = nil
+	file_attendance_proto_depIdxs = nil
+}
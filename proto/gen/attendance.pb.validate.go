@@ -0,0 +1,1830 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AttendanceRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceRecordMultiError, or nil if none found.
+func (m *AttendanceRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetStudentId() != "" {
+
+		if !_AttendanceRecord_StudentId_Pattern.MatchString(m.GetStudentId()) {
+			err := AttendanceRecordValidationError{
+				field:  "StudentId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClassId() != "" {
+
+		if !_AttendanceRecord_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := AttendanceRecordValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDate() != "" {
+
+		if !_AttendanceRecord_Date_Pattern.MatchString(m.GetDate()) {
+			err := AttendanceRecordValidationError{
+				field:  "Date",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetPeriod(); val < 0 || val > 12 {
+		err := AttendanceRecordValidationError{
+			field:  "Period",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AttendanceRecord_Status_InLookup[m.GetStatus()]; !ok {
+		err := AttendanceRecordValidationError{
+			field:  "Status",
+			reason: "value must be in list [ present absent late excused]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 500 {
+		err := AttendanceRecordValidationError{
+			field:  "Note",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MarkedBy
+
+	// no validation rules for MarkedAt
+
+	for idx, item := range m.GetCorrections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttendanceRecordValidationError{
+						field:  fmt.Sprintf("Corrections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttendanceRecordValidationError{
+						field:  fmt.Sprintf("Corrections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttendanceRecordValidationError{
+					field:  fmt.Sprintf("Corrections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return AttendanceRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceRecordMultiError is an error wrapping multiple validation errors
+// returned by AttendanceRecord.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceRecordMultiError) AllErrors() []error { return m }
+
+// AttendanceRecordValidationError is the validation error returned by
+// AttendanceRecord.Validate if the designated constraints aren't met.
+type AttendanceRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceRecordValidationError) ErrorName() string { return "AttendanceRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttendanceRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceRecordValidationError{}
+
+var _AttendanceRecord_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _AttendanceRecord_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _AttendanceRecord_Date_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _AttendanceRecord_Status_InLookup = map[string]struct{}{
+	"":        {},
+	"present": {},
+	"absent":  {},
+	"late":    {},
+	"excused": {},
+}
+
+// Validate checks the field values on AttendanceRecords with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceRecords) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceRecords with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceRecordsMultiError, or nil if none found.
+func (m *AttendanceRecords) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceRecords) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttendanceRecordsValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttendanceRecordsValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttendanceRecordsValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttendanceRecordsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceRecordsMultiError is an error wrapping multiple validation errors
+// returned by AttendanceRecords.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceRecordsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceRecordsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceRecordsMultiError) AllErrors() []error { return m }
+
+// AttendanceRecordsValidationError is the validation error returned by
+// AttendanceRecords.Validate if the designated constraints aren't met.
+type AttendanceRecordsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceRecordsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceRecordsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceRecordsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceRecordsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceRecordsValidationError) ErrorName() string {
+	return "AttendanceRecordsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceRecordsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceRecords.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceRecordsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceRecordsValidationError{}
+
+// Validate checks the field values on AttendanceCorrection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceCorrection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceCorrection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceCorrectionMultiError, or nil if none found.
+func (m *AttendanceCorrection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceCorrection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreviousStatus
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for CorrectedBy
+
+	// no validation rules for CorrectedAt
+
+	if len(errors) > 0 {
+		return AttendanceCorrectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceCorrectionMultiError is an error wrapping multiple validation
+// errors returned by AttendanceCorrection.ValidateAll() if the designated
+// constraints aren't met.
+type AttendanceCorrectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceCorrectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceCorrectionMultiError) AllErrors() []error { return m }
+
+// AttendanceCorrectionValidationError is the validation error returned by
+// AttendanceCorrection.Validate if the designated constraints aren't met.
+type AttendanceCorrectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceCorrectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceCorrectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceCorrectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceCorrectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceCorrectionValidationError) ErrorName() string {
+	return "AttendanceCorrectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceCorrectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceCorrection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceCorrectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceCorrectionValidationError{}
+
+// Validate checks the field values on MarkAttendanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkAttendanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkAttendanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkAttendanceResponseMultiError, or nil if none found.
+func (m *MarkAttendanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkAttendanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MarkAttendanceResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MarkAttendanceResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MarkAttendanceResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MarkAttendanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkAttendanceResponseMultiError is an error wrapping multiple validation
+// errors returned by MarkAttendanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MarkAttendanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkAttendanceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkAttendanceResponseMultiError) AllErrors() []error { return m }
+
+// MarkAttendanceResponseValidationError is the validation error returned by
+// MarkAttendanceResponse.Validate if the designated constraints aren't met.
+type MarkAttendanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkAttendanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkAttendanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkAttendanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkAttendanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkAttendanceResponseValidationError) ErrorName() string {
+	return "MarkAttendanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkAttendanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkAttendanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkAttendanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkAttendanceResponseValidationError{}
+
+// Validate checks the field values on StudentAttendance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StudentAttendance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentAttendance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StudentAttendanceMultiError, or nil if none found.
+func (m *StudentAttendance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentAttendance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := StudentAttendanceValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_StudentAttendance_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := StudentAttendanceValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _StudentAttendance_Status_InLookup[m.GetStatus()]; !ok {
+		err := StudentAttendanceValidationError{
+			field:  "Status",
+			reason: "value must be in list [present absent late excused]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 500 {
+		err := StudentAttendanceValidationError{
+			field:  "Note",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StudentAttendanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentAttendanceMultiError is an error wrapping multiple validation errors
+// returned by StudentAttendance.ValidateAll() if the designated constraints
+// aren't met.
+type StudentAttendanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentAttendanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentAttendanceMultiError) AllErrors() []error { return m }
+
+// StudentAttendanceValidationError is the validation error returned by
+// StudentAttendance.Validate if the designated constraints aren't met.
+type StudentAttendanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentAttendanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentAttendanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentAttendanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentAttendanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentAttendanceValidationError) ErrorName() string {
+	return "StudentAttendanceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StudentAttendanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentAttendance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentAttendanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentAttendanceValidationError{}
+
+var _StudentAttendance_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _StudentAttendance_Status_InLookup = map[string]struct{}{
+	"present": {},
+	"absent":  {},
+	"late":    {},
+	"excused": {},
+}
+
+// Validate checks the field values on ClassAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClassAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassAttendanceRequestMultiError, or nil if none found.
+func (m *ClassAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTeacherId()) != 24 {
+		err := ClassAttendanceRequestValidationError{
+			field:  "TeacherId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ClassAttendanceRequest_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+		err := ClassAttendanceRequestValidationError{
+			field:  "TeacherId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClassAttendanceRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := ClassAttendanceRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPeriod(); val < 0 || val > 12 {
+		err := ClassAttendanceRequestValidationError{
+			field:  "Period",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ClassAttendanceRequest_DefaultStatus_InLookup[m.GetDefaultStatus()]; !ok {
+		err := ClassAttendanceRequestValidationError{
+			field:  "DefaultStatus",
+			reason: "value must be in list [ present absent late excused]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetExceptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClassAttendanceRequestValidationError{
+						field:  fmt.Sprintf("Exceptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClassAttendanceRequestValidationError{
+						field:  fmt.Sprintf("Exceptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClassAttendanceRequestValidationError{
+					field:  fmt.Sprintf("Exceptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassAttendanceRequestMultiError is an error wrapping multiple validation
+// errors returned by ClassAttendanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClassAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassAttendanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// ClassAttendanceRequestValidationError is the validation error returned by
+// ClassAttendanceRequest.Validate if the designated constraints aren't met.
+type ClassAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassAttendanceRequestValidationError) ErrorName() string {
+	return "ClassAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClassAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassAttendanceRequestValidationError{}
+
+var _ClassAttendanceRequest_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ClassAttendanceRequest_Date_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _ClassAttendanceRequest_DefaultStatus_InLookup = map[string]struct{}{
+	"":        {},
+	"present": {},
+	"absent":  {},
+	"late":    {},
+	"excused": {},
+}
+
+// Validate checks the field values on AttendanceCorrectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceCorrectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceCorrectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceCorrectionRequestMultiError, or nil if none found.
+func (m *AttendanceCorrectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceCorrectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := AttendanceCorrectionRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_AttendanceCorrectionRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := AttendanceCorrectionRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AttendanceCorrectionRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AttendanceCorrectionRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [present absent late excused]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := AttendanceCorrectionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttendanceCorrectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceCorrectionRequestMultiError is an error wrapping multiple
+// validation errors returned by AttendanceCorrectionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AttendanceCorrectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceCorrectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceCorrectionRequestMultiError) AllErrors() []error { return m }
+
+// AttendanceCorrectionRequestValidationError is the validation error returned
+// by AttendanceCorrectionRequest.Validate if the designated constraints
+// aren't met.
+type AttendanceCorrectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceCorrectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceCorrectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceCorrectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceCorrectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceCorrectionRequestValidationError) ErrorName() string {
+	return "AttendanceCorrectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceCorrectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceCorrectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceCorrectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceCorrectionRequestValidationError{}
+
+var _AttendanceCorrectionRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _AttendanceCorrectionRequest_Status_InLookup = map[string]struct{}{
+	"present": {},
+	"absent":  {},
+	"late":    {},
+	"excused": {},
+}
+
+// Validate checks the field values on GetAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAttendanceRequestMultiError, or nil if none found.
+func (m *GetAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStudentId() != "" {
+
+		if !_GetAttendanceRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+			err := GetAttendanceRequestValidationError{
+				field:  "StudentId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClassId() != "" {
+
+		if !_GetAttendanceRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := GetAttendanceRequestValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetFromDate() != "" {
+
+		if !_GetAttendanceRequest_FromDate_Pattern.MatchString(m.GetFromDate()) {
+			err := GetAttendanceRequestValidationError{
+				field:  "FromDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetToDate() != "" {
+
+		if !_GetAttendanceRequest_ToDate_Pattern.MatchString(m.GetToDate()) {
+			err := GetAttendanceRequestValidationError{
+				field:  "ToDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _GetAttendanceRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := GetAttendanceRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ present absent late excused]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetAttendanceRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := GetAttendanceRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAttendanceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAttendanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAttendanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// GetAttendanceRequestValidationError is the validation error returned by
+// GetAttendanceRequest.Validate if the designated constraints aren't met.
+type GetAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAttendanceRequestValidationError) ErrorName() string {
+	return "GetAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAttendanceRequestValidationError{}
+
+var _GetAttendanceRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetAttendanceRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetAttendanceRequest_FromDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _GetAttendanceRequest_ToDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _GetAttendanceRequest_Status_InLookup = map[string]struct{}{
+	"":        {},
+	"present": {},
+	"absent":  {},
+	"late":    {},
+	"excused": {},
+}
+
+var _GetAttendanceRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on AttendanceSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceSummaryRequestMultiError, or nil if none found.
+func (m *AttendanceSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := AttendanceSummaryRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_AttendanceSummaryRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := AttendanceSummaryRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromDate() != "" {
+
+		if !_AttendanceSummaryRequest_FromDate_Pattern.MatchString(m.GetFromDate()) {
+			err := AttendanceSummaryRequestValidationError{
+				field:  "FromDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetToDate() != "" {
+
+		if !_AttendanceSummaryRequest_ToDate_Pattern.MatchString(m.GetToDate()) {
+			err := AttendanceSummaryRequestValidationError{
+				field:  "ToDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_AttendanceSummaryRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := AttendanceSummaryRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttendanceSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceSummaryRequestMultiError is an error wrapping multiple validation
+// errors returned by AttendanceSummaryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttendanceSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceSummaryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceSummaryRequestMultiError) AllErrors() []error { return m }
+
+// AttendanceSummaryRequestValidationError is the validation error returned by
+// AttendanceSummaryRequest.Validate if the designated constraints aren't met.
+type AttendanceSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceSummaryRequestValidationError) ErrorName() string {
+	return "AttendanceSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceSummaryRequestValidationError{}
+
+var _AttendanceSummaryRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _AttendanceSummaryRequest_FromDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _AttendanceSummaryRequest_ToDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _AttendanceSummaryRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on AttendanceSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceSummaryMultiError, or nil if none found.
+func (m *AttendanceSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StudentId
+
+	// no validation rules for Present
+
+	// no validation rules for Absent
+
+	// no validation rules for Late
+
+	// no validation rules for Excused
+
+	// no validation rules for Total
+
+	// no validation rules for AttendanceRate
+
+	if len(errors) > 0 {
+		return AttendanceSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceSummaryMultiError is an error wrapping multiple validation errors
+// returned by AttendanceSummary.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceSummaryMultiError) AllErrors() []error { return m }
+
+// AttendanceSummaryValidationError is the validation error returned by
+// AttendanceSummary.Validate if the designated constraints aren't met.
+type AttendanceSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceSummaryValidationError) ErrorName() string {
+	return "AttendanceSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceSummaryValidationError{}
+
+// Validate checks the field values on ClassAttendanceSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClassAttendanceSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassAttendanceSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassAttendanceSummaryMultiError, or nil if none found.
+func (m *ClassAttendanceSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassAttendanceSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClassId
+
+	if all {
+		switch v := interface{}(m.GetTotals()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClassAttendanceSummaryValidationError{
+					field:  "Totals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClassAttendanceSummaryValidationError{
+					field:  "Totals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClassAttendanceSummaryValidationError{
+				field:  "Totals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetStudents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClassAttendanceSummaryValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClassAttendanceSummaryValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClassAttendanceSummaryValidationError{
+					field:  fmt.Sprintf("Students[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassAttendanceSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassAttendanceSummaryMultiError is an error wrapping multiple validation
+// errors returned by ClassAttendanceSummary.ValidateAll() if the designated
+// constraints aren't met.
+type ClassAttendanceSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassAttendanceSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassAttendanceSummaryMultiError) AllErrors() []error { return m }
+
+// ClassAttendanceSummaryValidationError is the validation error returned by
+// ClassAttendanceSummary.Validate if the designated constraints aren't met.
+type ClassAttendanceSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassAttendanceSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassAttendanceSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassAttendanceSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassAttendanceSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassAttendanceSummaryValidationError) ErrorName() string {
+	return "ClassAttendanceSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClassAttendanceSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassAttendanceSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassAttendanceSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassAttendanceSummaryValidationError{}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttendanceService_MarkAttendance_FullMethodName              = "/main.AttendanceService/MarkAttendance"
+	AttendanceService_MarkClassAttendance_FullMethodName         = "/main.AttendanceService/MarkClassAttendance"
+	AttendanceService_CorrectAttendance_FullMethodName           = "/main.AttendanceService/CorrectAttendance"
+	AttendanceService_GetAttendance_FullMethodName               = "/main.AttendanceService/GetAttendance"
+	AttendanceService_GetStudentAttendanceSummary_FullMethodName = "/main.AttendanceService/GetStudentAttendanceSummary"
+	AttendanceService_GetClassAttendanceSummary_FullMethodName   = "/main.AttendanceService/GetClassAttendanceSummary"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttendanceServiceClient interface {
+	MarkAttendance(ctx context.Context, in *AttendanceRecords, opts ...grpc.CallOption) (*MarkAttendanceResponse, error)
+	MarkClassAttendance(ctx context.Context, in *ClassAttendanceRequest, opts ...grpc.CallOption) (*MarkAttendanceResponse, error)
+	CorrectAttendance(ctx context.Context, in *AttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceRecord, error)
+	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error)
+	GetStudentAttendanceSummary(ctx context.Context, in *AttendanceSummaryRequest, opts ...grpc.CallOption) (*AttendanceSummary, error)
+	GetClassAttendanceSummary(ctx context.Context, in *AttendanceSummaryRequest, opts ...grpc.CallOption) (*ClassAttendanceSummary, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) MarkAttendance(ctx context.Context, in *AttendanceRecords, opts ...grpc.CallOption) (*MarkAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_MarkAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) MarkClassAttendance(ctx context.Context, in *ClassAttendanceRequest, opts ...grpc.CallOption) (*MarkAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_MarkClassAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) CorrectAttendance(ctx context.Context, in *AttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecord)
+	err := c.cc.Invoke(ctx, AttendanceService_CorrectAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecords)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetStudentAttendanceSummary(ctx context.Context, in *AttendanceSummaryRequest, opts ...grpc.CallOption) (*AttendanceSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceSummary)
+	err := c.cc.Invoke(ctx, AttendanceService_GetStudentAttendanceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetClassAttendanceSummary(ctx context.Context, in *AttendanceSummaryRequest, opts ...grpc.CallOption) (*ClassAttendanceSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassAttendanceSummary)
+	err := c.cc.Invoke(ctx, AttendanceService_GetClassAttendanceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+type AttendanceServiceServer interface {
+	MarkAttendance(context.Context, *AttendanceRecords) (*MarkAttendanceResponse, error)
+	MarkClassAttendance(context.Context, *ClassAttendanceRequest) (*MarkAttendanceResponse, error)
+	CorrectAttendance(context.Context, *AttendanceCorrectionRequest) (*AttendanceRecord, error)
+	GetAttendance(context.Context, *GetAttendanceRequest) (*AttendanceRecords, error)
+	GetStudentAttendanceSummary(context.Context, *AttendanceSummaryRequest) (*AttendanceSummary, error)
+	GetClassAttendanceSummary(context.Context, *AttendanceSummaryRequest) (*ClassAttendanceSummary, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) MarkAttendance(context.Context, *AttendanceRecords) (*MarkAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) MarkClassAttendance(context.Context, *ClassAttendanceRequest) (*MarkAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkClassAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) CorrectAttendance(context.Context, *AttendanceCorrectionRequest) (*AttendanceRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*AttendanceRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetStudentAttendanceSummary(context.Context, *AttendanceSummaryRequest) (*AttendanceSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentAttendanceSummary not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetClassAttendanceSummary(context.Context, *AttendanceSummaryRequest) (*ClassAttendanceSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassAttendanceSummary not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_MarkAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).MarkAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_MarkAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).MarkAttendance(ctx, req.(*AttendanceRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_MarkClassAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).MarkClassAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_MarkClassAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).MarkClassAttendance(ctx, req.(*ClassAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_CorrectAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CorrectAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CorrectAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CorrectAttendance(ctx, req.(*AttendanceCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetStudentAttendanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetStudentAttendanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetStudentAttendanceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetStudentAttendanceSummary(ctx, req.(*AttendanceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetClassAttendanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetClassAttendanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetClassAttendanceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetClassAttendanceSummary(ctx, req.(*AttendanceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarkAttendance",
+			Handler:    _AttendanceService_MarkAttendance_Handler,
+		},
+		{
+			MethodName: "MarkClassAttendance",
+			Handler:    _AttendanceService_MarkClassAttendance_Handler,
+		},
+		{
+			MethodName: "CorrectAttendance",
+			Handler:    _AttendanceService_CorrectAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendance",
+			Handler:    _AttendanceService_GetAttendance_Handler,
+		},
+		{
+			MethodName: "GetStudentAttendanceSummary",
+			Handler:    _AttendanceService_GetStudentAttendanceSummary_Handler,
+		},
+		{
+			MethodName: "GetClassAttendanceSummary",
+			Handler:    _AttendanceService_GetClassAttendanceSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "attendance.proto",
+}
@@ -0,0 +1,1175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: calendar.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// dates are YYYY-MM-DD and inclusive
+type AcademicYear struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,6,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicYear) Reset() {
+	*x = AcademicYear{}
+	mi := &file_calendar_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYear) ProtoMessage() {}
+
+func (x *AcademicYear) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYear.ProtoReflect.Descriptor instead.
+func (*AcademicYear) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcademicYear) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type AcademicYears struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYears []*AcademicYear        `protobuf:"bytes,1,rep,name=academic_years,json=academicYears,proto3" json:"academic_years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicYears) Reset() {
+	*x = AcademicYears{}
+	mi := &file_calendar_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYears) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYears) ProtoMessage() {}
+
+func (x *AcademicYears) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYears.ProtoReflect.Descriptor instead.
+func (*AcademicYears) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcademicYears) GetAcademicYears() []*AcademicYear {
+	if x != nil {
+		return x.AcademicYears
+	}
+	return nil
+}
+
+type AcademicYearIds struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYearIds []string               `protobuf:"bytes,1,rep,name=academicYearIds,proto3" json:"academicYearIds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcademicYearIds) Reset() {
+	*x = AcademicYearIds{}
+	mi := &file_calendar_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYearIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYearIds) ProtoMessage() {}
+
+func (x *AcademicYearIds) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYearIds.ProtoReflect.Descriptor instead.
+func (*AcademicYearIds) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AcademicYearIds) GetAcademicYearIds() []string {
+	if x != nil {
+		return x.AcademicYearIds
+	}
+	return nil
+}
+
+type DeleteAcademicYearsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcademicYearsConfirm) Reset() {
+	*x = DeleteAcademicYearsConfirm{}
+	mi := &file_calendar_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcademicYearsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcademicYearsConfirm) ProtoMessage() {}
+
+func (x *DeleteAcademicYearsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAcademicYearsConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteAcademicYearsConfirm) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteAcademicYearsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAcademicYearsConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetAcademicYearRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYear   *AcademicYear          `protobuf:"bytes,1,opt,name=academic_year,json=academicYear,proto3" json:"academic_year,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAcademicYearRequest) Reset() {
+	*x = GetAcademicYearRequest{}
+	mi := &file_calendar_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcademicYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcademicYearRequest) ProtoMessage() {}
+
+func (x *GetAcademicYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcademicYearRequest.ProtoReflect.Descriptor instead.
+func (*GetAcademicYearRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAcademicYearRequest) GetAcademicYear() *AcademicYear {
+	if x != nil {
+		return x.AcademicYear
+	}
+	return nil
+}
+
+func (x *GetAcademicYearRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetAcademicYearRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetAcademicYearRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAcademicYearRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// the term name is unique, courses, attendance, grades and class memberships refer to a term by its name
+type Term struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AcademicYearId string                 `protobuf:"bytes,2,opt,name=academic_year_id,json=academicYearId,proto3" json:"academic_year_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate      string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	DeletedAt      string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy      string                 `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	ClosedAt       string                 `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"` // set by the year-end rollover, a closed term takes no new courses or enrollments
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	mi := &file_calendar_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Term) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Term) GetAcademicYearId() string {
+	if x != nil {
+		return x.AcademicYearId
+	}
+	return ""
+}
+
+func (x *Term) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Term) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Term) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Term) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Term) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Term) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+type Terms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Terms) Reset() {
+	*x = Terms{}
+	mi := &file_calendar_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Terms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terms) ProtoMessage() {}
+
+func (x *Terms) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terms.ProtoReflect.Descriptor instead.
+func (*Terms) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Terms) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type TermIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TermIds       []string               `protobuf:"bytes,1,rep,name=termIds,proto3" json:"termIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermIds) Reset() {
+	*x = TermIds{}
+	mi := &file_calendar_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermIds) ProtoMessage() {}
+
+func (x *TermIds) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermIds.ProtoReflect.Descriptor instead.
+func (*TermIds) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TermIds) GetTermIds() []string {
+	if x != nil {
+		return x.TermIds
+	}
+	return nil
+}
+
+type DeleteTermsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTermsConfirm) Reset() {
+	*x = DeleteTermsConfirm{}
+	mi := &file_calendar_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTermsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTermsConfirm) ProtoMessage() {}
+
+func (x *DeleteTermsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTermsConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteTermsConfirm) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTermsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteTermsConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetTermRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Term           *Term                  `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTermRequest) Reset() {
+	*x = GetTermRequest{}
+	mi := &file_calendar_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermRequest) ProtoMessage() {}
+
+func (x *GetTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTermRequest.ProtoReflect.Descriptor instead.
+func (*GetTermRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTermRequest) GetTerm() *Term {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+func (x *GetTermRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetTermRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetTermRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTermRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type Holiday struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AcademicYearId string                 `protobuf:"bytes,2,opt,name=academic_year_id,json=academicYearId,proto3" json:"academic_year_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate      string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	DeletedAt      string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy      string                 `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_calendar_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Holiday) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Holiday) GetAcademicYearId() string {
+	if x != nil {
+		return x.AcademicYearId
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Holiday) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Holiday) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Holiday) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type Holidays struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holidays) Reset() {
+	*x = Holidays{}
+	mi := &file_calendar_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holidays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holidays) ProtoMessage() {}
+
+func (x *Holidays) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holidays.ProtoReflect.Descriptor instead.
+func (*Holidays) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Holidays) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type HolidayIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HolidayIds    []string               `protobuf:"bytes,1,rep,name=holidayIds,proto3" json:"holidayIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HolidayIds) Reset() {
+	*x = HolidayIds{}
+	mi := &file_calendar_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HolidayIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolidayIds) ProtoMessage() {}
+
+func (x *HolidayIds) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolidayIds.ProtoReflect.Descriptor instead.
+func (*HolidayIds) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HolidayIds) GetHolidayIds() []string {
+	if x != nil {
+		return x.HolidayIds
+	}
+	return nil
+}
+
+type DeleteHolidaysConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHolidaysConfirm) Reset() {
+	*x = DeleteHolidaysConfirm{}
+	mi := &file_calendar_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHolidaysConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHolidaysConfirm) ProtoMessage() {}
+
+func (x *DeleteHolidaysConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHolidaysConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteHolidaysConfirm) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteHolidaysConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteHolidaysConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetHolidayRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYearId string                 `protobuf:"bytes,1,opt,name=academic_year_id,json=academicYearId,proto3" json:"academic_year_id,omitempty"`
+	FromDate       string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate         string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetHolidayRequest) Reset() {
+	*x = GetHolidayRequest{}
+	mi := &file_calendar_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidayRequest) ProtoMessage() {}
+
+func (x *GetHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidayRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetHolidayRequest) GetAcademicYearId() string {
+	if x != nil {
+		return x.AcademicYearId
+	}
+	return ""
+}
+
+func (x *GetHolidayRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetHolidayRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+// the current term is the latest term that started on or before date (today when empty),
+// so between two terms the term that just ended stays current
+type CurrentTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrentTermRequest) Reset() {
+	*x = CurrentTermRequest{}
+	mi := &file_calendar_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrentTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentTermRequest) ProtoMessage() {}
+
+func (x *CurrentTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentTermRequest.ProtoReflect.Descriptor instead.
+func (*CurrentTermRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CurrentTermRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+var File_calendar_proto protoreflect.FileDescriptor
+
+const file_calendar_proto_rawDesc = "" +
+	"\n" +
+	"\x0ecalendar.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"\x95\x02\n" +
+	"\fAcademicYear\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04name\x18\x02 \x01(\tB\x19\xfaB\x16r\x14\x18 2\x10^[A-Za-z0-9 -]*$R\x04name\x12E\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\tstartDate\x12A\n" +
+	"\bend_date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\aendDate\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x06 \x01(\tR\tdeletedBy\"J\n" +
+	"\rAcademicYears\x129\n" +
+	"\x0eacademic_years\x18\x01 \x03(\v2\x12.main.AcademicYearR\racademicYears\"E\n" +
+	"\x0fAcademicYearIds\x122\n" +
+	"\x0facademicYearIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\x0facademicYearIds\"U\n" +
+	"\x1aDeleteAcademicYearsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xdc\x01\n" +
+	"\x16GetAcademicYearRequest\x127\n" +
+	"\racademic_year\x18\x01 \x01(\v2\x12.main.AcademicYearR\facademicYear\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\xf1\x02\n" +
+	"\x04Term\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12E\n" +
+	"\x10academic_year_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\x0eacademicYearId\x12-\n" +
+	"\x04name\x18\x03 \x01(\tB\x19\xfaB\x16r\x14\x18 2\x10^[A-Za-z0-9 -]*$R\x04name\x12E\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\tstartDate\x12A\n" +
+	"\bend_date\x18\x05 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\aendDate\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\a \x01(\tR\tdeletedBy\x12\x1b\n" +
+	"\tclosed_at\x18\b \x01(\tR\bclosedAt\")\n" +
+	"\x05Terms\x12 \n" +
+	"\x05terms\x18\x01 \x03(\v2\n" +
+	".main.TermR\x05terms\"-\n" +
+	"\aTermIds\x12\"\n" +
+	"\atermIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\atermIds\"M\n" +
+	"\x12DeleteTermsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xbb\x01\n" +
+	"\x0eGetTermRequest\x12\x1e\n" +
+	"\x04term\x18\x01 \x01(\v2\n" +
+	".main.TermR\x04term\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\xd8\x02\n" +
+	"\aHoliday\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12E\n" +
+	"\x10academic_year_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\x0eacademicYearId\x12.\n" +
+	"\x04name\x18\x03 \x01(\tB\x1a\xfaB\x17r\x15\x18@2\x11^[A-Za-z0-9 '-]*$R\x04name\x12E\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\tstartDate\x12A\n" +
+	"\bend_date\x18\x05 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\aendDate\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\a \x01(\tR\tdeletedBy\"5\n" +
+	"\bHolidays\x12)\n" +
+	"\bholidays\x18\x01 \x03(\v2\r.main.HolidayR\bholidays\"6\n" +
+	"\n" +
+	"HolidayIds\x12(\n" +
+	"\n" +
+	"holidayIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
+	"holidayIds\"P\n" +
+	"\x15DeleteHolidaysConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xe0\x01\n" +
+	"\x11GetHolidayRequest\x12E\n" +
+	"\x10academic_year_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\x0eacademicYearId\x12C\n" +
+	"\tfrom_date\x18\x02 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\bfromDate\x12?\n" +
+	"\ato_date\x18\x03 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x06toDate\"P\n" +
+	"\x12CurrentTermRequest\x12:\n" +
+	"\x04date\x18\x01 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x04date2\xc5\x05\n" +
+	"\x17AcademicCalendarService\x12E\n" +
+	"\x10GetAcademicYears\x12\x1c.main.GetAcademicYearRequest\x1a\x13.main.AcademicYears\x12<\n" +
+	"\x10AddAcademicYears\x12\x13.main.AcademicYears\x1a\x13.main.AcademicYears\x12?\n" +
+	"\x13UpdateAcademicYears\x12\x13.main.AcademicYears\x1a\x13.main.AcademicYears\x12N\n" +
+	"\x13DeleteAcademicYears\x12\x15.main.AcademicYearIds\x1a .main.DeleteAcademicYearsConfirm\x12-\n" +
+	"\bGetTerms\x12\x14.main.GetTermRequest\x1a\v.main.Terms\x12$\n" +
+	"\bAddTerms\x12\v.main.Terms\x1a\v.main.Terms\x12'\n" +
+	"\vUpdateTerms\x12\v.main.Terms\x1a\v.main.Terms\x126\n" +
+	"\vDeleteTerms\x12\r.main.TermIds\x1a\x18.main.DeleteTermsConfirm\x126\n" +
+	"\vGetHolidays\x12\x17.main.GetHolidayRequest\x1a\x0e.main.Holidays\x12-\n" +
+	"\vAddHolidays\x12\x0e.main.Holidays\x1a\x0e.main.Holidays\x12?\n" +
+	"\x0eDeleteHolidays\x12\x10.main.HolidayIds\x1a\x1b.main.DeleteHolidaysConfirm\x126\n" +
+	"\x0eGetCurrentTerm\x12\x18.main.CurrentTermRequest\x1a\n" +
+	".main.TermB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_calendar_proto_rawDescOnce sync.Once
+	file_calendar_proto_rawDescData []byte
+)
+
+func file_calendar_proto_rawDescGZIP() []byte {
+	file_calendar_proto_rawDescOnce.Do(func() {
+		file_calendar_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_calendar_proto_rawDesc), len(file_calendar_proto_rawDesc)))
+	})
+	return file_calendar_proto_rawDescData
+}
+
+var file_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_calendar_proto_goTypes = []any{
+	(*AcademicYear)(nil),               // 0: main.AcademicYear
+	(*AcademicYears)(nil),              // 1: main.AcademicYears
+	(*AcademicYearIds)(nil),            // 2: main.AcademicYearIds
+	(*DeleteAcademicYearsConfirm)(nil), // 3: main.DeleteAcademicYearsConfirm
+	(*GetAcademicYearRequest)(nil),     // 4: main.GetAcademicYearRequest
+	(*Term)(nil),                       // 5: main.Term
+	(*Terms)(nil),                      // 6: main.Terms
+	(*TermIds)(nil),                    // 7: main.TermIds
+	(*DeleteTermsConfirm)(nil),         // 8: main.DeleteTermsConfirm
+	(*GetTermRequest)(nil),             // 9: main.GetTermRequest
+	(*Holiday)(nil),                    // 10: main.Holiday
+	(*Holidays)(nil),                   // 11: main.Holidays
+	(*HolidayIds)(nil),                 // 12: main.HolidayIds
+	(*DeleteHolidaysConfirm)(nil),      // 13: main.DeleteHolidaysConfirm
+	(*GetHolidayRequest)(nil),          // 14: main.GetHolidayRequest
+	(*CurrentTermRequest)(nil),         // 15: main.CurrentTermRequest
+	(*SortField)(nil),                  // 16: main.SortField
+}
+var file_calendar_proto_depIdxs = []int32{
+	0,  // 0: main.AcademicYears.academic_years:type_name -> main.AcademicYear
+	0,  // 1: main.GetAcademicYearRequest.academic_year:type_name -> main.AcademicYear
+	16, // 2: main.GetAcademicYearRequest.sort_by:type_name -> main.SortField
+	5,  // 3: main.Terms.terms:type_name -> main.Term
+	5,  // 4: main.GetTermRequest.term:type_name -> main.Term
+	16, // 5: main.GetTermRequest.sort_by:type_name -> main.SortField
+	10, // 6: main.Holidays.holidays:type_name -> main.Holiday
+	4,  // 7: main.AcademicCalendarService.GetAcademicYears:input_type -> main.GetAcademicYearRequest
+	1,  // 8: main.AcademicCalendarService.AddAcademicYears:input_type -> main.AcademicYears
+	1,  // 9: main.AcademicCalendarService.UpdateAcademicYears:input_type -> main.AcademicYears
+	2,  // 10: main.AcademicCalendarService.DeleteAcademicYears:input_type -> main.AcademicYearIds
+	9,  // 11: main.AcademicCalendarService.GetTerms:input_type -> main.GetTermRequest
+	6,  // 12: main.AcademicCalendarService.AddTerms:input_type -> main.Terms
+	6,  // 13: main.AcademicCalendarService.UpdateTerms:input_type -> main.Terms
+	7,  // 14: main.AcademicCalendarService.DeleteTerms:input_type -> main.TermIds
+	14, // 15: main.AcademicCalendarService.GetHolidays:input_type -> main.GetHolidayRequest
+	11, // 16: main.AcademicCalendarService.AddHolidays:input_type -> main.Holidays
+	12, // 17: main.AcademicCalendarService.DeleteHolidays:input_type -> main.HolidayIds
+	15, // 18: main.AcademicCalendarService.GetCurrentTerm:input_type -> main.CurrentTermRequest
+	1,  // 19: main.AcademicCalendarService.GetAcademicYears:output_type -> main.AcademicYears
+	1,  // 20: main.AcademicCalendarService.AddAcademicYears:output_type -> main.AcademicYears
+	1,  // 21: main.AcademicCalendarService.UpdateAcademicYears:output_type -> main.AcademicYears
+	3,  // 22: main.AcademicCalendarService.DeleteAcademicYears:output_type -> main.DeleteAcademicYearsConfirm
+	6,  // 23: main.AcademicCalendarService.GetTerms:output_type -> main.Terms
+	6,  // 24: main.AcademicCalendarService.AddTerms:output_type -> main.Terms
+	6,  // 25: main.AcademicCalendarService.UpdateTerms:output_type -> main.Terms
+	8,  // 26: main.AcademicCalendarService.DeleteTerms:output_type -> main.DeleteTermsConfirm
+	11, // 27: main.AcademicCalendarService.GetHolidays:output_type -> main.Holidays
+	11, // 28: main.AcademicCalendarService.AddHolidays:output_type -> main.Holidays
+	13, // 29: main.AcademicCalendarService.DeleteHolidays:output_type -> main.DeleteHolidaysConfirm
+	5,  // 30: main.AcademicCalendarService.GetCurrentTerm:output_type -> main.Term
+	19, // [19:31] is the sub-list for method output_type
+	7,  // [7:19] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_calendar_proto_init() }
+func file_calendar_proto_init() {
+	if File_calendar_proto != nil {
+		return
+	}
+	file_student_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calendar_proto_rawDesc), len(file_calendar_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_calendar_proto_goTypes,
+		DependencyIndexes: file_calendar_proto_depIdxs,
+		MessageInfos:      file_calendar_proto_msgTypes,
+	}.Build()
+	File_calendar_proto = out.File
+	file_calendar_proto_goTypes = nil
+	file_calendar_proto_depIdxs = nil
+}
@@ -0,0 +1,2212 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: calendar.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AcademicYear with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AcademicYear) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcademicYear with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AcademicYearMultiError, or
+// nil if none found.
+func (m *AcademicYear) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcademicYear) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) > 32 {
+		err := AcademicYearValidationError{
+			field:  "Name",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AcademicYear_Name_Pattern.MatchString(m.GetName()) {
+		err := AcademicYearValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDate() != "" {
+
+		if !_AcademicYear_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := AcademicYearValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_AcademicYear_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := AcademicYearValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return AcademicYearMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcademicYearMultiError is an error wrapping multiple validation errors
+// returned by AcademicYear.ValidateAll() if the designated constraints aren't met.
+type AcademicYearMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcademicYearMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcademicYearMultiError) AllErrors() []error { return m }
+
+// AcademicYearValidationError is the validation error returned by
+// AcademicYear.Validate if the designated constraints aren't met.
+type AcademicYearValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcademicYearValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcademicYearValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcademicYearValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcademicYearValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcademicYearValidationError) ErrorName() string { return "AcademicYearValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AcademicYearValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcademicYear.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcademicYearValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcademicYearValidationError{}
+
+var _AcademicYear_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _AcademicYear_StartDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _AcademicYear_EndDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on AcademicYears with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AcademicYears) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcademicYears with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AcademicYearsMultiError, or
+// nil if none found.
+func (m *AcademicYears) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcademicYears) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAcademicYears() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AcademicYearsValidationError{
+						field:  fmt.Sprintf("AcademicYears[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AcademicYearsValidationError{
+						field:  fmt.Sprintf("AcademicYears[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AcademicYearsValidationError{
+					field:  fmt.Sprintf("AcademicYears[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AcademicYearsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcademicYearsMultiError is an error wrapping multiple validation errors
+// returned by AcademicYears.ValidateAll() if the designated constraints
+// aren't met.
+type AcademicYearsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcademicYearsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcademicYearsMultiError) AllErrors() []error { return m }
+
+// AcademicYearsValidationError is the validation error returned by
+// AcademicYears.Validate if the designated constraints aren't met.
+type AcademicYearsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcademicYearsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcademicYearsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcademicYearsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcademicYearsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcademicYearsValidationError) ErrorName() string { return "AcademicYearsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AcademicYearsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcademicYears.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcademicYearsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcademicYearsValidationError{}
+
+// Validate checks the field values on AcademicYearIds with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AcademicYearIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcademicYearIds with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcademicYearIdsMultiError, or nil if none found.
+func (m *AcademicYearIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcademicYearIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAcademicYearIds()) < 1 {
+		err := AcademicYearIdsValidationError{
+			field:  "AcademicYearIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AcademicYearIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcademicYearIdsMultiError is an error wrapping multiple validation errors
+// returned by AcademicYearIds.ValidateAll() if the designated constraints
+// aren't met.
+type AcademicYearIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcademicYearIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcademicYearIdsMultiError) AllErrors() []error { return m }
+
+// AcademicYearIdsValidationError is the validation error returned by
+// AcademicYearIds.Validate if the designated constraints aren't met.
+type AcademicYearIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcademicYearIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcademicYearIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcademicYearIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcademicYearIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcademicYearIdsValidationError) ErrorName() string { return "AcademicYearIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AcademicYearIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcademicYearIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcademicYearIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcademicYearIdsValidationError{}
+
+// Validate checks the field values on DeleteAcademicYearsConfirm with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAcademicYearsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAcademicYearsConfirm with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAcademicYearsConfirmMultiError, or nil if none found.
+func (m *DeleteAcademicYearsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAcademicYearsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteAcademicYearsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAcademicYearsConfirmMultiError is an error wrapping multiple
+// validation errors returned by DeleteAcademicYearsConfirm.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteAcademicYearsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAcademicYearsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAcademicYearsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteAcademicYearsConfirmValidationError is the validation error returned
+// by DeleteAcademicYearsConfirm.Validate if the designated constraints aren't met.
+type DeleteAcademicYearsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAcademicYearsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAcademicYearsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAcademicYearsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAcademicYearsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAcademicYearsConfirmValidationError) ErrorName() string {
+	return "DeleteAcademicYearsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAcademicYearsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAcademicYearsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAcademicYearsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAcademicYearsConfirmValidationError{}
+
+// Validate checks the field values on GetAcademicYearRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAcademicYearRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAcademicYearRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAcademicYearRequestMultiError, or nil if none found.
+func (m *GetAcademicYearRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAcademicYearRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAcademicYear()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAcademicYearRequestValidationError{
+					field:  "AcademicYear",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAcademicYearRequestValidationError{
+					field:  "AcademicYear",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcademicYear()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAcademicYearRequestValidationError{
+				field:  "AcademicYear",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAcademicYearRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAcademicYearRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAcademicYearRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetAcademicYearRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAcademicYearRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAcademicYearRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAcademicYearRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAcademicYearRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAcademicYearRequestMultiError) AllErrors() []error { return m }
+
+// GetAcademicYearRequestValidationError is the validation error returned by
+// GetAcademicYearRequest.Validate if the designated constraints aren't met.
+type GetAcademicYearRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAcademicYearRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAcademicYearRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAcademicYearRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAcademicYearRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAcademicYearRequestValidationError) ErrorName() string {
+	return "GetAcademicYearRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAcademicYearRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAcademicYearRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAcademicYearRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAcademicYearRequestValidationError{}
+
+// Validate checks the field values on Term with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Term) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Term with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TermMultiError, or nil if none found.
+func (m *Term) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Term) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetAcademicYearId() != "" {
+
+		if !_Term_AcademicYearId_Pattern.MatchString(m.GetAcademicYearId()) {
+			err := TermValidationError{
+				field:  "AcademicYearId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 32 {
+		err := TermValidationError{
+			field:  "Name",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Term_Name_Pattern.MatchString(m.GetName()) {
+		err := TermValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDate() != "" {
+
+		if !_Term_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := TermValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_Term_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := TermValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	// no validation rules for ClosedAt
+
+	if len(errors) > 0 {
+		return TermMultiError(errors)
+	}
+
+	return nil
+}
+
+// TermMultiError is an error wrapping multiple validation errors returned by
+// Term.ValidateAll() if the designated constraints aren't met.
+type TermMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TermMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TermMultiError) AllErrors() []error { return m }
+
+// TermValidationError is the validation error returned by Term.Validate if the
+// designated constraints aren't met.
+type TermValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TermValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TermValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TermValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TermValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TermValidationError) ErrorName() string { return "TermValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TermValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TermValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TermValidationError{}
+
+var _Term_AcademicYearId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Term_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _Term_StartDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _Term_EndDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on Terms with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Terms) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Terms with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TermsMultiError, or nil if none found.
+func (m *Terms) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Terms) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTerms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TermsValidationError{
+						field:  fmt.Sprintf("Terms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TermsValidationError{
+						field:  fmt.Sprintf("Terms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TermsValidationError{
+					field:  fmt.Sprintf("Terms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TermsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TermsMultiError is an error wrapping multiple validation errors returned by
+// Terms.ValidateAll() if the designated constraints aren't met.
+type TermsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TermsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TermsMultiError) AllErrors() []error { return m }
+
+// TermsValidationError is the validation error returned by Terms.Validate if
+// the designated constraints aren't met.
+type TermsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TermsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TermsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TermsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TermsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TermsValidationError) ErrorName() string { return "TermsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TermsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerms.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TermsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TermsValidationError{}
+
+// Validate checks the field values on TermIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TermIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TermIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TermIdsMultiError, or nil if none found.
+func (m *TermIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TermIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTermIds()) < 1 {
+		err := TermIdsValidationError{
+			field:  "TermIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TermIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TermIdsMultiError is an error wrapping multiple validation errors returned
+// by TermIds.ValidateAll() if the designated constraints aren't met.
+type TermIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TermIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TermIdsMultiError) AllErrors() []error { return m }
+
+// TermIdsValidationError is the validation error returned by TermIds.Validate
+// if the designated constraints aren't met.
+type TermIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TermIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TermIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TermIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TermIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TermIdsValidationError) ErrorName() string { return "TermIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TermIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTermIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TermIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TermIdsValidationError{}
+
+// Validate checks the field values on DeleteTermsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTermsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTermsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTermsConfirmMultiError, or nil if none found.
+func (m *DeleteTermsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTermsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteTermsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTermsConfirmMultiError is an error wrapping multiple validation errors
+// returned by DeleteTermsConfirm.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTermsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTermsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTermsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteTermsConfirmValidationError is the validation error returned by
+// DeleteTermsConfirm.Validate if the designated constraints aren't met.
+type DeleteTermsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTermsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTermsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTermsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTermsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTermsConfirmValidationError) ErrorName() string {
+	return "DeleteTermsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTermsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTermsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTermsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTermsConfirmValidationError{}
+
+// Validate checks the field values on GetTermRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTermRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTermRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTermRequestMultiError,
+// or nil if none found.
+func (m *GetTermRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTermRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTerm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTermRequestValidationError{
+					field:  "Term",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTermRequestValidationError{
+					field:  "Term",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTerm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTermRequestValidationError{
+				field:  "Term",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTermRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTermRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTermRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetTermRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTermRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTermRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTermRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTermRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTermRequestMultiError) AllErrors() []error { return m }
+
+// GetTermRequestValidationError is the validation error returned by
+// GetTermRequest.Validate if the designated constraints aren't met.
+type GetTermRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTermRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTermRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTermRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTermRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTermRequestValidationError) ErrorName() string { return "GetTermRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTermRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTermRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTermRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTermRequestValidationError{}
+
+// Validate checks the field values on Holiday with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Holiday) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Holiday with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HolidayMultiError, or nil if none found.
+func (m *Holiday) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Holiday) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetAcademicYearId() != "" {
+
+		if !_Holiday_AcademicYearId_Pattern.MatchString(m.GetAcademicYearId()) {
+			err := HolidayValidationError{
+				field:  "AcademicYearId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := HolidayValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Holiday_Name_Pattern.MatchString(m.GetName()) {
+		err := HolidayValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 '-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDate() != "" {
+
+		if !_Holiday_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := HolidayValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_Holiday_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := HolidayValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return HolidayMultiError(errors)
+	}
+
+	return nil
+}
+
+// HolidayMultiError is an error wrapping multiple validation errors returned
+// by Holiday.ValidateAll() if the designated constraints aren't met.
+type HolidayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HolidayMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HolidayMultiError) AllErrors() []error { return m }
+
+// HolidayValidationError is the validation error returned by Holiday.Validate
+// if the designated constraints aren't met.
+type HolidayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HolidayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HolidayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HolidayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HolidayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HolidayValidationError) ErrorName() string { return "HolidayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HolidayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHoliday.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HolidayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HolidayValidationError{}
+
+var _Holiday_AcademicYearId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Holiday_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 '-]*$")
+
+var _Holiday_StartDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _Holiday_EndDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on Holidays with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Holidays) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Holidays with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HolidaysMultiError, or nil
+// if none found.
+func (m *Holidays) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Holidays) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHolidays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HolidaysValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HolidaysValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HolidaysValidationError{
+					field:  fmt.Sprintf("Holidays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HolidaysMultiError(errors)
+	}
+
+	return nil
+}
+
+// HolidaysMultiError is an error wrapping multiple validation errors returned
+// by Holidays.ValidateAll() if the designated constraints aren't met.
+type HolidaysMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HolidaysMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HolidaysMultiError) AllErrors() []error { return m }
+
+// HolidaysValidationError is the validation error returned by
+// Holidays.Validate if the designated constraints aren't met.
+type HolidaysValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HolidaysValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HolidaysValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HolidaysValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HolidaysValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HolidaysValidationError) ErrorName() string { return "HolidaysValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HolidaysValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHolidays.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HolidaysValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HolidaysValidationError{}
+
+// Validate checks the field values on HolidayIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HolidayIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HolidayIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HolidayIdsMultiError, or
+// nil if none found.
+func (m *HolidayIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HolidayIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetHolidayIds()) < 1 {
+		err := HolidayIdsValidationError{
+			field:  "HolidayIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HolidayIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// HolidayIdsMultiError is an error wrapping multiple validation errors
+// returned by HolidayIds.ValidateAll() if the designated constraints aren't met.
+type HolidayIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HolidayIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HolidayIdsMultiError) AllErrors() []error { return m }
+
+// HolidayIdsValidationError is the validation error returned by
+// HolidayIds.Validate if the designated constraints aren't met.
+type HolidayIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HolidayIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HolidayIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HolidayIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HolidayIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HolidayIdsValidationError) ErrorName() string { return "HolidayIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HolidayIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHolidayIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HolidayIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HolidayIdsValidationError{}
+
+// Validate checks the field values on DeleteHolidaysConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteHolidaysConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteHolidaysConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteHolidaysConfirmMultiError, or nil if none found.
+func (m *DeleteHolidaysConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteHolidaysConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteHolidaysConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteHolidaysConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteHolidaysConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteHolidaysConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteHolidaysConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteHolidaysConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteHolidaysConfirmValidationError is the validation error returned by
+// DeleteHolidaysConfirm.Validate if the designated constraints aren't met.
+type DeleteHolidaysConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteHolidaysConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteHolidaysConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteHolidaysConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteHolidaysConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteHolidaysConfirmValidationError) ErrorName() string {
+	return "DeleteHolidaysConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteHolidaysConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteHolidaysConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteHolidaysConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteHolidaysConfirmValidationError{}
+
+// Validate checks the field values on GetHolidayRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetHolidayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHolidayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHolidayRequestMultiError, or nil if none found.
+func (m *GetHolidayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHolidayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAcademicYearId() != "" {
+
+		if !_GetHolidayRequest_AcademicYearId_Pattern.MatchString(m.GetAcademicYearId()) {
+			err := GetHolidayRequestValidationError{
+				field:  "AcademicYearId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetFromDate() != "" {
+
+		if !_GetHolidayRequest_FromDate_Pattern.MatchString(m.GetFromDate()) {
+			err := GetHolidayRequestValidationError{
+				field:  "FromDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetToDate() != "" {
+
+		if !_GetHolidayRequest_ToDate_Pattern.MatchString(m.GetToDate()) {
+			err := GetHolidayRequestValidationError{
+				field:  "ToDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetHolidayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHolidayRequestMultiError is an error wrapping multiple validation errors
+// returned by GetHolidayRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetHolidayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHolidayRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHolidayRequestMultiError) AllErrors() []error { return m }
+
+// GetHolidayRequestValidationError is the validation error returned by
+// GetHolidayRequest.Validate if the designated constraints aren't met.
+type GetHolidayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHolidayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHolidayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHolidayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHolidayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHolidayRequestValidationError) ErrorName() string {
+	return "GetHolidayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHolidayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHolidayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHolidayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHolidayRequestValidationError{}
+
+var _GetHolidayRequest_AcademicYearId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetHolidayRequest_FromDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _GetHolidayRequest_ToDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on CurrentTermRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CurrentTermRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CurrentTermRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CurrentTermRequestMultiError, or nil if none found.
+func (m *CurrentTermRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CurrentTermRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDate() != "" {
+
+		if !_CurrentTermRequest_Date_Pattern.MatchString(m.GetDate()) {
+			err := CurrentTermRequestValidationError{
+				field:  "Date",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CurrentTermRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CurrentTermRequestMultiError is an error wrapping multiple validation errors
+// returned by CurrentTermRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CurrentTermRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CurrentTermRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CurrentTermRequestMultiError) AllErrors() []error { return m }
+
+// CurrentTermRequestValidationError is the validation error returned by
+// CurrentTermRequest.Validate if the designated constraints aren't met.
+type CurrentTermRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CurrentTermRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CurrentTermRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CurrentTermRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CurrentTermRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CurrentTermRequestValidationError) ErrorName() string {
+	return "CurrentTermRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CurrentTermRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCurrentTermRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CurrentTermRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CurrentTermRequestValidationError{}
+
+var _CurrentTermRequest_Date_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
@@ -0,0 +1,540 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: calendar.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AcademicCalendarService_GetAcademicYears_FullMethodName    = "/main.AcademicCalendarService/GetAcademicYears"
+	AcademicCalendarService_AddAcademicYears_FullMethodName    = "/main.AcademicCalendarService/AddAcademicYears"
+	AcademicCalendarService_UpdateAcademicYears_FullMethodName = "/main.AcademicCalendarService/UpdateAcademicYears"
+	AcademicCalendarService_DeleteAcademicYears_FullMethodName = "/main.AcademicCalendarService/DeleteAcademicYears"
+	AcademicCalendarService_GetTerms_FullMethodName            = "/main.AcademicCalendarService/GetTerms"
+	AcademicCalendarService_AddTerms_FullMethodName            = "/main.AcademicCalendarService/AddTerms"
+	AcademicCalendarService_UpdateTerms_FullMethodName         = "/main.AcademicCalendarService/UpdateTerms"
+	AcademicCalendarService_DeleteTerms_FullMethodName         = "/main.AcademicCalendarService/DeleteTerms"
+	AcademicCalendarService_GetHolidays_FullMethodName         = "/main.AcademicCalendarService/GetHolidays"
+	AcademicCalendarService_AddHolidays_FullMethodName         = "/main.AcademicCalendarService/AddHolidays"
+	AcademicCalendarService_DeleteHolidays_FullMethodName      = "/main.AcademicCalendarService/DeleteHolidays"
+	AcademicCalendarService_GetCurrentTerm_FullMethodName      = "/main.AcademicCalendarService/GetCurrentTerm"
+)
+
+// AcademicCalendarServiceClient is the client API for AcademicCalendarService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AcademicCalendarServiceClient interface {
+	GetAcademicYears(ctx context.Context, in *GetAcademicYearRequest, opts ...grpc.CallOption) (*AcademicYears, error)
+	AddAcademicYears(ctx context.Context, in *AcademicYears, opts ...grpc.CallOption) (*AcademicYears, error)
+	UpdateAcademicYears(ctx context.Context, in *AcademicYears, opts ...grpc.CallOption) (*AcademicYears, error)
+	DeleteAcademicYears(ctx context.Context, in *AcademicYearIds, opts ...grpc.CallOption) (*DeleteAcademicYearsConfirm, error)
+	GetTerms(ctx context.Context, in *GetTermRequest, opts ...grpc.CallOption) (*Terms, error)
+	AddTerms(ctx context.Context, in *Terms, opts ...grpc.CallOption) (*Terms, error)
+	UpdateTerms(ctx context.Context, in *Terms, opts ...grpc.CallOption) (*Terms, error)
+	DeleteTerms(ctx context.Context, in *TermIds, opts ...grpc.CallOption) (*DeleteTermsConfirm, error)
+	GetHolidays(ctx context.Context, in *GetHolidayRequest, opts ...grpc.CallOption) (*Holidays, error)
+	AddHolidays(ctx context.Context, in *Holidays, opts ...grpc.CallOption) (*Holidays, error)
+	DeleteHolidays(ctx context.Context, in *HolidayIds, opts ...grpc.CallOption) (*DeleteHolidaysConfirm, error)
+	GetCurrentTerm(ctx context.Context, in *CurrentTermRequest, opts ...grpc.CallOption) (*Term, error)
+}
+
+type academicCalendarServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAcademicCalendarServiceClient(cc grpc.ClientConnInterface) AcademicCalendarServiceClient {
+	return &academicCalendarServiceClient{cc}
+}
+
+func (c *academicCalendarServiceClient) GetAcademicYears(ctx context.Context, in *GetAcademicYearRequest, opts ...grpc.CallOption) (*AcademicYears, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcademicYears)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_GetAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) AddAcademicYears(ctx context.Context, in *AcademicYears, opts ...grpc.CallOption) (*AcademicYears, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcademicYears)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_AddAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) UpdateAcademicYears(ctx context.Context, in *AcademicYears, opts ...grpc.CallOption) (*AcademicYears, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcademicYears)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_UpdateAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) DeleteAcademicYears(ctx context.Context, in *AcademicYearIds, opts ...grpc.CallOption) (*DeleteAcademicYearsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAcademicYearsConfirm)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_DeleteAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) GetTerms(ctx context.Context, in *GetTermRequest, opts ...grpc.CallOption) (*Terms, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Terms)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_GetTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) AddTerms(ctx context.Context, in *Terms, opts ...grpc.CallOption) (*Terms, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Terms)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_AddTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) UpdateTerms(ctx context.Context, in *Terms, opts ...grpc.CallOption) (*Terms, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Terms)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_UpdateTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) DeleteTerms(ctx context.Context, in *TermIds, opts ...grpc.CallOption) (*DeleteTermsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTermsConfirm)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_DeleteTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) GetHolidays(ctx context.Context, in *GetHolidayRequest, opts ...grpc.CallOption) (*Holidays, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holidays)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_GetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) AddHolidays(ctx context.Context, in *Holidays, opts ...grpc.CallOption) (*Holidays, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holidays)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_AddHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) DeleteHolidays(ctx context.Context, in *HolidayIds, opts ...grpc.CallOption) (*DeleteHolidaysConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHolidaysConfirm)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_DeleteHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicCalendarServiceClient) GetCurrentTerm(ctx context.Context, in *CurrentTermRequest, opts ...grpc.CallOption) (*Term, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Term)
+	err := c.cc.Invoke(ctx, AcademicCalendarService_GetCurrentTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AcademicCalendarServiceServer is the server API for AcademicCalendarService service.
+// All implementations must embed UnimplementedAcademicCalendarServiceServer
+// for forward compatibility.
+type AcademicCalendarServiceServer interface {
+	GetAcademicYears(context.Context, *GetAcademicYearRequest) (*AcademicYears, error)
+	AddAcademicYears(context.Context, *AcademicYears) (*AcademicYears, error)
+	UpdateAcademicYears(context.Context, *AcademicYears) (*AcademicYears, error)
+	DeleteAcademicYears(context.Context, *AcademicYearIds) (*DeleteAcademicYearsConfirm, error)
+	GetTerms(context.Context, *GetTermRequest) (*Terms, error)
+	AddTerms(context.Context, *Terms) (*Terms, error)
+	UpdateTerms(context.Context, *Terms) (*Terms, error)
+	DeleteTerms(context.Context, *TermIds) (*DeleteTermsConfirm, error)
+	GetHolidays(context.Context, *GetHolidayRequest) (*Holidays, error)
+	AddHolidays(context.Context, *Holidays) (*Holidays, error)
+	DeleteHolidays(context.Context, *HolidayIds) (*DeleteHolidaysConfirm, error)
+	GetCurrentTerm(context.Context, *CurrentTermRequest) (*Term, error)
+	mustEmbedUnimplementedAcademicCalendarServiceServer()
+}
+
+// UnimplementedAcademicCalendarServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAcademicCalendarServiceServer struct{}
+
+func (UnimplementedAcademicCalendarServiceServer) GetAcademicYears(context.Context, *GetAcademicYearRequest) (*AcademicYears, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAcademicYears not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) AddAcademicYears(context.Context, *AcademicYears) (*AcademicYears, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAcademicYears not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) UpdateAcademicYears(context.Context, *AcademicYears) (*AcademicYears, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAcademicYears not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) DeleteAcademicYears(context.Context, *AcademicYearIds) (*DeleteAcademicYearsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAcademicYears not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) GetTerms(context.Context, *GetTermRequest) (*Terms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerms not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) AddTerms(context.Context, *Terms) (*Terms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTerms not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) UpdateTerms(context.Context, *Terms) (*Terms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTerms not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) DeleteTerms(context.Context, *TermIds) (*DeleteTermsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTerms not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) GetHolidays(context.Context, *GetHolidayRequest) (*Holidays, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHolidays not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) AddHolidays(context.Context, *Holidays) (*Holidays, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHolidays not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) DeleteHolidays(context.Context, *HolidayIds) (*DeleteHolidaysConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHolidays not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) GetCurrentTerm(context.Context, *CurrentTermRequest) (*Term, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentTerm not implemented")
+}
+func (UnimplementedAcademicCalendarServiceServer) mustEmbedUnimplementedAcademicCalendarServiceServer() {
+}
+func (UnimplementedAcademicCalendarServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAcademicCalendarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AcademicCalendarServiceServer will
+// result in compilation errors.
+type UnsafeAcademicCalendarServiceServer interface {
+	mustEmbedUnimplementedAcademicCalendarServiceServer()
+}
+
+func RegisterAcademicCalendarServiceServer(s grpc.ServiceRegistrar, srv AcademicCalendarServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAcademicCalendarServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AcademicCalendarService_ServiceDesc, srv)
+}
+
+func _AcademicCalendarService_GetAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAcademicYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).GetAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_GetAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).GetAcademicYears(ctx, req.(*GetAcademicYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_AddAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcademicYears)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).AddAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_AddAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).AddAcademicYears(ctx, req.(*AcademicYears))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_UpdateAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcademicYears)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).UpdateAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_UpdateAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).UpdateAcademicYears(ctx, req.(*AcademicYears))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_DeleteAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcademicYearIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).DeleteAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_DeleteAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).DeleteAcademicYears(ctx, req.(*AcademicYearIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_GetTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).GetTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_GetTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).GetTerms(ctx, req.(*GetTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_AddTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Terms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).AddTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_AddTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).AddTerms(ctx, req.(*Terms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_UpdateTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Terms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).UpdateTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_UpdateTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).UpdateTerms(ctx, req.(*Terms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_DeleteTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).DeleteTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_DeleteTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).DeleteTerms(ctx, req.(*TermIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_GetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).GetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_GetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).GetHolidays(ctx, req.(*GetHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_AddHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Holidays)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).AddHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_AddHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).AddHolidays(ctx, req.(*Holidays))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_DeleteHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HolidayIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).DeleteHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_DeleteHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).DeleteHolidays(ctx, req.(*HolidayIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicCalendarService_GetCurrentTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrentTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicCalendarServiceServer).GetCurrentTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicCalendarService_GetCurrentTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicCalendarServiceServer).GetCurrentTerm(ctx, req.(*CurrentTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AcademicCalendarService_ServiceDesc is the grpc.ServiceDesc for AcademicCalendarService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AcademicCalendarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AcademicCalendarService",
+	HandlerType: (*AcademicCalendarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAcademicYears",
+			Handler:    _AcademicCalendarService_GetAcademicYears_Handler,
+		},
+		{
+			MethodName: "AddAcademicYears",
+			Handler:    _AcademicCalendarService_AddAcademicYears_Handler,
+		},
+		{
+			MethodName: "UpdateAcademicYears",
+			Handler:    _AcademicCalendarService_UpdateAcademicYears_Handler,
+		},
+		{
+			MethodName: "DeleteAcademicYears",
+			Handler:    _AcademicCalendarService_DeleteAcademicYears_Handler,
+		},
+		{
+			MethodName: "GetTerms",
+			Handler:    _AcademicCalendarService_GetTerms_Handler,
+		},
+		{
+			MethodName: "AddTerms",
+			Handler:    _AcademicCalendarService_AddTerms_Handler,
+		},
+		{
+			MethodName: "UpdateTerms",
+			Handler:    _AcademicCalendarService_UpdateTerms_Handler,
+		},
+		{
+			MethodName: "DeleteTerms",
+			Handler:    _AcademicCalendarService_DeleteTerms_Handler,
+		},
+		{
+			MethodName: "GetHolidays",
+			Handler:    _AcademicCalendarService_GetHolidays_Handler,
+		},
+		{
+			MethodName: "AddHolidays",
+			Handler:    _AcademicCalendarService_AddHolidays_Handler,
+		},
+		{
+			MethodName: "DeleteHolidays",
+			Handler:    _AcademicCalendarService_DeleteHolidays_Handler,
+		},
+		{
+			MethodName: "GetCurrentTerm",
+			Handler:    _AcademicCalendarService_GetCurrentTerm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calendar.proto",
+}
@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: class.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteClassesConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassesConfirm) Reset() {
+	*x = DeleteClassesConfirm{}
+	mi := &file_class_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassesConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassesConfirm) ProtoMessage() {}
+
+func (x *DeleteClassesConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassesConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteClassesConfirm) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteClassesConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteClassesConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type ClassIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassIds      []string               `protobuf:"bytes,1,rep,name=classIds,proto3" json:"classIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassIds) Reset() {
+	*x = ClassIds{}
+	mi := &file_class_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassIds) ProtoMessage() {}
+
+func (x *ClassIds) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassIds.ProtoReflect.Descriptor instead.
+func (*ClassIds) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClassIds) GetClassIds() []string {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+type GetClassRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Class          *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetClassRequest) Reset() {
+	*x = GetClassRequest{}
+	mi := &file_class_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassRequest) ProtoMessage() {}
+
+func (x *GetClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassRequest.ProtoReflect.Descriptor instead.
+func (*GetClassRequest) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetClassRequest) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *GetClassRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetClassRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetClassRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetClassRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type Class struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GradeLevel        int32                  `protobuf:"varint,3,opt,name=grade_level,json=gradeLevel,proto3" json:"grade_level,omitempty"`
+	Section           string                 `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	HomeroomTeacherId string                 `protobuf:"bytes,5,opt,name=homeroom_teacher_id,json=homeroomTeacherId,proto3" json:"homeroom_teacher_id,omitempty"`
+	Room              string                 `protobuf:"bytes,6,opt,name=room,proto3" json:"room,omitempty"`
+	Capacity          int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	AcademicYear      string                 `protobuf:"bytes,8,opt,name=academic_year,json=academicYear,proto3" json:"academic_year,omitempty"`
+	DeletedAt         string                 `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy         string                 `protobuf:"bytes,10,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	mi := &file_class_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Class) GetGradeLevel() int32 {
+	if x != nil {
+		return x.GradeLevel
+	}
+	return 0
+}
+
+func (x *Class) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Class) GetHomeroomTeacherId() string {
+	if x != nil {
+		return x.HomeroomTeacherId
+	}
+	return ""
+}
+
+func (x *Class) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *Class) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Class) GetAcademicYear() string {
+	if x != nil {
+		return x.AcademicYear
+	}
+	return ""
+}
+
+func (x *Class) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Class) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type Classes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Classes) Reset() {
+	*x = Classes{}
+	mi := &file_class_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Classes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Classes) ProtoMessage() {}
+
+func (x *Classes) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Classes.ProtoReflect.Descriptor instead.
+func (*Classes) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Classes) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+// promotes the classes of from_academic_year into new classes of to_academic_year.
+// without rules a grade level moves up by one and final_grade_level (12 when 0) graduates.
+// held back students stay at their grade level and join the new class of the same grade and section.
+// close_term defaults to the current term, it and every earlier open term are closed.
+type RolloverRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FromAcademicYear   string                 `protobuf:"bytes,1,opt,name=from_academic_year,json=fromAcademicYear,proto3" json:"from_academic_year,omitempty"`
+	ToAcademicYear     string                 `protobuf:"bytes,2,opt,name=to_academic_year,json=toAcademicYear,proto3" json:"to_academic_year,omitempty"`
+	Rules              []*GradeRule           `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	FinalGradeLevel    int32                  `protobuf:"varint,4,opt,name=final_grade_level,json=finalGradeLevel,proto3" json:"final_grade_level,omitempty"`
+	HoldBackStudentIds []string               `protobuf:"bytes,5,rep,name=hold_back_student_ids,json=holdBackStudentIds,proto3" json:"hold_back_student_ids,omitempty"`
+	CloseTerm          string                 `protobuf:"bytes,6,opt,name=close_term,json=closeTerm,proto3" json:"close_term,omitempty"`
+	DryRun             bool                   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RolloverRequest) Reset() {
+	*x = RolloverRequest{}
+	mi := &file_class_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverRequest) ProtoMessage() {}
+
+func (x *RolloverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverRequest.ProtoReflect.Descriptor instead.
+func (*RolloverRequest) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RolloverRequest) GetFromAcademicYear() string {
+	if x != nil {
+		return x.FromAcademicYear
+	}
+	return ""
+}
+
+func (x *RolloverRequest) GetToAcademicYear() string {
+	if x != nil {
+		return x.ToAcademicYear
+	}
+	return ""
+}
+
+func (x *RolloverRequest) GetRules() []*GradeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RolloverRequest) GetFinalGradeLevel() int32 {
+	if x != nil {
+		return x.FinalGradeLevel
+	}
+	return 0
+}
+
+func (x *RolloverRequest) GetHoldBackStudentIds() []string {
+	if x != nil {
+		return x.HoldBackStudentIds
+	}
+	return nil
+}
+
+func (x *RolloverRequest) GetCloseTerm() string {
+	if x != nil {
+		return x.CloseTerm
+	}
+	return ""
+}
+
+func (x *RolloverRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// students of from_grade_level move to to_grade_level, or leave school when graduate is set
+type GradeRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromGradeLevel int32                  `protobuf:"varint,1,opt,name=from_grade_level,json=fromGradeLevel,proto3" json:"from_grade_level,omitempty"`
+	ToGradeLevel   int32                  `protobuf:"varint,2,opt,name=to_grade_level,json=toGradeLevel,proto3" json:"to_grade_level,omitempty"`
+	Graduate       bool                   `protobuf:"varint,3,opt,name=graduate,proto3" json:"graduate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GradeRule) Reset() {
+	*x = GradeRule{}
+	mi := &file_class_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeRule) ProtoMessage() {}
+
+func (x *GradeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeRule.ProtoReflect.Descriptor instead.
+func (*GradeRule) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GradeRule) GetFromGradeLevel() int32 {
+	if x != nil {
+		return x.FromGradeLevel
+	}
+	return 0
+}
+
+func (x *GradeRule) GetToGradeLevel() int32 {
+	if x != nil {
+		return x.ToGradeLevel
+	}
+	return 0
+}
+
+func (x *GradeRule) GetGraduate() bool {
+	if x != nil {
+		return x.Graduate
+	}
+	return false
+}
+
+// the changes of a rollover, with dry_run nothing was written and the new classes have no id yet
+type RolloverPlan struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DryRun               bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	ClosedTerms          []string               `protobuf:"bytes,2,rep,name=closed_terms,json=closedTerms,proto3" json:"closed_terms,omitempty"`
+	Classes              []*RolloverClass       `protobuf:"bytes,3,rep,name=classes,proto3" json:"classes,omitempty"`
+	Students             []*RolloverStudent     `protobuf:"bytes,4,rep,name=students,proto3" json:"students,omitempty"`
+	CompletedEnrollments int32                  `protobuf:"varint,5,opt,name=completed_enrollments,json=completedEnrollments,proto3" json:"completed_enrollments,omitempty"`
+	DroppedEnrollments   int32                  `protobuf:"varint,6,opt,name=dropped_enrollments,json=droppedEnrollments,proto3" json:"dropped_enrollments,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RolloverPlan) Reset() {
+	*x = RolloverPlan{}
+	mi := &file_class_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverPlan) ProtoMessage() {}
+
+func (x *RolloverPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverPlan.ProtoReflect.Descriptor instead.
+func (*RolloverPlan) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RolloverPlan) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RolloverPlan) GetClosedTerms() []string {
+	if x != nil {
+		return x.ClosedTerms
+	}
+	return nil
+}
+
+func (x *RolloverPlan) GetClasses() []*RolloverClass {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *RolloverPlan) GetStudents() []*RolloverStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *RolloverPlan) GetCompletedEnrollments() int32 {
+	if x != nil {
+		return x.CompletedEnrollments
+	}
+	return 0
+}
+
+func (x *RolloverPlan) GetDroppedEnrollments() int32 {
+	if x != nil {
+		return x.DroppedEnrollments
+	}
+	return 0
+}
+
+type RolloverClass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromClassId   string                 `protobuf:"bytes,1,opt,name=from_class_id,json=fromClassId,proto3" json:"from_class_id,omitempty"`
+	FromClass     string                 `protobuf:"bytes,2,opt,name=from_class,json=fromClass,proto3" json:"from_class,omitempty"`
+	Class         *Class                 `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"` // the new class
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverClass) Reset() {
+	*x = RolloverClass{}
+	mi := &file_class_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverClass) ProtoMessage() {}
+
+func (x *RolloverClass) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverClass.ProtoReflect.Descriptor instead.
+func (*RolloverClass) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RolloverClass) GetFromClassId() string {
+	if x != nil {
+		return x.FromClassId
+	}
+	return ""
+}
+
+func (x *RolloverClass) GetFromClass() string {
+	if x != nil {
+		return x.FromClass
+	}
+	return ""
+}
+
+func (x *RolloverClass) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+// action is promoted, held_back or graduated
+type RolloverStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	FromClass     string                 `protobuf:"bytes,2,opt,name=from_class,json=fromClass,proto3" json:"from_class,omitempty"`
+	ToClass       string                 `protobuf:"bytes,3,opt,name=to_class,json=toClass,proto3" json:"to_class,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverStudent) Reset() {
+	*x = RolloverStudent{}
+	mi := &file_class_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverStudent) ProtoMessage() {}
+
+func (x *RolloverStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_class_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverStudent.ProtoReflect.Descriptor instead.
+func (*RolloverStudent) Descriptor() ([]byte, []int) {
+	return file_class_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RolloverStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *RolloverStudent) GetFromClass() string {
+	if x != nil {
+		return x.FromClass
+	}
+	return ""
+}
+
+func (x *RolloverStudent) GetToClass() string {
+	if x != nil {
+		return x.ToClass
+	}
+	return ""
+}
+
+func (x *RolloverStudent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+var File_class_proto protoreflect.FileDescriptor
+
+const file_class_proto_rawDesc = "" +
+	"\n" +
+	"\vclass.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"O\n" +
+	"\x14DeleteClassesConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"0\n" +
+	"\bClassIds\x12$\n" +
+	"\bclassIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\bclassIds\"\xbf\x01\n" +
+	"\x0fGetClassRequest\x12!\n" +
+	"\x05class\x18\x01 \x01(\v2\v.main.ClassR\x05class\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\xc1\x03\n" +
+	"\x05Class\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x04name\x18\x02 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x04name\x12*\n" +
+	"\vgrade_level\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\n" +
+	"gradeLevel\x12/\n" +
+	"\asection\x18\x04 \x01(\tB\x15\xfaB\x12r\x102\x0e^[A-Za-z0-9]*$R\asection\x12K\n" +
+	"\x13homeroom_teacher_id\x18\x05 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\x11homeroomTeacherId\x12+\n" +
+	"\x04room\x18\x06 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04room\x12#\n" +
+	"\bcapacity\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bcapacity\x12B\n" +
+	"\racademic_year\x18\b \x01(\tB\x1d\xfaB\x1ar\x182\x16^([0-9]{4}-[0-9]{4})?$R\facademicYear\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\n" +
+	" \x01(\tR\tdeletedBy\"0\n" +
+	"\aClasses\x12%\n" +
+	"\aclasses\x18\x01 \x03(\v2\v.main.ClassR\aclasses\"\xa5\x03\n" +
+	"\x0fRolloverRequest\x12K\n" +
+	"\x12from_academic_year\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x182\x16^([0-9]{4}-[0-9]{4})?$R\x10fromAcademicYear\x12D\n" +
+	"\x10to_academic_year\x18\x02 \x01(\tB\x1a\xfaB\x17r\x152\x13^[0-9]{4}-[0-9]{4}$R\x0etoAcademicYear\x12%\n" +
+	"\x05rules\x18\x03 \x03(\v2\x0f.main.GradeRuleR\x05rules\x125\n" +
+	"\x11final_grade_level\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\x0ffinalGradeLevel\x12P\n" +
+	"\x15hold_back_student_ids\x18\x05 \x03(\tB\x1d\xfaB\x1a\x92\x01\x17\"\x15r\x132\x11^[a-fA-F0-9]{24}$R\x12holdBackStudentIds\x126\n" +
+	"\n" +
+	"close_term\x18\x06 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\tcloseTerm\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\"\x8d\x01\n" +
+	"\tGradeRule\x123\n" +
+	"\x10from_grade_level\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\x0efromGradeLevel\x12/\n" +
+	"\x0eto_grade_level\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\ftoGradeLevel\x12\x1a\n" +
+	"\bgraduate\x18\x03 \x01(\bR\bgraduate\"\x92\x02\n" +
+	"\fRolloverPlan\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12!\n" +
+	"\fclosed_terms\x18\x02 \x03(\tR\vclosedTerms\x12-\n" +
+	"\aclasses\x18\x03 \x03(\v2\x13.main.RolloverClassR\aclasses\x121\n" +
+	"\bstudents\x18\x04 \x03(\v2\x15.main.RolloverStudentR\bstudents\x123\n" +
+	"\x15completed_enrollments\x18\x05 \x01(\x05R\x14completedEnrollments\x12/\n" +
+	"\x13dropped_enrollments\x18\x06 \x01(\x05R\x12droppedEnrollments\"u\n" +
+	"\rRolloverClass\x12\"\n" +
+	"\rfrom_class_id\x18\x01 \x01(\tR\vfromClassId\x12\x1d\n" +
+	"\n" +
+	"from_class\x18\x02 \x01(\tR\tfromClass\x12!\n" +
+	"\x05class\x18\x03 \x01(\v2\v.main.ClassR\x05class\"\x82\x01\n" +
+	"\x0fRolloverStudent\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1d\n" +
+	"\n" +
+	"from_class\x18\x02 \x01(\tR\tfromClass\x12\x19\n" +
+	"\bto_class\x18\x03 \x01(\tR\atoClass\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action2\x97\x02\n" +
+	"\x0eClassesService\x122\n" +
+	"\n" +
+	"GetClasses\x12\x15.main.GetClassRequest\x1a\r.main.Classes\x12*\n" +
+	"\n" +
+	"AddClasses\x12\r.main.Classes\x1a\r.main.Classes\x12-\n" +
+	"\rUpdateClasses\x12\r.main.Classes\x1a\r.main.Classes\x12;\n" +
+	"\rDeleteClasses\x12\x0e.main.ClassIds\x1a\x1a.main.DeleteClassesConfirm\x129\n" +
+	"\fRolloverYear\x12\x15.main.RolloverRequest\x1a\x12.main.RolloverPlanB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_class_proto_rawDescOnce sync.Once
+	file_class_proto_rawDescData []byte
+)
+
+func file_class_proto_rawDescGZIP() []byte {
+	file_class_proto_rawDescOnce.Do(func() {
+		file_class_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_class_proto_rawDesc), len(file_class_proto_rawDesc)))
+	})
+	return file_class_proto_rawDescData
+}
+
+var file_class_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_class_proto_goTypes = []any{
+	(*DeleteClassesConfirm)(nil), // 0: main.DeleteClassesConfirm
+	(*ClassIds)(nil),             // 1: main.ClassIds
+	(*GetClassRequest)(nil),      // 2: main.GetClassRequest
+	(*Class)(nil),                // 3: main.Class
+	(*Classes)(nil),              // 4: main.Classes
+	(*RolloverRequest)(nil),      // 5: main.RolloverRequest
+	(*GradeRule)(nil),            // 6: main.GradeRule
+	(*RolloverPlan)(nil),         // 7: main.RolloverPlan
+	(*RolloverClass)(nil),        // 8: main.RolloverClass
+	(*RolloverStudent)(nil),      // 9: main.RolloverStudent
+	(*SortField)(nil),            // 10: main.SortField
+}
+var file_class_proto_depIdxs = []int32{
+	3,  // 0: main.GetClassRequest.class:type_name -> main.Class
+	10, // 1: main.GetClassRequest.sort_by:type_name -> main.SortField
+	3,  // 2: main.Classes.classes:type_name -> main.Class
+	6,  // 3: main.RolloverRequest.rules:type_name -> main.GradeRule
+	8,  // 4: main.RolloverPlan.classes:type_name -> main.RolloverClass
+	9,  // 5: main.RolloverPlan.students:type_name -> main.RolloverStudent
+	3,  // 6: main.RolloverClass.class:type_name -> main.Class
+	2,  // 7: main.ClassesService.GetClasses:input_type -> main.GetClassRequest
+	4,  // 8: main.ClassesService.AddClasses:input_type -> main.Classes
+	4,  // 9: main.ClassesService.UpdateClasses:input_type -> main.Classes
+	1,  // 10: main.ClassesService.DeleteClasses:input_type -> main.ClassIds
+	5,  // 11: main.ClassesService.RolloverYear:input_type -> main.RolloverRequest
+	4,  // 12: main.ClassesService.GetClasses:output_type -> main.Classes
+	4,  // 13: main.ClassesService.AddClasses:output_type -> main.Classes
+	4,  // 14: main.ClassesService.UpdateClasses:output_type -> main.Classes
+	0,  // 15: main.ClassesService.DeleteClasses:output_type -> main.DeleteClassesConfirm
+	7,  // 16: main.ClassesService.RolloverYear:output_type -> main.RolloverPlan
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_class_proto_init() }
+func file_class_proto_init() {
+	if File_class_proto != nil {
+		return
+	}
+	file_student_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_class_proto_rawDesc), len(file_class_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_class_proto_goTypes,
+		DependencyIndexes: file_class_proto_depIdxs,
+		MessageInfos:      file_class_proto_msgTypes,
+	}.Build()
+	File_class_proto = out.File
+	file_class_proto_goTypes = nil
+	file_class_proto_depIdxs = nil
+}
@@ -0,0 +1,1487 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: class.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeleteClassesConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClassesConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClassesConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClassesConfirmMultiError, or nil if none found.
+func (m *DeleteClassesConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClassesConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteClassesConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClassesConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteClassesConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteClassesConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClassesConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClassesConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteClassesConfirmValidationError is the validation error returned by
+// DeleteClassesConfirm.Validate if the designated constraints aren't met.
+type DeleteClassesConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClassesConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClassesConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClassesConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClassesConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClassesConfirmValidationError) ErrorName() string {
+	return "DeleteClassesConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClassesConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClassesConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClassesConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClassesConfirmValidationError{}
+
+// Validate checks the field values on ClassIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClassIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClassIdsMultiError, or nil
+// if none found.
+func (m *ClassIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClassIds()) < 1 {
+		err := ClassIdsValidationError{
+			field:  "ClassIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClassIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassIdsMultiError is an error wrapping multiple validation errors returned
+// by ClassIds.ValidateAll() if the designated constraints aren't met.
+type ClassIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassIdsMultiError) AllErrors() []error { return m }
+
+// ClassIdsValidationError is the validation error returned by
+// ClassIds.Validate if the designated constraints aren't met.
+type ClassIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassIdsValidationError) ErrorName() string { return "ClassIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassIdsValidationError{}
+
+// Validate checks the field values on GetClassRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClassRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClassRequestMultiError, or nil if none found.
+func (m *GetClassRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClassRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClass()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClassRequestValidationError{
+					field:  "Class",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClassRequestValidationError{
+					field:  "Class",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClass()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClassRequestValidationError{
+				field:  "Class",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetClassRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetClassRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetClassRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetClassRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClassRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClassRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClassRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClassRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClassRequestMultiError) AllErrors() []error { return m }
+
+// GetClassRequestValidationError is the validation error returned by
+// GetClassRequest.Validate if the designated constraints aren't met.
+type GetClassRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClassRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClassRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClassRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClassRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClassRequestValidationError) ErrorName() string { return "GetClassRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClassRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClassRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClassRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClassRequestValidationError{}
+
+// Validate checks the field values on Class with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Class) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Class with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClassMultiError, or nil if none found.
+func (m *Class) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Class) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_Class_Name_Pattern.MatchString(m.GetName()) {
+		err := ClassValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetGradeLevel(); val < 0 || val > 12 {
+		err := ClassValidationError{
+			field:  "GradeLevel",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Class_Section_Pattern.MatchString(m.GetSection()) {
+		err := ClassValidationError{
+			field:  "Section",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHomeroomTeacherId() != "" {
+
+		if !_Class_HomeroomTeacherId_Pattern.MatchString(m.GetHomeroomTeacherId()) {
+			err := ClassValidationError{
+				field:  "HomeroomTeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_Class_Room_Pattern.MatchString(m.GetRoom()) {
+		err := ClassValidationError{
+			field:  "Room",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCapacity() < 0 {
+		err := ClassValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Class_AcademicYear_Pattern.MatchString(m.GetAcademicYear()) {
+		err := ClassValidationError{
+			field:  "AcademicYear",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{4})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return ClassMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassMultiError is an error wrapping multiple validation errors returned by
+// Class.ValidateAll() if the designated constraints aren't met.
+type ClassMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassMultiError) AllErrors() []error { return m }
+
+// ClassValidationError is the validation error returned by Class.Validate if
+// the designated constraints aren't met.
+type ClassValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassValidationError) ErrorName() string { return "ClassValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClass.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassValidationError{}
+
+var _Class_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
+
+var _Class_Section_Pattern = regexp.MustCompile("^[A-Za-z0-9]*$")
+
+var _Class_HomeroomTeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Class_Room_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _Class_AcademicYear_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{4})?$")
+
+// Validate checks the field values on Classes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Classes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Classes with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClassesMultiError, or nil if none found.
+func (m *Classes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Classes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClasses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClassesValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClassesValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClassesValidationError{
+					field:  fmt.Sprintf("Classes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassesMultiError is an error wrapping multiple validation errors returned
+// by Classes.ValidateAll() if the designated constraints aren't met.
+type ClassesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassesMultiError) AllErrors() []error { return m }
+
+// ClassesValidationError is the validation error returned by Classes.Validate
+// if the designated constraints aren't met.
+type ClassesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassesValidationError) ErrorName() string { return "ClassesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClasses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassesValidationError{}
+
+// Validate checks the field values on RolloverRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RolloverRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RolloverRequestMultiError, or nil if none found.
+func (m *RolloverRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RolloverRequest_FromAcademicYear_Pattern.MatchString(m.GetFromAcademicYear()) {
+		err := RolloverRequestValidationError{
+			field:  "FromAcademicYear",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{4})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RolloverRequest_ToAcademicYear_Pattern.MatchString(m.GetToAcademicYear()) {
+		err := RolloverRequestValidationError{
+			field:  "ToAcademicYear",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{4}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RolloverRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RolloverRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RolloverRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if val := m.GetFinalGradeLevel(); val < 0 || val > 12 {
+		err := RolloverRequestValidationError{
+			field:  "FinalGradeLevel",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHoldBackStudentIds() {
+		_, _ = idx, item
+
+		if !_RolloverRequest_HoldBackStudentIds_Pattern.MatchString(item) {
+			err := RolloverRequestValidationError{
+				field:  fmt.Sprintf("HoldBackStudentIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_RolloverRequest_CloseTerm_Pattern.MatchString(m.GetCloseTerm()) {
+		err := RolloverRequestValidationError{
+			field:  "CloseTerm",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return RolloverRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverRequestMultiError is an error wrapping multiple validation errors
+// returned by RolloverRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RolloverRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverRequestMultiError) AllErrors() []error { return m }
+
+// RolloverRequestValidationError is the validation error returned by
+// RolloverRequest.Validate if the designated constraints aren't met.
+type RolloverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverRequestValidationError) ErrorName() string { return "RolloverRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolloverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverRequestValidationError{}
+
+var _RolloverRequest_FromAcademicYear_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{4})?$")
+
+var _RolloverRequest_ToAcademicYear_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{4}$")
+
+var _RolloverRequest_HoldBackStudentIds_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _RolloverRequest_CloseTerm_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on GradeRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GradeRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GradeRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GradeRuleMultiError, or nil
+// if none found.
+func (m *GradeRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GradeRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetFromGradeLevel(); val < 0 || val > 12 {
+		err := GradeRuleValidationError{
+			field:  "FromGradeLevel",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetToGradeLevel(); val < 0 || val > 12 {
+		err := GradeRuleValidationError{
+			field:  "ToGradeLevel",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Graduate
+
+	if len(errors) > 0 {
+		return GradeRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradeRuleMultiError is an error wrapping multiple validation errors returned
+// by GradeRule.ValidateAll() if the designated constraints aren't met.
+type GradeRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradeRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradeRuleMultiError) AllErrors() []error { return m }
+
+// GradeRuleValidationError is the validation error returned by
+// GradeRule.Validate if the designated constraints aren't met.
+type GradeRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradeRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradeRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradeRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradeRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradeRuleValidationError) ErrorName() string { return "GradeRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradeRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGradeRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradeRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradeRuleValidationError{}
+
+// Validate checks the field values on RolloverPlan with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolloverPlan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverPlan with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolloverPlanMultiError, or
+// nil if none found.
+func (m *RolloverPlan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverPlan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	for idx, item := range m.GetClasses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RolloverPlanValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RolloverPlanValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RolloverPlanValidationError{
+					field:  fmt.Sprintf("Classes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStudents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RolloverPlanValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RolloverPlanValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RolloverPlanValidationError{
+					field:  fmt.Sprintf("Students[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CompletedEnrollments
+
+	// no validation rules for DroppedEnrollments
+
+	if len(errors) > 0 {
+		return RolloverPlanMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverPlanMultiError is an error wrapping multiple validation errors
+// returned by RolloverPlan.ValidateAll() if the designated constraints aren't met.
+type RolloverPlanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverPlanMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverPlanMultiError) AllErrors() []error { return m }
+
+// RolloverPlanValidationError is the validation error returned by
+// RolloverPlan.Validate if the designated constraints aren't met.
+type RolloverPlanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverPlanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverPlanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverPlanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverPlanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverPlanValidationError) ErrorName() string { return "RolloverPlanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolloverPlanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverPlan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverPlanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverPlanValidationError{}
+
+// Validate checks the field values on RolloverClass with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolloverClass) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverClass with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolloverClassMultiError, or
+// nil if none found.
+func (m *RolloverClass) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverClass) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromClassId
+
+	// no validation rules for FromClass
+
+	if all {
+		switch v := interface{}(m.GetClass()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RolloverClassValidationError{
+					field:  "Class",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RolloverClassValidationError{
+					field:  "Class",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClass()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RolloverClassValidationError{
+				field:  "Class",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RolloverClassMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverClassMultiError is an error wrapping multiple validation errors
+// returned by RolloverClass.ValidateAll() if the designated constraints
+// aren't met.
+type RolloverClassMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverClassMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverClassMultiError) AllErrors() []error { return m }
+
+// RolloverClassValidationError is the validation error returned by
+// RolloverClass.Validate if the designated constraints aren't met.
+type RolloverClassValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverClassValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverClassValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverClassValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverClassValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverClassValidationError) ErrorName() string { return "RolloverClassValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolloverClassValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverClass.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverClassValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverClassValidationError{}
+
+// Validate checks the field values on RolloverStudent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RolloverStudent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverStudent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RolloverStudentMultiError, or nil if none found.
+func (m *RolloverStudent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverStudent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StudentId
+
+	// no validation rules for FromClass
+
+	// no validation rules for ToClass
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return RolloverStudentMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverStudentMultiError is an error wrapping multiple validation errors
+// returned by RolloverStudent.ValidateAll() if the designated constraints
+// aren't met.
+type RolloverStudentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverStudentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverStudentMultiError) AllErrors() []error { return m }
+
+// RolloverStudentValidationError is the validation error returned by
+// RolloverStudent.Validate if the designated constraints aren't met.
+type RolloverStudentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverStudentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverStudentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverStudentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverStudentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverStudentValidationError) ErrorName() string { return "RolloverStudentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolloverStudentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverStudent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverStudentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverStudentValidationError{}
@@ -0,0 +1,1045 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: course.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteSubjectsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubjectsConfirm) Reset() {
+	*x = DeleteSubjectsConfirm{}
+	mi := &file_course_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubjectsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubjectsConfirm) ProtoMessage() {}
+
+func (x *DeleteSubjectsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubjectsConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteSubjectsConfirm) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteSubjectsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteSubjectsConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type SubjectIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectIds    []string               `protobuf:"bytes,1,rep,name=subjectIds,proto3" json:"subjectIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubjectIds) Reset() {
+	*x = SubjectIds{}
+	mi := &file_course_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectIds) ProtoMessage() {}
+
+func (x *SubjectIds) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectIds.ProtoReflect.Descriptor instead.
+func (*SubjectIds) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubjectIds) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+type GetSubjectRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Subject        *Subject               `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSubjectRequest) Reset() {
+	*x = GetSubjectRequest{}
+	mi := &file_course_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubjectRequest) ProtoMessage() {}
+
+func (x *GetSubjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubjectRequest.ProtoReflect.Descriptor instead.
+func (*GetSubjectRequest) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSubjectRequest) GetSubject() *Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *GetSubjectRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetSubjectRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetSubjectRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSubjectRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type Subject struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                   string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name                   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Credits                int32                  `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	Department             string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`
+	DeletedAt              string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy              string                 `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	PrerequisiteSubjectIds []string               `protobuf:"bytes,8,rep,name=prerequisite_subject_ids,json=prerequisiteSubjectIds,proto3" json:"prerequisite_subject_ids,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Subject) Reset() {
+	*x = Subject{}
+	mi := &file_course_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subject) ProtoMessage() {}
+
+func (x *Subject) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subject.ProtoReflect.Descriptor instead.
+func (*Subject) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Subject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subject) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Subject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subject) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Subject) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *Subject) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Subject) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Subject) GetPrerequisiteSubjectIds() []string {
+	if x != nil {
+		return x.PrerequisiteSubjectIds
+	}
+	return nil
+}
+
+type Subjects struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subjects      []*Subject             `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subjects) Reset() {
+	*x = Subjects{}
+	mi := &file_course_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subjects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subjects) ProtoMessage() {}
+
+func (x *Subjects) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subjects.ProtoReflect.Descriptor instead.
+func (*Subjects) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Subjects) GetSubjects() []*Subject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+type DeleteCoursesConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoursesConfirm) Reset() {
+	*x = DeleteCoursesConfirm{}
+	mi := &file_course_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoursesConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoursesConfirm) ProtoMessage() {}
+
+func (x *DeleteCoursesConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoursesConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteCoursesConfirm) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCoursesConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteCoursesConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type CourseIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseIds     []string               `protobuf:"bytes,1,rep,name=courseIds,proto3" json:"courseIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseIds) Reset() {
+	*x = CourseIds{}
+	mi := &file_course_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseIds) ProtoMessage() {}
+
+func (x *CourseIds) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseIds.ProtoReflect.Descriptor instead.
+func (*CourseIds) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CourseIds) GetCourseIds() []string {
+	if x != nil {
+		return x.CourseIds
+	}
+	return nil
+}
+
+type GetCourseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Course         *Course                `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCourseRequest) Reset() {
+	*x = GetCourseRequest{}
+	mi := &file_course_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseRequest) ProtoMessage() {}
+
+func (x *GetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCourseRequest) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetCourseRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetCourseRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetCourseRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// term defaults to the current term
+type CoursesByTeacherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoursesByTeacherRequest) Reset() {
+	*x = CoursesByTeacherRequest{}
+	mi := &file_course_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursesByTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursesByTeacherRequest) ProtoMessage() {}
+
+func (x *CoursesByTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursesByTeacherRequest.ProtoReflect.Descriptor instead.
+func (*CoursesByTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CoursesByTeacherRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CoursesByTeacherRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// term defaults to the current term
+type CoursesByClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoursesByClassRequest) Reset() {
+	*x = CoursesByClassRequest{}
+	mi := &file_course_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursesByClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursesByClassRequest) ProtoMessage() {}
+
+func (x *CoursesByClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursesByClassRequest.ProtoReflect.Descriptor instead.
+func (*CoursesByClassRequest) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CoursesByClassRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *CoursesByClassRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// a course is one subject taught to one class by one teacher in one term
+type Course struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Term          string                 `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"` // name of a term of the academic calendar
+	DeletedAt     string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	Capacity      int32                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`                                // 0 means no limit
+	EnrolledCount int32                  `protobuf:"varint,9,opt,name=enrolled_count,json=enrolledCount,proto3" json:"enrolled_count,omitempty"` // read only, kept by the EnrollmentService
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Course) Reset() {
+	*x = Course{}
+	mi := &file_course_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Course) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Course) ProtoMessage() {}
+
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Course) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Course) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *Course) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Course) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Course) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Course) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Course) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Course) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Course) GetEnrolledCount() int32 {
+	if x != nil {
+		return x.EnrolledCount
+	}
+	return 0
+}
+
+type Courses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courses) Reset() {
+	*x = Courses{}
+	mi := &file_course_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courses) ProtoMessage() {}
+
+func (x *Courses) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
+func (*Courses) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Courses) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+// read only view of a course on the teacher it is assigned to
+type CourseAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	SubjectCode   string                 `protobuf:"bytes,3,opt,name=subject_code,json=subjectCode,proto3" json:"subject_code,omitempty"`
+	SubjectName   string                 `protobuf:"bytes,4,opt,name=subject_name,json=subjectName,proto3" json:"subject_name,omitempty"`
+	ClassId       string                 `protobuf:"bytes,5,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ClassName     string                 `protobuf:"bytes,6,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Term          string                 `protobuf:"bytes,7,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseAssignment) Reset() {
+	*x = CourseAssignment{}
+	mi := &file_course_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseAssignment) ProtoMessage() {}
+
+func (x *CourseAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_course_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseAssignment.ProtoReflect.Descriptor instead.
+func (*CourseAssignment) Descriptor() ([]byte, []int) {
+	return file_course_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CourseAssignment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseAssignment) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *CourseAssignment) GetSubjectCode() string {
+	if x != nil {
+		return x.SubjectCode
+	}
+	return ""
+}
+
+func (x *CourseAssignment) GetSubjectName() string {
+	if x != nil {
+		return x.SubjectName
+	}
+	return ""
+}
+
+func (x *CourseAssignment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *CourseAssignment) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *CourseAssignment) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+var File_course_proto protoreflect.FileDescriptor
+
+const file_course_proto_rawDesc = "" +
+	"\n" +
+	"\fcourse.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"P\n" +
+	"\x15DeleteSubjectsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"6\n" +
+	"\n" +
+	"SubjectIds\x12(\n" +
+	"\n" +
+	"subjectIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
+	"subjectIds\"\xc7\x01\n" +
+	"\x11GetSubjectRequest\x12'\n" +
+	"\asubject\x18\x01 \x01(\v2\r.main.SubjectR\asubject\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\xe5\x02\n" +
+	"\aSubject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x04code\x18\x02 \x01(\tB\x18\xfaB\x15r\x13\x18\x102\x0f^[A-Za-z0-9-]*$R\x04code\x12*\n" +
+	"\x04name\x18\x03 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x04name\x12!\n" +
+	"\acredits\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\acredits\x126\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\n" +
+	"department\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\a \x01(\tR\tdeletedBy\x12W\n" +
+	"\x18prerequisite_subject_ids\x18\b \x03(\tB\x1d\xfaB\x1a\x92\x01\x17\"\x15r\x132\x11^[a-fA-F0-9]{24}$R\x16prerequisiteSubjectIds\"5\n" +
+	"\bSubjects\x12)\n" +
+	"\bsubjects\x18\x01 \x03(\v2\r.main.SubjectR\bsubjects\"O\n" +
+	"\x14DeleteCoursesConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"3\n" +
+	"\tCourseIds\x12&\n" +
+	"\tcourseIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\tcourseIds\"\xc3\x01\n" +
+	"\x10GetCourseRequest\x12$\n" +
+	"\x06course\x18\x01 \x01(\v2\f.main.CourseR\x06course\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"j\n" +
+	"\x17CoursesByTeacherRequest\x12;\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tteacherId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\"d\n" +
+	"\x15CoursesByClassRequest\x127\n" +
+	"\bclass_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\aclassId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\"\xff\x02\n" +
+	"\x06Course\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tsubjectId\x126\n" +
+	"\bclass_id\x18\x03 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12:\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tteacherId\x12+\n" +
+	"\x04term\x18\x05 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\a \x01(\tR\tdeletedBy\x12#\n" +
+	"\bcapacity\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bcapacity\x12%\n" +
+	"\x0eenrolled_count\x18\t \x01(\x05R\renrolledCount\"1\n" +
+	"\aCourses\x12&\n" +
+	"\acourses\x18\x01 \x03(\v2\f.main.CourseR\acourses\"\xe2\x01\n" +
+	"\x10CourseAssignment\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x12!\n" +
+	"\fsubject_code\x18\x03 \x01(\tR\vsubjectCode\x12!\n" +
+	"\fsubject_name\x18\x04 \x01(\tR\vsubjectName\x12\x19\n" +
+	"\bclass_id\x18\x05 \x01(\tR\aclassId\x12\x1d\n" +
+	"\n" +
+	"class_name\x18\x06 \x01(\tR\tclassName\x12\x12\n" +
+	"\x04term\x18\a \x01(\tR\x04term2\xc0\x04\n" +
+	"\x0eCoursesService\x126\n" +
+	"\vGetSubjects\x12\x17.main.GetSubjectRequest\x1a\x0e.main.Subjects\x12-\n" +
+	"\vAddSubjects\x12\x0e.main.Subjects\x1a\x0e.main.Subjects\x120\n" +
+	"\x0eUpdateSubjects\x12\x0e.main.Subjects\x1a\x0e.main.Subjects\x12?\n" +
+	"\x0eDeleteSubjects\x12\x10.main.SubjectIds\x1a\x1b.main.DeleteSubjectsConfirm\x123\n" +
+	"\n" +
+	"GetCourses\x12\x16.main.GetCourseRequest\x1a\r.main.Courses\x12*\n" +
+	"\n" +
+	"AddCourses\x12\r.main.Courses\x1a\r.main.Courses\x12-\n" +
+	"\rUpdateCourses\x12\r.main.Courses\x1a\r.main.Courses\x12<\n" +
+	"\rDeleteCourses\x12\x0f.main.CourseIds\x1a\x1a.main.DeleteCoursesConfirm\x12D\n" +
+	"\x14ListCoursesByTeacher\x12\x1d.main.CoursesByTeacherRequest\x1a\r.main.Courses\x12@\n" +
+	"\x12ListCoursesByClass\x12\x1b.main.CoursesByClassRequest\x1a\r.main.CoursesB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_course_proto_rawDescOnce sync.Once
+	file_course_proto_rawDescData []byte
+)
+
+func file_course_proto_rawDescGZIP() []byte {
+	file_course_proto_rawDescOnce.Do(func() {
+		file_course_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_course_proto_rawDesc), len(file_course_proto_rawDesc)))
+	})
+	return file_course_proto_rawDescData
+}
+
+var file_course_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_course_proto_goTypes = []any{
+	(*DeleteSubjectsConfirm)(nil),   // 0: main.DeleteSubjectsConfirm
+	(*SubjectIds)(nil),              // 1: main.SubjectIds
+	(*GetSubjectRequest)(nil),       // 2: main.GetSubjectRequest
+	(*Subject)(nil),                 // 3: main.Subject
+	(*Subjects)(nil),                // 4: main.Subjects
+	(*DeleteCoursesConfirm)(nil),    // 5: main.DeleteCoursesConfirm
+	(*CourseIds)(nil),               // 6: main.CourseIds
+	(*GetCourseRequest)(nil),        // 7: main.GetCourseRequest
+	(*CoursesByTeacherRequest)(nil), // 8: main.CoursesByTeacherRequest
+	(*CoursesByClassRequest)(nil),   // 9: main.CoursesByClassRequest
+	(*Course)(nil),                  // 10: main.Course
+	(*Courses)(nil),                 // 11: main.Courses
+	(*CourseAssignment)(nil),        // 12: main.CourseAssignment
+	(*SortField)(nil),               // 13: main.SortField
+}
+var file_course_proto_depIdxs = []int32{
+	3,  // 0: main.GetSubjectRequest.subject:type_name -> main.Subject
+	13, // 1: main.GetSubjectRequest.sort_by:type_name -> main.SortField
+	3,  // 2: main.Subjects.subjects:type_name -> main.Subject
+	10, // 3: main.GetCourseRequest.course:type_name -> main.Course
+	13, // 4: main.GetCourseRequest.sort_by:type_name -> main.SortField
+	10, // 5: main.Courses.courses:type_name -> main.Course
+	2,  // 6: main.CoursesService.GetSubjects:input_type -> main.GetSubjectRequest
+	4,  // 7: main.CoursesService.AddSubjects:input_type -> main.Subjects
+	4,  // 8: main.CoursesService.UpdateSubjects:input_type -> main.Subjects
+	1,  // 9: main.CoursesService.DeleteSubjects:input_type -> main.SubjectIds
+	7,  // 10: main.CoursesService.GetCourses:input_type -> main.GetCourseRequest
+	11, // 11: main.CoursesService.AddCourses:input_type -> main.Courses
+	11, // 12: main.CoursesService.UpdateCourses:input_type -> main.Courses
+	6,  // 13: main.CoursesService.DeleteCourses:input_type -> main.CourseIds
+	8,  // 14: main.CoursesService.ListCoursesByTeacher:input_type -> main.CoursesByTeacherRequest
+	9,  // 15: main.CoursesService.ListCoursesByClass:input_type -> main.CoursesByClassRequest
+	4,  // 16: main.CoursesService.GetSubjects:output_type -> main.Subjects
+	4,  // 17: main.CoursesService.AddSubjects:output_type -> main.Subjects
+	4,  // 18: main.CoursesService.UpdateSubjects:output_type -> main.Subjects
+	0,  // 19: main.CoursesService.DeleteSubjects:output_type -> main.DeleteSubjectsConfirm
+	11, // 20: main.CoursesService.GetCourses:output_type -> main.Courses
+	11, // 21: main.CoursesService.AddCourses:output_type -> main.Courses
+	11, // 22: main.CoursesService.UpdateCourses:output_type -> main.Courses
+	5,  // 23: main.CoursesService.DeleteCourses:output_type -> main.DeleteCoursesConfirm
+	11, // 24: main.CoursesService.ListCoursesByTeacher:output_type -> main.Courses
+	11, // 25: main.CoursesService.ListCoursesByClass:output_type -> main.Courses
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_course_proto_init() }
+func file_course_proto_init() {
+	if File_course_proto != nil {
+		return
+	}
+	file_student_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_course_proto_rawDesc), len(file_course_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_course_proto_goTypes,
+		DependencyIndexes: file_course_proto_depIdxs,
+		MessageInfos:      file_course_proto_msgTypes,
+	}.Build()
+	File_course_proto = out.File
+	file_course_proto_goTypes = nil
+	file_course_proto_depIdxs = nil
+}
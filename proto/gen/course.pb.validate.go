@@ -0,0 +1,1805 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: course.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeleteSubjectsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSubjectsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSubjectsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSubjectsConfirmMultiError, or nil if none found.
+func (m *DeleteSubjectsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSubjectsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteSubjectsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSubjectsConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteSubjectsConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSubjectsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSubjectsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSubjectsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteSubjectsConfirmValidationError is the validation error returned by
+// DeleteSubjectsConfirm.Validate if the designated constraints aren't met.
+type DeleteSubjectsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSubjectsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSubjectsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSubjectsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSubjectsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSubjectsConfirmValidationError) ErrorName() string {
+	return "DeleteSubjectsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSubjectsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSubjectsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSubjectsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSubjectsConfirmValidationError{}
+
+// Validate checks the field values on SubjectIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubjectIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubjectIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubjectIdsMultiError, or
+// nil if none found.
+func (m *SubjectIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubjectIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSubjectIds()) < 1 {
+		err := SubjectIdsValidationError{
+			field:  "SubjectIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubjectIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectIdsMultiError is an error wrapping multiple validation errors
+// returned by SubjectIds.ValidateAll() if the designated constraints aren't met.
+type SubjectIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectIdsMultiError) AllErrors() []error { return m }
+
+// SubjectIdsValidationError is the validation error returned by
+// SubjectIds.Validate if the designated constraints aren't met.
+type SubjectIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectIdsValidationError) ErrorName() string { return "SubjectIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjectIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectIdsValidationError{}
+
+// Validate checks the field values on GetSubjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSubjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubjectRequestMultiError, or nil if none found.
+func (m *GetSubjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSubjectRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSubjectRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSubjectRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubjectRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubjectRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubjectRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetSubjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubjectRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSubjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSubjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubjectRequestMultiError) AllErrors() []error { return m }
+
+// GetSubjectRequestValidationError is the validation error returned by
+// GetSubjectRequest.Validate if the designated constraints aren't met.
+type GetSubjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubjectRequestValidationError) ErrorName() string {
+	return "GetSubjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubjectRequestValidationError{}
+
+// Validate checks the field values on Subject with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subject with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SubjectMultiError, or nil if none found.
+func (m *Subject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetCode()) > 16 {
+		err := SubjectValidationError{
+			field:  "Code",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Subject_Code_Pattern.MatchString(m.GetCode()) {
+		err := SubjectValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Subject_Name_Pattern.MatchString(m.GetName()) {
+		err := SubjectValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCredits() < 0 {
+		err := SubjectValidationError{
+			field:  "Credits",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Subject_Department_Pattern.MatchString(m.GetDepartment()) {
+		err := SubjectValidationError{
+			field:  "Department",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	for idx, item := range m.GetPrerequisiteSubjectIds() {
+		_, _ = idx, item
+
+		if !_Subject_PrerequisiteSubjectIds_Pattern.MatchString(item) {
+			err := SubjectValidationError{
+				field:  fmt.Sprintf("PrerequisiteSubjectIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectMultiError is an error wrapping multiple validation errors returned
+// by Subject.ValidateAll() if the designated constraints aren't met.
+type SubjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectMultiError) AllErrors() []error { return m }
+
+// SubjectValidationError is the validation error returned by Subject.Validate
+// if the designated constraints aren't met.
+type SubjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectValidationError) ErrorName() string { return "SubjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectValidationError{}
+
+var _Subject_Code_Pattern = regexp.MustCompile("^[A-Za-z0-9-]*$")
+
+var _Subject_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
+
+var _Subject_Department_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
+
+var _Subject_PrerequisiteSubjectIds_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on Subjects with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subjects) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subjects with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubjectsMultiError, or nil
+// if none found.
+func (m *Subjects) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subjects) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubjectsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubjectsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubjectsValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectsMultiError is an error wrapping multiple validation errors returned
+// by Subjects.ValidateAll() if the designated constraints aren't met.
+type SubjectsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectsMultiError) AllErrors() []error { return m }
+
+// SubjectsValidationError is the validation error returned by
+// Subjects.Validate if the designated constraints aren't met.
+type SubjectsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectsValidationError) ErrorName() string { return "SubjectsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjects.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectsValidationError{}
+
+// Validate checks the field values on DeleteCoursesConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCoursesConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCoursesConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCoursesConfirmMultiError, or nil if none found.
+func (m *DeleteCoursesConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCoursesConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteCoursesConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCoursesConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteCoursesConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCoursesConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCoursesConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCoursesConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteCoursesConfirmValidationError is the validation error returned by
+// DeleteCoursesConfirm.Validate if the designated constraints aren't met.
+type DeleteCoursesConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCoursesConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCoursesConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCoursesConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCoursesConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCoursesConfirmValidationError) ErrorName() string {
+	return "DeleteCoursesConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCoursesConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCoursesConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCoursesConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCoursesConfirmValidationError{}
+
+// Validate checks the field values on CourseIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CourseIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CourseIdsMultiError, or nil
+// if none found.
+func (m *CourseIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCourseIds()) < 1 {
+		err := CourseIdsValidationError{
+			field:  "CourseIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CourseIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseIdsMultiError is an error wrapping multiple validation errors returned
+// by CourseIds.ValidateAll() if the designated constraints aren't met.
+type CourseIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseIdsMultiError) AllErrors() []error { return m }
+
+// CourseIdsValidationError is the validation error returned by
+// CourseIds.Validate if the designated constraints aren't met.
+type CourseIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseIdsValidationError) ErrorName() string { return "CourseIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseIdsValidationError{}
+
+// Validate checks the field values on GetCourseRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCourseRequestMultiError, or nil if none found.
+func (m *GetCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCourse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCourseRequestValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCourseRequestValidationError{
+					field:  "Course",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCourse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCourseRequestValidationError{
+				field:  "Course",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCourseRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCourseRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCourseRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCourseRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCourseRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCourseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCourseRequestMultiError) AllErrors() []error { return m }
+
+// GetCourseRequestValidationError is the validation error returned by
+// GetCourseRequest.Validate if the designated constraints aren't met.
+type GetCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCourseRequestValidationError) ErrorName() string { return "GetCourseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCourseRequestValidationError{}
+
+// Validate checks the field values on CoursesByTeacherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CoursesByTeacherRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CoursesByTeacherRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CoursesByTeacherRequestMultiError, or nil if none found.
+func (m *CoursesByTeacherRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CoursesByTeacherRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTeacherId()) != 24 {
+		err := CoursesByTeacherRequestValidationError{
+			field:  "TeacherId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_CoursesByTeacherRequest_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+		err := CoursesByTeacherRequestValidationError{
+			field:  "TeacherId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return CoursesByTeacherRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoursesByTeacherRequestMultiError is an error wrapping multiple validation
+// errors returned by CoursesByTeacherRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CoursesByTeacherRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoursesByTeacherRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoursesByTeacherRequestMultiError) AllErrors() []error { return m }
+
+// CoursesByTeacherRequestValidationError is the validation error returned by
+// CoursesByTeacherRequest.Validate if the designated constraints aren't met.
+type CoursesByTeacherRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoursesByTeacherRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoursesByTeacherRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoursesByTeacherRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoursesByTeacherRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoursesByTeacherRequestValidationError) ErrorName() string {
+	return "CoursesByTeacherRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CoursesByTeacherRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoursesByTeacherRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoursesByTeacherRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoursesByTeacherRequestValidationError{}
+
+var _CoursesByTeacherRequest_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on CoursesByClassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CoursesByClassRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CoursesByClassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CoursesByClassRequestMultiError, or nil if none found.
+func (m *CoursesByClassRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CoursesByClassRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClassId()) != 24 {
+		err := CoursesByClassRequestValidationError{
+			field:  "ClassId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_CoursesByClassRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+		err := CoursesByClassRequestValidationError{
+			field:  "ClassId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return CoursesByClassRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoursesByClassRequestMultiError is an error wrapping multiple validation
+// errors returned by CoursesByClassRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CoursesByClassRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoursesByClassRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoursesByClassRequestMultiError) AllErrors() []error { return m }
+
+// CoursesByClassRequestValidationError is the validation error returned by
+// CoursesByClassRequest.Validate if the designated constraints aren't met.
+type CoursesByClassRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoursesByClassRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoursesByClassRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoursesByClassRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoursesByClassRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoursesByClassRequestValidationError) ErrorName() string {
+	return "CoursesByClassRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CoursesByClassRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoursesByClassRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoursesByClassRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoursesByClassRequestValidationError{}
+
+var _CoursesByClassRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on Course with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Course) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Course with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CourseMultiError, or nil if none found.
+func (m *Course) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Course) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetSubjectId() != "" {
+
+		if !_Course_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+			err := CourseValidationError{
+				field:  "SubjectId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClassId() != "" {
+
+		if !_Course_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := CourseValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetTeacherId() != "" {
+
+		if !_Course_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+			err := CourseValidationError{
+				field:  "TeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_Course_Term_Pattern.MatchString(m.GetTerm()) {
+		err := CourseValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if m.GetCapacity() < 0 {
+		err := CourseValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EnrolledCount
+
+	if len(errors) > 0 {
+		return CourseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseMultiError is an error wrapping multiple validation errors returned by
+// Course.ValidateAll() if the designated constraints aren't met.
+type CourseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseMultiError) AllErrors() []error { return m }
+
+// CourseValidationError is the validation error returned by Course.Validate if
+// the designated constraints aren't met.
+type CourseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseValidationError) ErrorName() string { return "CourseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseValidationError{}
+
+var _Course_SubjectId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Course_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Course_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Course_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on Courses with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Courses) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Courses with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CoursesMultiError, or nil if none found.
+func (m *Courses) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Courses) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCourses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CoursesValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CoursesValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CoursesValidationError{
+					field:  fmt.Sprintf("Courses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CoursesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CoursesMultiError is an error wrapping multiple validation errors returned
+// by Courses.ValidateAll() if the designated constraints aren't met.
+type CoursesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CoursesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CoursesMultiError) AllErrors() []error { return m }
+
+// CoursesValidationError is the validation error returned by Courses.Validate
+// if the designated constraints aren't met.
+type CoursesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoursesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoursesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoursesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoursesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoursesValidationError) ErrorName() string { return "CoursesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CoursesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoursesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoursesValidationError{}
+
+// Validate checks the field values on CourseAssignment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CourseAssignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CourseAssignmentMultiError, or nil if none found.
+func (m *CourseAssignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseAssignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CourseId
+
+	// no validation rules for SubjectId
+
+	// no validation rules for SubjectCode
+
+	// no validation rules for SubjectName
+
+	// no validation rules for ClassId
+
+	// no validation rules for ClassName
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return CourseAssignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseAssignmentMultiError is an error wrapping multiple validation errors
+// returned by CourseAssignment.ValidateAll() if the designated constraints
+// aren't met.
+type CourseAssignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseAssignmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseAssignmentMultiError) AllErrors() []error { return m }
+
+// CourseAssignmentValidationError is the validation error returned by
+// CourseAssignment.Validate if the designated constraints aren't met.
+type CourseAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseAssignmentValidationError) ErrorName() string { return "CourseAssignmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseAssignmentValidationError{}
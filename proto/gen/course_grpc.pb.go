@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: course.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoursesService_GetSubjects_FullMethodName          = "/main.CoursesService/GetSubjects"
+	CoursesService_AddSubjects_FullMethodName          = "/main.CoursesService/AddSubjects"
+	CoursesService_UpdateSubjects_FullMethodName       = "/main.CoursesService/UpdateSubjects"
+	CoursesService_DeleteSubjects_FullMethodName       = "/main.CoursesService/DeleteSubjects"
+	CoursesService_GetCourses_FullMethodName           = "/main.CoursesService/GetCourses"
+	CoursesService_AddCourses_FullMethodName           = "/main.CoursesService/AddCourses"
+	CoursesService_UpdateCourses_FullMethodName        = "/main.CoursesService/UpdateCourses"
+	CoursesService_DeleteCourses_FullMethodName        = "/main.CoursesService/DeleteCourses"
+	CoursesService_ListCoursesByTeacher_FullMethodName = "/main.CoursesService/ListCoursesByTeacher"
+	CoursesService_ListCoursesByClass_FullMethodName   = "/main.CoursesService/ListCoursesByClass"
+)
+
+// CoursesServiceClient is the client API for CoursesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CoursesServiceClient interface {
+	GetSubjects(ctx context.Context, in *GetSubjectRequest, opts ...grpc.CallOption) (*Subjects, error)
+	AddSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error)
+	UpdateSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error)
+	DeleteSubjects(ctx context.Context, in *SubjectIds, opts ...grpc.CallOption) (*DeleteSubjectsConfirm, error)
+	GetCourses(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Courses, error)
+	AddCourses(ctx context.Context, in *Courses, opts ...grpc.CallOption) (*Courses, error)
+	UpdateCourses(ctx context.Context, in *Courses, opts ...grpc.CallOption) (*Courses, error)
+	DeleteCourses(ctx context.Context, in *CourseIds, opts ...grpc.CallOption) (*DeleteCoursesConfirm, error)
+	ListCoursesByTeacher(ctx context.Context, in *CoursesByTeacherRequest, opts ...grpc.CallOption) (*Courses, error)
+	ListCoursesByClass(ctx context.Context, in *CoursesByClassRequest, opts ...grpc.CallOption) (*Courses, error)
+}
+
+type coursesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoursesServiceClient(cc grpc.ClientConnInterface) CoursesServiceClient {
+	return &coursesServiceClient{cc}
+}
+
+func (c *coursesServiceClient) GetSubjects(ctx context.Context, in *GetSubjectRequest, opts ...grpc.CallOption) (*Subjects, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subjects)
+	err := c.cc.Invoke(ctx, CoursesService_GetSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) AddSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subjects)
+	err := c.cc.Invoke(ctx, CoursesService_AddSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) UpdateSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subjects)
+	err := c.cc.Invoke(ctx, CoursesService_UpdateSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeleteSubjects(ctx context.Context, in *SubjectIds, opts ...grpc.CallOption) (*DeleteSubjectsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSubjectsConfirm)
+	err := c.cc.Invoke(ctx, CoursesService_DeleteSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourses(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) AddCourses(ctx context.Context, in *Courses, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_AddCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) UpdateCourses(ctx context.Context, in *Courses, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_UpdateCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeleteCourses(ctx context.Context, in *CourseIds, opts ...grpc.CallOption) (*DeleteCoursesConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCoursesConfirm)
+	err := c.cc.Invoke(ctx, CoursesService_DeleteCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListCoursesByTeacher(ctx context.Context, in *CoursesByTeacherRequest, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_ListCoursesByTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) ListCoursesByClass(ctx context.Context, in *CoursesByClassRequest, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_ListCoursesByClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoursesServiceServer is the server API for CoursesService service.
+// All implementations must embed UnimplementedCoursesServiceServer
+// for forward compatibility.
+type CoursesServiceServer interface {
+	GetSubjects(context.Context, *GetSubjectRequest) (*Subjects, error)
+	AddSubjects(context.Context, *Subjects) (*Subjects, error)
+	UpdateSubjects(context.Context, *Subjects) (*Subjects, error)
+	DeleteSubjects(context.Context, *SubjectIds) (*DeleteSubjectsConfirm, error)
+	GetCourses(context.Context, *GetCourseRequest) (*Courses, error)
+	AddCourses(context.Context, *Courses) (*Courses, error)
+	UpdateCourses(context.Context, *Courses) (*Courses, error)
+	DeleteCourses(context.Context, *CourseIds) (*DeleteCoursesConfirm, error)
+	ListCoursesByTeacher(context.Context, *CoursesByTeacherRequest) (*Courses, error)
+	ListCoursesByClass(context.Context, *CoursesByClassRequest) (*Courses, error)
+	mustEmbedUnimplementedCoursesServiceServer()
+}
+
+// UnimplementedCoursesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoursesServiceServer struct{}
+
+func (UnimplementedCoursesServiceServer) GetSubjects(context.Context, *GetSubjectRequest) (*Subjects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubjects not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddSubjects(context.Context, *Subjects) (*Subjects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubjects not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpdateSubjects(context.Context, *Subjects) (*Subjects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubjects not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeleteSubjects(context.Context, *SubjectIds) (*DeleteSubjectsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubjects not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourses(context.Context, *GetCourseRequest) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddCourses(context.Context, *Courses) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpdateCourses(context.Context, *Courses) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeleteCourses(context.Context, *CourseIds) (*DeleteCoursesConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListCoursesByTeacher(context.Context, *CoursesByTeacherRequest) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoursesByTeacher not implemented")
+}
+func (UnimplementedCoursesServiceServer) ListCoursesByClass(context.Context, *CoursesByClassRequest) (*Courses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoursesByClass not implemented")
+}
+func (UnimplementedCoursesServiceServer) mustEmbedUnimplementedCoursesServiceServer() {}
+func (UnimplementedCoursesServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCoursesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoursesServiceServer will
+// result in compilation errors.
+type UnsafeCoursesServiceServer interface {
+	mustEmbedUnimplementedCoursesServiceServer()
+}
+
+func RegisterCoursesServiceServer(s grpc.ServiceRegistrar, srv CoursesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCoursesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoursesService_ServiceDesc, srv)
+}
+
+func _CoursesService_GetSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetSubjects(ctx, req.(*GetSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subjects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddSubjects(ctx, req.(*Subjects))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_UpdateSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subjects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).UpdateSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_UpdateSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).UpdateSubjects(ctx, req.(*Subjects))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeleteSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeleteSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeleteSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeleteSubjects(ctx, req.(*SubjectIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourses(ctx, req.(*GetCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Courses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddCourses(ctx, req.(*Courses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_UpdateCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Courses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).UpdateCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_UpdateCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).UpdateCourses(ctx, req.(*Courses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeleteCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeleteCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeleteCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeleteCourses(ctx, req.(*CourseIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListCoursesByTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoursesByTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListCoursesByTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListCoursesByTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListCoursesByTeacher(ctx, req.(*CoursesByTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_ListCoursesByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoursesByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).ListCoursesByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_ListCoursesByClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).ListCoursesByClass(ctx, req.(*CoursesByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoursesService_ServiceDesc is the grpc.ServiceDesc for CoursesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoursesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.CoursesService",
+	HandlerType: (*CoursesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSubjects",
+			Handler:    _CoursesService_GetSubjects_Handler,
+		},
+		{
+			MethodName: "AddSubjects",
+			Handler:    _CoursesService_AddSubjects_Handler,
+		},
+		{
+			MethodName: "UpdateSubjects",
+			Handler:    _CoursesService_UpdateSubjects_Handler,
+		},
+		{
+			MethodName: "DeleteSubjects",
+			Handler:    _CoursesService_DeleteSubjects_Handler,
+		},
+		{
+			MethodName: "GetCourses",
+			Handler:    _CoursesService_GetCourses_Handler,
+		},
+		{
+			MethodName: "AddCourses",
+			Handler:    _CoursesService_AddCourses_Handler,
+		},
+		{
+			MethodName: "UpdateCourses",
+			Handler:    _CoursesService_UpdateCourses_Handler,
+		},
+		{
+			MethodName: "DeleteCourses",
+			Handler:    _CoursesService_DeleteCourses_Handler,
+		},
+		{
+			MethodName: "ListCoursesByTeacher",
+			Handler:    _CoursesService_ListCoursesByTeacher_Handler,
+		},
+		{
+			MethodName: "ListCoursesByClass",
+			Handler:    _CoursesService_ListCoursesByClass_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "course.proto",
+}
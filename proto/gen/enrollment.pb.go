@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: enrollment.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentRequest) Reset() {
+	*x = EnrollmentRequest{}
+	mi := &file_enrollment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentRequest) ProtoMessage() {}
+
+func (x *EnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_enrollment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_enrollment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnrollmentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *EnrollmentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type EnrollmentsByStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentsByStudentRequest) Reset() {
+	*x = EnrollmentsByStudentRequest{}
+	mi := &file_enrollment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentsByStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentsByStudentRequest) ProtoMessage() {}
+
+func (x *EnrollmentsByStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_enrollment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentsByStudentRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentsByStudentRequest) Descriptor() ([]byte, []int) {
+	return file_enrollment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnrollmentsByStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *EnrollmentsByStudentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type EnrollmentsByCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentsByCourseRequest) Reset() {
+	*x = EnrollmentsByCourseRequest{}
+	mi := &file_enrollment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentsByCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentsByCourseRequest) ProtoMessage() {}
+
+func (x *EnrollmentsByCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_enrollment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentsByCourseRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentsByCourseRequest) Descriptor() ([]byte, []int) {
+	return file_enrollment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnrollmentsByCourseRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *EnrollmentsByCourseRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// status is one of enrolled, waitlisted, dropped, completed
+type Enrollment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId        string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId         string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	WaitlistPosition int32                  `protobuf:"varint,5,opt,name=waitlist_position,json=waitlistPosition,proto3" json:"waitlist_position,omitempty"`
+	RequestedAt      string                 `protobuf:"bytes,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	EnrolledAt       string                 `protobuf:"bytes,7,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"`
+	DroppedAt        string                 `protobuf:"bytes,8,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	mi := &file_enrollment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_enrollment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_enrollment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Enrollment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Enrollment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Enrollment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Enrollment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Enrollment) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+func (x *Enrollment) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *Enrollment) GetEnrolledAt() string {
+	if x != nil {
+		return x.EnrolledAt
+	}
+	return ""
+}
+
+func (x *Enrollment) GetDroppedAt() string {
+	if x != nil {
+		return x.DroppedAt
+	}
+	return ""
+}
+
+type Enrollments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrollments) Reset() {
+	*x = Enrollments{}
+	mi := &file_enrollment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollments) ProtoMessage() {}
+
+func (x *Enrollments) ProtoReflect() protoreflect.Message {
+	mi := &file_enrollment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
+func (*Enrollments) Descriptor() ([]byte, []int) {
+	return file_enrollment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Enrollments) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+var File_enrollment_proto protoreflect.FileDescriptor
+
+const file_enrollment_proto_rawDesc = "" +
+	"\n" +
+	"\x10enrollment.proto\x12\x04main\x1a\x17validate/validate.proto\"\x8b\x01\n" +
+	"\x11EnrollmentRequest\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x129\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\bcourseId\"\xa5\x01\n" +
+	"\x1bEnrollmentsByStudentRequest\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12I\n" +
+	"\x06status\x18\x02 \x01(\tB1\xfaB.r,R\x00R\benrolledR\n" +
+	"waitlistedR\adroppedR\tcompletedR\x06status\"\xa2\x01\n" +
+	"\x1aEnrollmentsByCourseRequest\x129\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\bcourseId\x12I\n" +
+	"\x06status\x18\x02 \x01(\tB1\xfaB.r,R\x00R\benrolledR\n" +
+	"waitlistedR\adroppedR\tcompletedR\x06status\"\x80\x02\n" +
+	"\n" +
+	"Enrollment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12+\n" +
+	"\x11waitlist_position\x18\x05 \x01(\x05R\x10waitlistPosition\x12!\n" +
+	"\frequested_at\x18\x06 \x01(\tR\vrequestedAt\x12\x1f\n" +
+	"\venrolled_at\x18\a \x01(\tR\n" +
+	"enrolledAt\x12\x1d\n" +
+	"\n" +
+	"dropped_at\x18\b \x01(\tR\tdroppedAt\"A\n" +
+	"\vEnrollments\x122\n" +
+	"\venrollments\x18\x01 \x03(\v2\x10.main.EnrollmentR\venrollments2\x9d\x02\n" +
+	"\x11EnrollmentService\x123\n" +
+	"\x06Enroll\x12\x17.main.EnrollmentRequest\x1a\x10.main.Enrollment\x121\n" +
+	"\x04Drop\x12\x17.main.EnrollmentRequest\x1a\x10.main.Enrollment\x12P\n" +
+	"\x18ListEnrollmentsByStudent\x12!.main.EnrollmentsByStudentRequest\x1a\x11.main.Enrollments\x12N\n" +
+	"\x17ListEnrollmentsByCourse\x12 .main.EnrollmentsByCourseRequest\x1a\x11.main.EnrollmentsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_enrollment_proto_rawDescOnce sync.Once
+	file_enrollment_proto_rawDescData []byte
+)
+
+func file_enrollment_proto_rawDescGZIP() []byte {
+	file_enrollment_proto_rawDescOnce.Do(func() {
+		file_enrollment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_enrollment_proto_rawDesc), len(file_enrollment_proto_rawDesc)))
+	})
+	return file_enrollment_proto_rawDescData
+}
+
+var file_enrollment_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_enrollment_proto_goTypes = []any{
+	(*EnrollmentRequest)(nil),           // 0: main.EnrollmentRequest
+	(*EnrollmentsByStudentRequest)(nil), // 1: main.EnrollmentsByStudentRequest
+	(*EnrollmentsByCourseRequest)(nil),  // 2: main.EnrollmentsByCourseRequest
+	(*Enrollment)(nil),                  // 3: main.Enrollment
+	(*Enrollments)(nil),                 // 4: main.Enrollments
+}
+var file_enrollment_proto_depIdxs = []int32{
+	3, // 0: main.Enrollments.enrollments:type_name -> main.Enrollment
+	0, // 1: main.EnrollmentService.Enroll:input_type -> main.EnrollmentRequest
+	0, // 2: main.EnrollmentService.Drop:input_type -> main.EnrollmentRequest
+	1, // 3: main.EnrollmentService.ListEnrollmentsByStudent:input_type -> main.EnrollmentsByStudentRequest
+	2, // 4: main.EnrollmentService.ListEnrollmentsByCourse:input_type -> main.EnrollmentsByCourseRequest
+	3, // 5: main.EnrollmentService.Enroll:output_type -> main.Enrollment
+	3, // 6: main.EnrollmentService.Drop:output_type -> main.Enrollment
+	4, // 7: main.EnrollmentService.ListEnrollmentsByStudent:output_type -> main.Enrollments
+	4, // 8: main.EnrollmentService.ListEnrollmentsByCourse:output_type -> main.Enrollments
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_enrollment_proto_init() }
+func file_enrollment_proto_init() {
+	if File_enrollment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_enrollment_proto_rawDesc), len(file_enrollment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_enrollment_proto_goTypes,
+		DependencyIndexes: file_enrollment_proto_depIdxs,
+		MessageInfos:      file_enrollment_proto_msgTypes,
+	}.Build()
+	File_enrollment_proto = out.File
+	file_enrollment_proto_goTypes = nil
+	file_enrollment_proto_depIdxs = nil
+}
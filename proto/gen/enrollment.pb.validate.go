@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: enrollment.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on EnrollmentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrollmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollmentRequestMultiError, or nil if none found.
+func (m *EnrollmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := EnrollmentRequestValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_EnrollmentRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := EnrollmentRequestValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCourseId()) != 24 {
+		err := EnrollmentRequestValidationError{
+			field:  "CourseId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_EnrollmentRequest_CourseId_Pattern.MatchString(m.GetCourseId()) {
+		err := EnrollmentRequestValidationError{
+			field:  "CourseId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnrollmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollmentRequestMultiError is an error wrapping multiple validation errors
+// returned by EnrollmentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollmentRequestMultiError) AllErrors() []error { return m }
+
+// EnrollmentRequestValidationError is the validation error returned by
+// EnrollmentRequest.Validate if the designated constraints aren't met.
+type EnrollmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollmentRequestValidationError) ErrorName() string {
+	return "EnrollmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollmentRequestValidationError{}
+
+var _EnrollmentRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _EnrollmentRequest_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on EnrollmentsByStudentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollmentsByStudentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollmentsByStudentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollmentsByStudentRequestMultiError, or nil if none found.
+func (m *EnrollmentsByStudentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollmentsByStudentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := EnrollmentsByStudentRequestValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_EnrollmentsByStudentRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := EnrollmentsByStudentRequestValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _EnrollmentsByStudentRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := EnrollmentsByStudentRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ enrolled waitlisted dropped completed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnrollmentsByStudentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollmentsByStudentRequestMultiError is an error wrapping multiple
+// validation errors returned by EnrollmentsByStudentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type EnrollmentsByStudentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollmentsByStudentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollmentsByStudentRequestMultiError) AllErrors() []error { return m }
+
+// EnrollmentsByStudentRequestValidationError is the validation error returned
+// by EnrollmentsByStudentRequest.Validate if the designated constraints
+// aren't met.
+type EnrollmentsByStudentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollmentsByStudentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollmentsByStudentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollmentsByStudentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollmentsByStudentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollmentsByStudentRequestValidationError) ErrorName() string {
+	return "EnrollmentsByStudentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollmentsByStudentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollmentsByStudentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollmentsByStudentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollmentsByStudentRequestValidationError{}
+
+var _EnrollmentsByStudentRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _EnrollmentsByStudentRequest_Status_InLookup = map[string]struct{}{
+	"":           {},
+	"enrolled":   {},
+	"waitlisted": {},
+	"dropped":    {},
+	"completed":  {},
+}
+
+// Validate checks the field values on EnrollmentsByCourseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollmentsByCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollmentsByCourseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollmentsByCourseRequestMultiError, or nil if none found.
+func (m *EnrollmentsByCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollmentsByCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCourseId()) != 24 {
+		err := EnrollmentsByCourseRequestValidationError{
+			field:  "CourseId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_EnrollmentsByCourseRequest_CourseId_Pattern.MatchString(m.GetCourseId()) {
+		err := EnrollmentsByCourseRequestValidationError{
+			field:  "CourseId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _EnrollmentsByCourseRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := EnrollmentsByCourseRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ enrolled waitlisted dropped completed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnrollmentsByCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollmentsByCourseRequestMultiError is an error wrapping multiple
+// validation errors returned by EnrollmentsByCourseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type EnrollmentsByCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollmentsByCourseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollmentsByCourseRequestMultiError) AllErrors() []error { return m }
+
+// EnrollmentsByCourseRequestValidationError is the validation error returned
+// by EnrollmentsByCourseRequest.Validate if the designated constraints aren't met.
+type EnrollmentsByCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollmentsByCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollmentsByCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollmentsByCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollmentsByCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollmentsByCourseRequestValidationError) ErrorName() string {
+	return "EnrollmentsByCourseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollmentsByCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollmentsByCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollmentsByCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollmentsByCourseRequestValidationError{}
+
+var _EnrollmentsByCourseRequest_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _EnrollmentsByCourseRequest_Status_InLookup = map[string]struct{}{
+	"":           {},
+	"enrolled":   {},
+	"waitlisted": {},
+	"dropped":    {},
+	"completed":  {},
+}
+
+// Validate checks the field values on Enrollment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Enrollment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Enrollment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollmentMultiError, or
+// nil if none found.
+func (m *Enrollment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Enrollment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for StudentId
+
+	// no validation rules for CourseId
+
+	// no validation rules for Status
+
+	// no validation rules for WaitlistPosition
+
+	// no validation rules for RequestedAt
+
+	// no validation rules for EnrolledAt
+
+	// no validation rules for DroppedAt
+
+	if len(errors) > 0 {
+		return EnrollmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollmentMultiError is an error wrapping multiple validation errors
+// returned by Enrollment.ValidateAll() if the designated constraints aren't met.
+type EnrollmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollmentMultiError) AllErrors() []error { return m }
+
+// EnrollmentValidationError is the validation error returned by
+// Enrollment.Validate if the designated constraints aren't met.
+type EnrollmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollmentValidationError) ErrorName() string { return "EnrollmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollmentValidationError{}
+
+// Validate checks the field values on Enrollments with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Enrollments) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Enrollments with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollmentsMultiError, or
+// nil if none found.
+func (m *Enrollments) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Enrollments) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEnrollments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EnrollmentsValidationError{
+						field:  fmt.Sprintf("Enrollments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EnrollmentsValidationError{
+						field:  fmt.Sprintf("Enrollments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EnrollmentsValidationError{
+					field:  fmt.Sprintf("Enrollments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EnrollmentsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollmentsMultiError is an error wrapping multiple validation errors
+// returned by Enrollments.ValidateAll() if the designated constraints aren't met.
+type EnrollmentsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollmentsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollmentsMultiError) AllErrors() []error { return m }
+
+// EnrollmentsValidationError is the validation error returned by
+// Enrollments.Validate if the designated constraints aren't met.
+type EnrollmentsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollmentsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollmentsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollmentsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollmentsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollmentsValidationError) ErrorName() string { return "EnrollmentsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollmentsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollments.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollmentsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollmentsValidationError{}
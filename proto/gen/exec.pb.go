@@ -570,6 +570,58 @@ func (x *DeleteExecsConfirm) GetDeletedIds() []string {
 	return nil
 }
 
+type RestoreExecsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExecsConfirm) Reset() {
+	*x = RestoreExecsConfirm{}
+	mi := &file_exec_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExecsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExecsConfirm) ProtoMessage() {}
+
+func (x *RestoreExecsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExecsConfirm.ProtoReflect.Descriptor instead.
+func (*RestoreExecsConfirm) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreExecsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreExecsConfirm) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
 type ExecIds struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExecIds       []string               `protobuf:"bytes,1,rep,name=execIds,proto3" json:"execIds,omitempty"`
@@ -579,7 +631,7 @@ type ExecIds struct {
 
 func (x *ExecIds) Reset() {
 	*x = ExecIds{}
-	mi := &file_exec_proto_msgTypes[11]
+	mi := &file_exec_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +643,7 @@ func (x *ExecIds) String() string {
 func (*ExecIds) ProtoMessage() {}
 
 func (x *ExecIds) ProtoReflect() protoreflect.Message {
-	mi := &file_exec_proto_msgTypes[11]
+	mi := &file_exec_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +656,7 @@ func (x *ExecIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecIds.ProtoReflect.Descriptor instead.
 func (*ExecIds) Descriptor() ([]byte, []int) {
-	return file_exec_proto_rawDescGZIP(), []int{11}
+	return file_exec_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ExecIds) GetExecIds() []string {
@@ -615,16 +667,27 @@ func (x *ExecIds) GetExecIds() []string {
 }
 
 type GetExecRequset struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exec          *Exec                  `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
-	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exec           *Exec                  `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// 10 when 0, capped by the server like the other lists
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next_page_token of the previous page, the first page when empty. a token only works with the sort it was
+	// made for
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// count every record matching the filter into total_size, an extra query
+	IncludeTotalSize bool `protobuf:"varint,6,opt,name=include_total_size,json=includeTotalSize,proto3" json:"include_total_size,omitempty"`
+	// filter expression like: role IN (admin, manager) AND user_created_at >= 2024-09-01, matched together with the fields set above.
+	// = (a trailing * matches a prefix), != < <= > >= : (contains, ignoring case), IN, AND, OR, NOT and parentheses
+	Filter        string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetExecRequset) Reset() {
 	*x = GetExecRequset{}
-	mi := &file_exec_proto_msgTypes[12]
+	mi := &file_exec_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +699,7 @@ func (x *GetExecRequset) String() string {
 func (*GetExecRequset) ProtoMessage() {}
 
 func (x *GetExecRequset) ProtoReflect() protoreflect.Message {
-	mi := &file_exec_proto_msgTypes[12]
+	mi := &file_exec_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +712,7 @@ func (x *GetExecRequset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExecRequset.ProtoReflect.Descriptor instead.
 func (*GetExecRequset) Descriptor() ([]byte, []int) {
-	return file_exec_proto_rawDescGZIP(), []int{12}
+	return file_exec_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetExecRequset) GetExec() *Exec {
@@ -666,6 +729,41 @@ func (x *GetExecRequset) GetSortBy() []*SortField {
 	return nil
 }
 
+func (x *GetExecRequset) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetExecRequset) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetExecRequset) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetExecRequset) GetIncludeTotalSize() bool {
+	if x != nil {
+		return x.IncludeTotalSize
+	}
+	return false
+}
+
+func (x *GetExecRequset) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type Exec struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -680,13 +778,17 @@ type Exec struct {
 	PasswordTokenExp   string                 `protobuf:"bytes,10,opt,name=passwordTokenExp,proto3" json:"passwordTokenExp,omitempty"`
 	Role               string                 `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
 	InactiveStatus     bool                   `protobuf:"varint,12,opt,name=inactiveStatus,proto3" json:"inactiveStatus,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	DeletedAt          string                 `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy          string                 `protobuf:"bytes,14,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// the student or teacher a student or teacher account belongs to, tokens of these accounts carry it as uid
+	ProfileId     string `protobuf:"bytes,15,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Exec) Reset() {
 	*x = Exec{}
-	mi := &file_exec_proto_msgTypes[13]
+	mi := &file_exec_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +800,7 @@ func (x *Exec) String() string {
 func (*Exec) ProtoMessage() {}
 
 func (x *Exec) ProtoReflect() protoreflect.Message {
-	mi := &file_exec_proto_msgTypes[13]
+	mi := &file_exec_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +813,7 @@ func (x *Exec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Exec.ProtoReflect.Descriptor instead.
 func (*Exec) Descriptor() ([]byte, []int) {
-	return file_exec_proto_rawDescGZIP(), []int{13}
+	return file_exec_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Exec) GetId() string {
@@ -798,16 +900,41 @@ func (x *Exec) GetInactiveStatus() bool {
 	return false
 }
 
+func (x *Exec) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Exec) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Exec) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
 type Execs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Execs         []*Exec                `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Execs []*Exec                `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// only set when include_total_size was asked for
+	TotalSize     int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Execs) Reset() {
 	*x = Execs{}
-	mi := &file_exec_proto_msgTypes[14]
+	mi := &file_exec_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -819,7 +946,7 @@ func (x *Execs) String() string {
 func (*Execs) ProtoMessage() {}
 
 func (x *Execs) ProtoReflect() protoreflect.Message {
-	mi := &file_exec_proto_msgTypes[14]
+	mi := &file_exec_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,7 +959,7 @@ func (x *Execs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Execs.ProtoReflect.Descriptor instead.
 func (*Execs) Descriptor() ([]byte, []int) {
-	return file_exec_proto_rawDescGZIP(), []int{14}
+	return file_exec_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Execs) GetExecs() []*Exec {
@@ -842,12 +969,582 @@ func (x *Execs) GetExecs() []*Exec {
 	return nil
 }
 
+func (x *Execs) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *Execs) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// the caller's own account, and the student or teacher profile of a student or teacher account
+type MeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Account       *Exec                  `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Student       *Student               `protobuf:"bytes,3,opt,name=student,proto3" json:"student,omitempty"`
+	Teacher       *Teacher               `protobuf:"bytes,4,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeResponse) Reset() {
+	*x = MeResponse{}
+	mi := &file_exec_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeResponse) ProtoMessage() {}
+
+func (x *MeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeResponse.ProtoReflect.Descriptor instead.
+func (*MeResponse) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MeResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MeResponse) GetAccount() *Exec {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *MeResponse) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *MeResponse) GetTeacher() *Teacher {
+	if x != nil {
+		return x.Teacher
+	}
+	return nil
+}
+
+// the invite code from the invite email and the password the new user chooses
+type AcceptInviteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode      string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ConfirmPassword string                 `protobuf:"bytes,3,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptInviteRequest) Reset() {
+	*x = AcceptInviteRequest{}
+	mi := &file_exec_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteRequest) ProtoMessage() {}
+
+func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AcceptInviteRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *AcceptInviteRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *AcceptInviteRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+// invites of users that have not set their password yet, expired and revoked ones only when asked for
+type ListInvitesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeExpired bool                   `protobuf:"varint,1,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	IncludeRevoked bool                   `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_exec_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListInvitesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+func (x *ListInvitesRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+// status is one of pending, expired, revoked
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecId        string                 `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedAt     string                 `protobuf:"bytes,5,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_exec_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Invite) GetExecId() string {
+	if x != nil {
+		return x.ExecId
+	}
+	return ""
+}
+
+func (x *Invite) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Invite) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invite) GetInvitedAt() string {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return ""
+}
+
+func (x *Invite) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Invite) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Invites struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invites) Reset() {
+	*x = Invites{}
+	mi := &file_exec_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invites) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invites) ProtoMessage() {}
+
+func (x *Invites) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invites.ProtoReflect.Descriptor instead.
+func (*Invites) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Invites) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type InviteConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ExecIds       []string               `protobuf:"bytes,2,rep,name=exec_ids,json=execIds,proto3" json:"exec_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteConfirm) Reset() {
+	*x = InviteConfirm{}
+	mi := &file_exec_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteConfirm) ProtoMessage() {}
+
+func (x *InviteConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteConfirm.ProtoReflect.Descriptor instead.
+func (*InviteConfirm) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InviteConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InviteConfirm) GetExecIds() []string {
+	if x != nil {
+		return x.ExecIds
+	}
+	return nil
+}
+
+// resume_token is the resume_token of the last event the client got, the watch continues after it
+type WatchExecsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exec          *Exec                  `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchExecsRequest) Reset() {
+	*x = WatchExecsRequest{}
+	mi := &file_exec_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExecsRequest) ProtoMessage() {}
+
+func (x *WatchExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExecsRequest.ProtoReflect.Descriptor instead.
+func (*WatchExecsRequest) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WatchExecsRequest) GetExec() *Exec {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *WatchExecsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// operation is created, updated, deleted or restored, deleted and restored are the soft delete and its undo.
+// passwords and tokens are never sent
+type ExecEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Exec          *Exec                  `protobuf:"bytes,2,opt,name=exec,proto3" json:"exec,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecEvent) Reset() {
+	*x = ExecEvent{}
+	mi := &file_exec_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecEvent) ProtoMessage() {}
+
+func (x *ExecEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecEvent.ProtoReflect.Descriptor instead.
+func (*ExecEvent) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExecEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ExecEvent) GetExec() *Exec {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *ExecEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ExecEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ExportExecsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *GetExecRequset        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Options       *ExportOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportExecsRequest) Reset() {
+	*x = ExportExecsRequest{}
+	mi := &file_exec_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportExecsRequest) ProtoMessage() {}
+
+func (x *ExportExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exec_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportExecsRequest.ProtoReflect.Descriptor instead.
+func (*ExportExecsRequest) Descriptor() ([]byte, []int) {
+	return file_exec_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportExecsRequest) GetQuery() *GetExecRequset {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExportExecsRequest) GetOptions() *ExportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 var File_exec_proto protoreflect.FileDescriptor
 
 const file_exec_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"exec.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"\x8e\x01\n" +
+	"exec.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\x1a\n" +
+	"main.proto\"\x8e\x01\n" +
 	"\x10ExecLogInRequest\x12<\n" +
 	"\busername\x18\x01 \x01(\tB \xfaB\x1dr\x1b\x10\x062\x14^[a-zA-Z0-9@.#$+-]+$\xd0\x01\x00R\busername\x12<\n" +
 	"\bpassword\x18\x02 \x01(\tB \xfaB\x1dr\x1b\x10\x062\x14^[a-zA-Z0-9@.#$+-]+$\xd0\x01\x00R\bpassword\"A\n" +
@@ -880,13 +1577,22 @@ const file_exec_proto_rawDesc = "" +
 	"\x12DeleteExecsConfirm\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"#\n" +
+	"deletedIds\"P\n" +
+	"\x13RestoreExecsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\"#\n" +
 	"\aExecIds\x12\x18\n" +
-	"\aexecIds\x18\x01 \x03(\tR\aexecIds\"Z\n" +
+	"\aexecIds\x18\x01 \x03(\tR\aexecIds\"\x85\x02\n" +
 	"\x0eGetExecRequset\x12\x1e\n" +
 	"\x04exec\x18\x01 \x01(\v2\n" +
 	".main.ExecR\x04exec\x12(\n" +
-	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x83\x04\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12,\n" +
+	"\x12include_total_size\x18\x06 \x01(\bR\x10includeTotalSize\x12\x16\n" +
+	"\x06filter\x18\a \x01(\tR\x06filter\"\xfd\x04\n" +
 	"\x04Exec\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\n" +
@@ -901,22 +1607,86 @@ const file_exec_proto_rawDesc = "" +
 	"\x10passwordTokenExp\x18\n" +
 	" \x01(\tR\x10passwordTokenExp\x12\x12\n" +
 	"\x04role\x18\v \x01(\tR\x04role\x12&\n" +
-	"\x0einactiveStatus\x18\f \x01(\bR\x0einactiveStatus\")\n" +
+	"\x0einactiveStatus\x18\f \x01(\bR\x0einactiveStatus\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0e \x01(\tR\tdeletedBy\x12:\n" +
+	"\n" +
+	"profile_id\x18\x0f \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tprofileId\"p\n" +
 	"\x05Execs\x12 \n" +
 	"\x05execs\x18\x01 \x03(\v2\n" +
-	".main.ExecR\x05execs2\xf9\x04\n" +
+	".main.ExecR\x05execs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x98\x01\n" +
+	"\n" +
+	"MeResponse\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12$\n" +
+	"\aaccount\x18\x02 \x01(\v2\n" +
+	".main.ExecR\aaccount\x12'\n" +
+	"\astudent\x18\x03 \x01(\v2\r.main.StudentR\astudent\x12'\n" +
+	"\ateacher\x18\x04 \x01(\v2\r.main.TeacherR\ateacher\"\xac\x01\n" +
+	"\x13AcceptInviteRequest\x12(\n" +
+	"\vinvite_code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"inviteCode\x12@\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18\x10\x062\x14^[a-zA-Z0-9@.#$+-]+$R\vnewPassword\x12)\n" +
+	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\"f\n" +
+	"\x12ListInvitesRequest\x12'\n" +
+	"\x0finclude_expired\x18\x01 \x01(\bR\x0eincludeExpired\x12'\n" +
+	"\x0finclude_revoked\x18\x02 \x01(\bR\x0eincludeRevoked\"\xdc\x01\n" +
+	"\x06Invite\x12\x17\n" +
+	"\aexec_id\x18\x01 \x01(\tR\x06execId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_at\x18\x05 \x01(\tR\tinvitedAt\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tR\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"1\n" +
+	"\aInvites\x12&\n" +
+	"\ainvites\x18\x01 \x03(\v2\f.main.InviteR\ainvites\"B\n" +
+	"\rInviteConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\bexec_ids\x18\x02 \x03(\tR\aexecIds\"V\n" +
+	"\x11WatchExecsRequest\x12\x1e\n" +
+	"\x04exec\x18\x01 \x01(\v2\n" +
+	".main.ExecR\x04exec\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\x8d\x01\n" +
+	"\tExecEvent\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x1e\n" +
+	"\x04exec\x18\x02 \x01(\v2\n" +
+	".main.ExecR\x04exec\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"o\n" +
+	"\x12ExportExecsRequest\x12*\n" +
+	"\x05query\x18\x01 \x01(\v2\x14.main.GetExecRequsetR\x05query\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.main.ExportOptionsR\aoptions2\xb6\b\n" +
 	"\fExecsService\x12-\n" +
 	"\bGetExecs\x12\x14.main.GetExecRequset\x1a\v.main.Execs\x12$\n" +
 	"\bAddExecs\x12\v.main.Execs\x1a\v.main.Execs\x12'\n" +
 	"\vUpdateExecs\x12\v.main.Execs\x1a\v.main.Execs\x126\n" +
 	"\vDeleteExecs\x12\r.main.ExecIds\x1a\x18.main.DeleteExecsConfirm\x128\n" +
+	"\fRestoreExecs\x12\r.main.ExecIds\x1a\x19.main.RestoreExecsConfirm\x128\n" +
+	"\n" +
+	"WatchExecs\x12\x17.main.WatchExecsRequest\x1a\x0f.main.ExecEvent0\x01\x12<\n" +
+	"\vExportExecs\x12\x18.main.ExportExecsRequest\x1a\x11.main.ExportChunk0\x01\x128\n" +
 	"\x05Login\x12\x16.main.ExecLogInRequest\x1a\x17.main.ExecLogInResponse\x126\n" +
 	"\x06Logout\x12\x12.main.EmptyRequest\x1a\x18.main.ExecLogoutResponse\x12K\n" +
 	"\x0eUpdatePassword\x12\x1b.main.UpdatePasswordRequest\x1a\x1c.main.UpdatePasswordResponse\x12>\n" +
 	"\rResetPassword\x12\x19.main.ResetPasswordRequst\x1a\x12.main.Confirmation\x12J\n" +
 	"\x0eForgotPassword\x12\x1a.main.ForgotPasswordRequst\x1a\x1c.main.ForgotPasswordResponse\x123\n" +
 	"\x0eDeactivateUser\x12\r.main.ExecIds\x1a\x12.main.Confirmation\x123\n" +
-	"\x0eReactivateUser\x12\r.main.ExecIds\x1a\x12.main.ConfirmationB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+	"\x0eReactivateUser\x12\r.main.ExecIds\x1a\x12.main.Confirmation\x12*\n" +
+	"\x02Me\x12\x12.main.EmptyRequest\x1a\x10.main.MeResponse\x12=\n" +
+	"\fAcceptInvite\x12\x19.main.AcceptInviteRequest\x1a\x12.main.Confirmation\x126\n" +
+	"\vListInvites\x12\x18.main.ListInvitesRequest\x1a\r.main.Invites\x122\n" +
+	"\fResendInvite\x12\r.main.ExecIds\x1a\x13.main.InviteConfirm\x122\n" +
+	"\fRevokeInvite\x12\r.main.ExecIds\x1a\x13.main.InviteConfirmB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_exec_proto_rawDescOnce sync.Once
@@ -930,7 +1700,7 @@ func file_exec_proto_rawDescGZIP() []byte {
 	return file_exec_proto_rawDescData
 }
 
-var file_exec_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_exec_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_exec_proto_goTypes = []any{
 	(*ExecLogInRequest)(nil),       // 0: main.ExecLogInRequest
 	(*ExecLogInResponse)(nil),      // 1: main.ExecLogInResponse
@@ -943,43 +1713,81 @@ var file_exec_proto_goTypes = []any{
 	(*ExecLogoutResponse)(nil),     // 8: main.ExecLogoutResponse
 	(*UpdatePasswordRequest)(nil),  // 9: main.UpdatePasswordRequest
 	(*DeleteExecsConfirm)(nil),     // 10: main.DeleteExecsConfirm
-	(*ExecIds)(nil),                // 11: main.ExecIds
-	(*GetExecRequset)(nil),         // 12: main.GetExecRequset
-	(*Exec)(nil),                   // 13: main.Exec
-	(*Execs)(nil),                  // 14: main.Execs
-	(*SortField)(nil),              // 15: main.SortField
+	(*RestoreExecsConfirm)(nil),    // 11: main.RestoreExecsConfirm
+	(*ExecIds)(nil),                // 12: main.ExecIds
+	(*GetExecRequset)(nil),         // 13: main.GetExecRequset
+	(*Exec)(nil),                   // 14: main.Exec
+	(*Execs)(nil),                  // 15: main.Execs
+	(*MeResponse)(nil),             // 16: main.MeResponse
+	(*AcceptInviteRequest)(nil),    // 17: main.AcceptInviteRequest
+	(*ListInvitesRequest)(nil),     // 18: main.ListInvitesRequest
+	(*Invite)(nil),                 // 19: main.Invite
+	(*Invites)(nil),                // 20: main.Invites
+	(*InviteConfirm)(nil),          // 21: main.InviteConfirm
+	(*WatchExecsRequest)(nil),      // 22: main.WatchExecsRequest
+	(*ExecEvent)(nil),              // 23: main.ExecEvent
+	(*ExportExecsRequest)(nil),     // 24: main.ExportExecsRequest
+	(*SortField)(nil),              // 25: main.SortField
+	(*Student)(nil),                // 26: main.Student
+	(*Teacher)(nil),                // 27: main.Teacher
+	(*ExportOptions)(nil),          // 28: main.ExportOptions
+	(*ExportChunk)(nil),            // 29: main.ExportChunk
 }
 var file_exec_proto_depIdxs = []int32{
-	13, // 0: main.GetExecRequset.exec:type_name -> main.Exec
-	15, // 1: main.GetExecRequset.sort_by:type_name -> main.SortField
-	13, // 2: main.Execs.execs:type_name -> main.Exec
-	12, // 3: main.ExecsService.GetExecs:input_type -> main.GetExecRequset
-	14, // 4: main.ExecsService.AddExecs:input_type -> main.Execs
-	14, // 5: main.ExecsService.UpdateExecs:input_type -> main.Execs
-	11, // 6: main.ExecsService.DeleteExecs:input_type -> main.ExecIds
-	0,  // 7: main.ExecsService.Login:input_type -> main.ExecLogInRequest
-	7,  // 8: main.ExecsService.Logout:input_type -> main.EmptyRequest
-	9,  // 9: main.ExecsService.UpdatePassword:input_type -> main.UpdatePasswordRequest
-	4,  // 10: main.ExecsService.ResetPassword:input_type -> main.ResetPasswordRequst
-	2,  // 11: main.ExecsService.ForgotPassword:input_type -> main.ForgotPasswordRequst
-	11, // 12: main.ExecsService.DeactivateUser:input_type -> main.ExecIds
-	11, // 13: main.ExecsService.ReactivateUser:input_type -> main.ExecIds
-	14, // 14: main.ExecsService.GetExecs:output_type -> main.Execs
-	14, // 15: main.ExecsService.AddExecs:output_type -> main.Execs
-	14, // 16: main.ExecsService.UpdateExecs:output_type -> main.Execs
-	10, // 17: main.ExecsService.DeleteExecs:output_type -> main.DeleteExecsConfirm
-	1,  // 18: main.ExecsService.Login:output_type -> main.ExecLogInResponse
-	8,  // 19: main.ExecsService.Logout:output_type -> main.ExecLogoutResponse
-	6,  // 20: main.ExecsService.UpdatePassword:output_type -> main.UpdatePasswordResponse
-	5,  // 21: main.ExecsService.ResetPassword:output_type -> main.Confirmation
-	3,  // 22: main.ExecsService.ForgotPassword:output_type -> main.ForgotPasswordResponse
-	5,  // 23: main.ExecsService.DeactivateUser:output_type -> main.Confirmation
-	5,  // 24: main.ExecsService.ReactivateUser:output_type -> main.Confirmation
-	14, // [14:25] is the sub-list for method output_type
-	3,  // [3:14] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	14, // 0: main.GetExecRequset.exec:type_name -> main.Exec
+	25, // 1: main.GetExecRequset.sort_by:type_name -> main.SortField
+	14, // 2: main.Execs.execs:type_name -> main.Exec
+	14, // 3: main.MeResponse.account:type_name -> main.Exec
+	26, // 4: main.MeResponse.student:type_name -> main.Student
+	27, // 5: main.MeResponse.teacher:type_name -> main.Teacher
+	19, // 6: main.Invites.invites:type_name -> main.Invite
+	14, // 7: main.WatchExecsRequest.exec:type_name -> main.Exec
+	14, // 8: main.ExecEvent.exec:type_name -> main.Exec
+	13, // 9: main.ExportExecsRequest.query:type_name -> main.GetExecRequset
+	28, // 10: main.ExportExecsRequest.options:type_name -> main.ExportOptions
+	13, // 11: main.ExecsService.GetExecs:input_type -> main.GetExecRequset
+	15, // 12: main.ExecsService.AddExecs:input_type -> main.Execs
+	15, // 13: main.ExecsService.UpdateExecs:input_type -> main.Execs
+	12, // 14: main.ExecsService.DeleteExecs:input_type -> main.ExecIds
+	12, // 15: main.ExecsService.RestoreExecs:input_type -> main.ExecIds
+	22, // 16: main.ExecsService.WatchExecs:input_type -> main.WatchExecsRequest
+	24, // 17: main.ExecsService.ExportExecs:input_type -> main.ExportExecsRequest
+	0,  // 18: main.ExecsService.Login:input_type -> main.ExecLogInRequest
+	7,  // 19: main.ExecsService.Logout:input_type -> main.EmptyRequest
+	9,  // 20: main.ExecsService.UpdatePassword:input_type -> main.UpdatePasswordRequest
+	4,  // 21: main.ExecsService.ResetPassword:input_type -> main.ResetPasswordRequst
+	2,  // 22: main.ExecsService.ForgotPassword:input_type -> main.ForgotPasswordRequst
+	12, // 23: main.ExecsService.DeactivateUser:input_type -> main.ExecIds
+	12, // 24: main.ExecsService.ReactivateUser:input_type -> main.ExecIds
+	7,  // 25: main.ExecsService.Me:input_type -> main.EmptyRequest
+	17, // 26: main.ExecsService.AcceptInvite:input_type -> main.AcceptInviteRequest
+	18, // 27: main.ExecsService.ListInvites:input_type -> main.ListInvitesRequest
+	12, // 28: main.ExecsService.ResendInvite:input_type -> main.ExecIds
+	12, // 29: main.ExecsService.RevokeInvite:input_type -> main.ExecIds
+	15, // 30: main.ExecsService.GetExecs:output_type -> main.Execs
+	15, // 31: main.ExecsService.AddExecs:output_type -> main.Execs
+	15, // 32: main.ExecsService.UpdateExecs:output_type -> main.Execs
+	10, // 33: main.ExecsService.DeleteExecs:output_type -> main.DeleteExecsConfirm
+	11, // 34: main.ExecsService.RestoreExecs:output_type -> main.RestoreExecsConfirm
+	23, // 35: main.ExecsService.WatchExecs:output_type -> main.ExecEvent
+	29, // 36: main.ExecsService.ExportExecs:output_type -> main.ExportChunk
+	1,  // 37: main.ExecsService.Login:output_type -> main.ExecLogInResponse
+	8,  // 38: main.ExecsService.Logout:output_type -> main.ExecLogoutResponse
+	6,  // 39: main.ExecsService.UpdatePassword:output_type -> main.UpdatePasswordResponse
+	5,  // 40: main.ExecsService.ResetPassword:output_type -> main.Confirmation
+	3,  // 41: main.ExecsService.ForgotPassword:output_type -> main.ForgotPasswordResponse
+	5,  // 42: main.ExecsService.DeactivateUser:output_type -> main.Confirmation
+	5,  // 43: main.ExecsService.ReactivateUser:output_type -> main.Confirmation
+	16, // 44: main.ExecsService.Me:output_type -> main.MeResponse
+	5,  // 45: main.ExecsService.AcceptInvite:output_type -> main.Confirmation
+	20, // 46: main.ExecsService.ListInvites:output_type -> main.Invites
+	21, // 47: main.ExecsService.ResendInvite:output_type -> main.InviteConfirm
+	21, // 48: main.ExecsService.RevokeInvite:output_type -> main.InviteConfirm
+	30, // [30:49] is the sub-list for method output_type
+	11, // [11:30] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_exec_proto_init() }
@@ -988,13 +1796,14 @@ func file_exec_proto_init() {
 		return
 	}
 	file_student_proto_init()
+	file_main_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_exec_proto_rawDesc), len(file_exec_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1229,6 +1229,110 @@ var _ interface {
 	ErrorName() string
 } = DeleteExecsConfirmValidationError{}
 
+// Validate checks the field values on RestoreExecsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreExecsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreExecsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreExecsConfirmMultiError, or nil if none found.
+func (m *RestoreExecsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreExecsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RestoreExecsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreExecsConfirmMultiError is an error wrapping multiple validation
+// errors returned by RestoreExecsConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreExecsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreExecsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreExecsConfirmMultiError) AllErrors() []error { return m }
+
+// RestoreExecsConfirmValidationError is the validation error returned by
+// RestoreExecsConfirm.Validate if the designated constraints aren't met.
+type RestoreExecsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreExecsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreExecsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreExecsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreExecsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreExecsConfirmValidationError) ErrorName() string {
+	return "RestoreExecsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreExecsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreExecsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreExecsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreExecsConfirmValidationError{}
+
 // Validate checks the field values on ExecIds with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1412,6 +1516,16 @@ func (m *GetExecRequset) validate(all bool) error {
 
 	}
 
+	// no validation rules for IncludeDeleted
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotalSize
+
+	// no validation rules for Filter
+
 	if len(errors) > 0 {
 		return GetExecRequsetMultiError(errors)
 	}
@@ -1611,6 +1725,25 @@ func (m *Exec) validate(all bool) error {
 
 	// no validation rules for InactiveStatus
 
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if m.GetProfileId() != "" {
+
+		if !_Exec_ProfileId_Pattern.MatchString(m.GetProfileId()) {
+			err := ExecValidationError{
+				field:  "ProfileId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ExecMultiError(errors)
 	}
@@ -1746,6 +1879,8 @@ var _Exec_Username_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
 
 var _Exec_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
 
+var _Exec_ProfileId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on Execs with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1801,6 +1936,10 @@ func (m *Execs) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
 	if len(errors) > 0 {
 		return ExecsMultiError(errors)
 	}
@@ -1877,3 +2016,1211 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ExecsValidationError{}
+
+// Validate checks the field values on MeResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MeResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MeResponseMultiError, or
+// nil if none found.
+func (m *MeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MeResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MeResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MeResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStudent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MeResponseValidationError{
+					field:  "Student",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MeResponseValidationError{
+					field:  "Student",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStudent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MeResponseValidationError{
+				field:  "Student",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTeacher()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MeResponseValidationError{
+					field:  "Teacher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MeResponseValidationError{
+					field:  "Teacher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeacher()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MeResponseValidationError{
+				field:  "Teacher",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MeResponseMultiError is an error wrapping multiple validation errors
+// returned by MeResponse.ValidateAll() if the designated constraints aren't met.
+type MeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MeResponseMultiError) AllErrors() []error { return m }
+
+// MeResponseValidationError is the validation error returned by
+// MeResponse.Validate if the designated constraints aren't met.
+type MeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MeResponseValidationError) ErrorName() string { return "MeResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MeResponseValidationError{}
+
+// Validate checks the field values on AcceptInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptInviteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptInviteRequestMultiError, or nil if none found.
+func (m *AcceptInviteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptInviteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetInviteCode()) < 1 {
+		err := AcceptInviteRequestValidationError{
+			field:  "InviteCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewPassword()) < 6 {
+		err := AcceptInviteRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AcceptInviteRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := AcceptInviteRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ConfirmPassword
+
+	if len(errors) > 0 {
+		return AcceptInviteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptInviteRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptInviteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptInviteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptInviteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptInviteRequestMultiError) AllErrors() []error { return m }
+
+// AcceptInviteRequestValidationError is the validation error returned by
+// AcceptInviteRequest.Validate if the designated constraints aren't met.
+type AcceptInviteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptInviteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptInviteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptInviteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptInviteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptInviteRequestValidationError) ErrorName() string {
+	return "AcceptInviteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptInviteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptInviteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptInviteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptInviteRequestValidationError{}
+
+var _AcceptInviteRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
+
+// Validate checks the field values on ListInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvitesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvitesRequestMultiError, or nil if none found.
+func (m *ListInvitesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeExpired
+
+	// no validation rules for IncludeRevoked
+
+	if len(errors) > 0 {
+		return ListInvitesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListInvitesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListInvitesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitesRequestMultiError) AllErrors() []error { return m }
+
+// ListInvitesRequestValidationError is the validation error returned by
+// ListInvitesRequest.Validate if the designated constraints aren't met.
+type ListInvitesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitesRequestValidationError) ErrorName() string {
+	return "ListInvitesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvitesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitesRequestValidationError{}
+
+// Validate checks the field values on Invite with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invite with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in InviteMultiError, or nil if none found.
+func (m *Invite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExecId
+
+	// no validation rules for Username
+
+	// no validation rules for Email
+
+	// no validation rules for Role
+
+	// no validation rules for InvitedAt
+
+	// no validation rules for InvitedBy
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return InviteMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteMultiError is an error wrapping multiple validation errors returned by
+// Invite.ValidateAll() if the designated constraints aren't met.
+type InviteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteMultiError) AllErrors() []error { return m }
+
+// InviteValidationError is the validation error returned by Invite.Validate if
+// the designated constraints aren't met.
+type InviteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteValidationError) ErrorName() string { return "InviteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InviteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteValidationError{}
+
+// Validate checks the field values on Invites with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invites) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invites with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in InvitesMultiError, or nil if none found.
+func (m *Invites) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invites) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InvitesValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InvitesValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InvitesValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InvitesMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvitesMultiError is an error wrapping multiple validation errors returned
+// by Invites.ValidateAll() if the designated constraints aren't met.
+type InvitesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvitesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvitesMultiError) AllErrors() []error { return m }
+
+// InvitesValidationError is the validation error returned by Invites.Validate
+// if the designated constraints aren't met.
+type InvitesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvitesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvitesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvitesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvitesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvitesValidationError) ErrorName() string { return "InvitesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvitesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvites.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvitesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvitesValidationError{}
+
+// Validate checks the field values on InviteConfirm with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InviteConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteConfirm with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InviteConfirmMultiError, or
+// nil if none found.
+func (m *InviteConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return InviteConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteConfirmMultiError is an error wrapping multiple validation errors
+// returned by InviteConfirm.ValidateAll() if the designated constraints
+// aren't met.
+type InviteConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteConfirmMultiError) AllErrors() []error { return m }
+
+// InviteConfirmValidationError is the validation error returned by
+// InviteConfirm.Validate if the designated constraints aren't met.
+type InviteConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteConfirmValidationError) ErrorName() string { return "InviteConfirmValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InviteConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteConfirmValidationError{}
+
+// Validate checks the field values on WatchExecsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchExecsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchExecsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchExecsRequestMultiError, or nil if none found.
+func (m *WatchExecsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchExecsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchExecsRequestValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchExecsRequestValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchExecsRequestValidationError{
+				field:  "Exec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchExecsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchExecsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchExecsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchExecsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchExecsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchExecsRequestMultiError) AllErrors() []error { return m }
+
+// WatchExecsRequestValidationError is the validation error returned by
+// WatchExecsRequest.Validate if the designated constraints aren't met.
+type WatchExecsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchExecsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchExecsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchExecsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchExecsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchExecsRequestValidationError) ErrorName() string {
+	return "WatchExecsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchExecsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchExecsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchExecsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchExecsRequestValidationError{}
+
+// Validate checks the field values on ExecEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecEventMultiError, or nil
+// if none found.
+func (m *ExecEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetExec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecEventValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecEventValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecEventValidationError{
+				field:  "Exec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	// no validation rules for OccurredAt
+
+	if len(errors) > 0 {
+		return ExecEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecEventMultiError is an error wrapping multiple validation errors returned
+// by ExecEvent.ValidateAll() if the designated constraints aren't met.
+type ExecEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecEventMultiError) AllErrors() []error { return m }
+
+// ExecEventValidationError is the validation error returned by
+// ExecEvent.Validate if the designated constraints aren't met.
+type ExecEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecEventValidationError) ErrorName() string { return "ExecEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecEventValidationError{}
+
+// Validate checks the field values on ExportExecsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportExecsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportExecsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportExecsRequestMultiError, or nil if none found.
+func (m *ExportExecsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportExecsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportExecsRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportExecsRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportExecsRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportExecsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportExecsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportExecsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportExecsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportExecsRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportExecsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportExecsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportExecsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportExecsRequestMultiError) AllErrors() []error { return m }
+
+// ExportExecsRequestValidationError is the validation error returned by
+// ExportExecsRequest.Validate if the designated constraints aren't met.
+type ExportExecsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportExecsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportExecsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportExecsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportExecsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportExecsRequestValidationError) ErrorName() string {
+	return "ExportExecsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportExecsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportExecsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportExecsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportExecsRequestValidationError{}
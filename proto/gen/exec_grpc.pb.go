@@ -23,6 +23,9 @@ const (
 	ExecsService_AddExecs_FullMethodName       = "/main.ExecsService/AddExecs"
 	ExecsService_UpdateExecs_FullMethodName    = "/main.ExecsService/UpdateExecs"
 	ExecsService_DeleteExecs_FullMethodName    = "/main.ExecsService/DeleteExecs"
+	ExecsService_RestoreExecs_FullMethodName   = "/main.ExecsService/RestoreExecs"
+	ExecsService_WatchExecs_FullMethodName     = "/main.ExecsService/WatchExecs"
+	ExecsService_ExportExecs_FullMethodName    = "/main.ExecsService/ExportExecs"
 	ExecsService_Login_FullMethodName          = "/main.ExecsService/Login"
 	ExecsService_Logout_FullMethodName         = "/main.ExecsService/Logout"
 	ExecsService_UpdatePassword_FullMethodName = "/main.ExecsService/UpdatePassword"
@@ -30,6 +33,11 @@ const (
 	ExecsService_ForgotPassword_FullMethodName = "/main.ExecsService/ForgotPassword"
 	ExecsService_DeactivateUser_FullMethodName = "/main.ExecsService/DeactivateUser"
 	ExecsService_ReactivateUser_FullMethodName = "/main.ExecsService/ReactivateUser"
+	ExecsService_Me_FullMethodName             = "/main.ExecsService/Me"
+	ExecsService_AcceptInvite_FullMethodName   = "/main.ExecsService/AcceptInvite"
+	ExecsService_ListInvites_FullMethodName    = "/main.ExecsService/ListInvites"
+	ExecsService_ResendInvite_FullMethodName   = "/main.ExecsService/ResendInvite"
+	ExecsService_RevokeInvite_FullMethodName   = "/main.ExecsService/RevokeInvite"
 )
 
 // ExecsServiceClient is the client API for ExecsService service.
@@ -40,6 +48,11 @@ type ExecsServiceClient interface {
 	AddExecs(ctx context.Context, in *Execs, opts ...grpc.CallOption) (*Execs, error)
 	UpdateExecs(ctx context.Context, in *Execs, opts ...grpc.CallOption) (*Execs, error)
 	DeleteExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*DeleteExecsConfirm, error)
+	RestoreExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*RestoreExecsConfirm, error)
+	// streams the changes of the execs matching the filter until the client disconnects
+	WatchExecs(ctx context.Context, in *WatchExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecEvent], error)
+	// streams the execs matching the query as a csv, jsonl or xlsx file, passwords and tokens are never exported
+	ExportExecs(ctx context.Context, in *ExportExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 	Login(ctx context.Context, in *ExecLogInRequest, opts ...grpc.CallOption) (*ExecLogInResponse, error)
 	Logout(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExecLogoutResponse, error)
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordResponse, error)
@@ -47,6 +60,11 @@ type ExecsServiceClient interface {
 	ForgotPassword(ctx context.Context, in *ForgotPasswordRequst, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
 	DeactivateUser(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*Confirmation, error)
 	ReactivateUser(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*Confirmation, error)
+	Me(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*MeResponse, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*Confirmation, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*Invites, error)
+	ResendInvite(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*InviteConfirm, error)
+	RevokeInvite(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*InviteConfirm, error)
 }
 
 type execsServiceClient struct {
@@ -97,6 +115,54 @@ func (c *execsServiceClient) DeleteExecs(ctx context.Context, in *ExecIds, opts
 	return out, nil
 }
 
+func (c *execsServiceClient) RestoreExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*RestoreExecsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreExecsConfirm)
+	err := c.cc.Invoke(ctx, ExecsService_RestoreExecs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) WatchExecs(ctx context.Context, in *WatchExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecsService_ServiceDesc.Streams[0], ExecsService_WatchExecs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchExecsRequest, ExecEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_WatchExecsClient = grpc.ServerStreamingClient[ExecEvent]
+
+func (c *execsServiceClient) ExportExecs(ctx context.Context, in *ExportExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecsService_ServiceDesc.Streams[1], ExecsService_ExportExecs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportExecsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_ExportExecsClient = grpc.ServerStreamingClient[ExportChunk]
+
 func (c *execsServiceClient) Login(ctx context.Context, in *ExecLogInRequest, opts ...grpc.CallOption) (*ExecLogInResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecLogInResponse)
@@ -167,6 +233,56 @@ func (c *execsServiceClient) ReactivateUser(ctx context.Context, in *ExecIds, op
 	return out, nil
 }
 
+func (c *execsServiceClient) Me(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*MeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeResponse)
+	err := c.cc.Invoke(ctx, ExecsService_Me_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*Confirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Confirmation)
+	err := c.cc.Invoke(ctx, ExecsService_AcceptInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*Invites, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invites)
+	err := c.cc.Invoke(ctx, ExecsService_ListInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) ResendInvite(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*InviteConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteConfirm)
+	err := c.cc.Invoke(ctx, ExecsService_ResendInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) RevokeInvite(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*InviteConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteConfirm)
+	err := c.cc.Invoke(ctx, ExecsService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecsServiceServer is the server API for ExecsService service.
 // All implementations must embed UnimplementedExecsServiceServer
 // for forward compatibility.
@@ -175,6 +291,11 @@ type ExecsServiceServer interface {
 	AddExecs(context.Context, *Execs) (*Execs, error)
 	UpdateExecs(context.Context, *Execs) (*Execs, error)
 	DeleteExecs(context.Context, *ExecIds) (*DeleteExecsConfirm, error)
+	RestoreExecs(context.Context, *ExecIds) (*RestoreExecsConfirm, error)
+	// streams the changes of the execs matching the filter until the client disconnects
+	WatchExecs(*WatchExecsRequest, grpc.ServerStreamingServer[ExecEvent]) error
+	// streams the execs matching the query as a csv, jsonl or xlsx file, passwords and tokens are never exported
+	ExportExecs(*ExportExecsRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	Login(context.Context, *ExecLogInRequest) (*ExecLogInResponse, error)
 	Logout(context.Context, *EmptyRequest) (*ExecLogoutResponse, error)
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
@@ -182,6 +303,11 @@ type ExecsServiceServer interface {
 	ForgotPassword(context.Context, *ForgotPasswordRequst) (*ForgotPasswordResponse, error)
 	DeactivateUser(context.Context, *ExecIds) (*Confirmation, error)
 	ReactivateUser(context.Context, *ExecIds) (*Confirmation, error)
+	Me(context.Context, *EmptyRequest) (*MeResponse, error)
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*Confirmation, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*Invites, error)
+	ResendInvite(context.Context, *ExecIds) (*InviteConfirm, error)
+	RevokeInvite(context.Context, *ExecIds) (*InviteConfirm, error)
 	mustEmbedUnimplementedExecsServiceServer()
 }
 
@@ -204,6 +330,15 @@ func (UnimplementedExecsServiceServer) UpdateExecs(context.Context, *Execs) (*Ex
 func (UnimplementedExecsServiceServer) DeleteExecs(context.Context, *ExecIds) (*DeleteExecsConfirm, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteExecs not implemented")
 }
+func (UnimplementedExecsServiceServer) RestoreExecs(context.Context, *ExecIds) (*RestoreExecsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreExecs not implemented")
+}
+func (UnimplementedExecsServiceServer) WatchExecs(*WatchExecsRequest, grpc.ServerStreamingServer[ExecEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchExecs not implemented")
+}
+func (UnimplementedExecsServiceServer) ExportExecs(*ExportExecsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportExecs not implemented")
+}
 func (UnimplementedExecsServiceServer) Login(context.Context, *ExecLogInRequest) (*ExecLogInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -225,6 +360,21 @@ func (UnimplementedExecsServiceServer) DeactivateUser(context.Context, *ExecIds)
 func (UnimplementedExecsServiceServer) ReactivateUser(context.Context, *ExecIds) (*Confirmation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
 }
+func (UnimplementedExecsServiceServer) Me(context.Context, *EmptyRequest) (*MeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Me not implemented")
+}
+func (UnimplementedExecsServiceServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*Confirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedExecsServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*Invites, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedExecsServiceServer) ResendInvite(context.Context, *ExecIds) (*InviteConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvite not implemented")
+}
+func (UnimplementedExecsServiceServer) RevokeInvite(context.Context, *ExecIds) (*InviteConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
 func (UnimplementedExecsServiceServer) mustEmbedUnimplementedExecsServiceServer() {}
 func (UnimplementedExecsServiceServer) testEmbeddedByValue()                      {}
 
@@ -318,6 +468,46 @@ func _ExecsService_DeleteExecs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecsService_RestoreExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).RestoreExecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_RestoreExecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).RestoreExecs(ctx, req.(*ExecIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_WatchExecs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchExecsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecsServiceServer).WatchExecs(m, &grpc.GenericServerStream[WatchExecsRequest, ExecEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_WatchExecsServer = grpc.ServerStreamingServer[ExecEvent]
+
+func _ExecsService_ExportExecs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportExecsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecsServiceServer).ExportExecs(m, &grpc.GenericServerStream[ExportExecsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_ExportExecsServer = grpc.ServerStreamingServer[ExportChunk]
+
 func _ExecsService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecLogInRequest)
 	if err := dec(in); err != nil {
@@ -444,6 +634,96 @@ func _ExecsService_ReactivateUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecsService_Me_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).Me(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_Me_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).Me(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_AcceptInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_ListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_ResendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).ResendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_ResendInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).ResendInvite(ctx, req.(*ExecIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).RevokeInvite(ctx, req.(*ExecIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExecsService_ServiceDesc is the grpc.ServiceDesc for ExecsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -467,6 +747,10 @@ var ExecsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExecs",
 			Handler:    _ExecsService_DeleteExecs_Handler,
 		},
+		{
+			MethodName: "RestoreExecs",
+			Handler:    _ExecsService_RestoreExecs_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _ExecsService_Login_Handler,
@@ -495,7 +779,38 @@ var ExecsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReactivateUser",
 			Handler:    _ExecsService_ReactivateUser_Handler,
 		},
+		{
+			MethodName: "Me",
+			Handler:    _ExecsService_Me_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _ExecsService_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _ExecsService_ListInvites_Handler,
+		},
+		{
+			MethodName: "ResendInvite",
+			Handler:    _ExecsService_ResendInvite_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _ExecsService_RevokeInvite_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchExecs",
+			Handler:       _ExecsService_WatchExecs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportExecs",
+			Handler:       _ExecsService_ExportExecs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "exec.proto",
 }
@@ -0,0 +1,1027 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// type is one of homework, quiz, exam
+// weight is relative to the other assessments of the course, weights do not need to add up to 100
+type Assessment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	MaxScore      float64                `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	DueDate       string                 `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,10,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assessment) Reset() {
+	*x = Assessment{}
+	mi := &file_grades_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessment) ProtoMessage() {}
+
+func (x *Assessment) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessment.ProtoReflect.Descriptor instead.
+func (*Assessment) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Assessment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assessment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Assessment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Assessment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Assessment) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Assessment) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Assessment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *Assessment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Assessment) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Assessment) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type Assessments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessments   []*Assessment          `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assessments) Reset() {
+	*x = Assessments{}
+	mi := &file_grades_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assessments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessments) ProtoMessage() {}
+
+func (x *Assessments) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessments.ProtoReflect.Descriptor instead.
+func (*Assessments) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Assessments) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+type AssessmentIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentIds []string               `protobuf:"bytes,1,rep,name=assessmentIds,proto3" json:"assessmentIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessmentIds) Reset() {
+	*x = AssessmentIds{}
+	mi := &file_grades_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessmentIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentIds) ProtoMessage() {}
+
+func (x *AssessmentIds) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentIds.ProtoReflect.Descriptor instead.
+func (*AssessmentIds) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AssessmentIds) GetAssessmentIds() []string {
+	if x != nil {
+		return x.AssessmentIds
+	}
+	return nil
+}
+
+type DeleteAssessmentsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssessmentsConfirm) Reset() {
+	*x = DeleteAssessmentsConfirm{}
+	mi := &file_grades_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssessmentsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssessmentsConfirm) ProtoMessage() {}
+
+func (x *DeleteAssessmentsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssessmentsConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteAssessmentsConfirm) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteAssessmentsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAssessmentsConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetAssessmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssessmentsRequest) Reset() {
+	*x = GetAssessmentsRequest{}
+	mi := &file_grades_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentsRequest) ProtoMessage() {}
+
+func (x *GetAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAssessmentsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAssessmentsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Score struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssessmentId  string                 `protobuf:"bytes,2,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	GradedBy      string                 `protobuf:"bytes,7,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	GradedAt      string                 `protobuf:"bytes,8,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	Term          string                 `protobuf:"bytes,9,opt,name=term,proto3" json:"term,omitempty"` // the term of the course
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_grades_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Score) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Score) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *Score) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Score) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Score) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Score) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Score) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+func (x *Score) GetGradedAt() string {
+	if x != nil {
+		return x.GradedAt
+	}
+	return ""
+}
+
+func (x *Score) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type Scores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*Score               `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scores) Reset() {
+	*x = Scores{}
+	mi := &file_grades_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scores) ProtoMessage() {}
+
+func (x *Scores) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scores.ProtoReflect.Descriptor instead.
+func (*Scores) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Scores) GetScores() []*Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type StudentScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentScore) Reset() {
+	*x = StudentScore{}
+	mi := &file_grades_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentScore) ProtoMessage() {}
+
+func (x *StudentScore) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentScore.ProtoReflect.Descriptor instead.
+func (*StudentScore) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StudentScore) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *StudentScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// scores of students that already have one for the assessment are overwritten
+type RecordScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentId  string                 `protobuf:"bytes,1,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	Scores        []*StudentScore        `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordScoresRequest) Reset() {
+	*x = RecordScoresRequest{}
+	mi := &file_grades_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordScoresRequest) ProtoMessage() {}
+
+func (x *RecordScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordScoresRequest.ProtoReflect.Descriptor instead.
+func (*RecordScoresRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordScoresRequest) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *RecordScoresRequest) GetScores() []*StudentScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type GetScoresRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentId string                 `protobuf:"bytes,1,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	CourseId     string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// scores of a student are limited to the current term when neither term, assessment nor course is given
+	Term          string `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoresRequest) Reset() {
+	*x = GetScoresRequest{}
+	mi := &file_grades_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoresRequest) ProtoMessage() {}
+
+func (x *GetScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetScoresRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetScoresRequest) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *GetScoresRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetScoresRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetScoresRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type CourseGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseGradesRequest) Reset() {
+	*x = CourseGradesRequest{}
+	mi := &file_grades_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseGradesRequest) ProtoMessage() {}
+
+func (x *CourseGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseGradesRequest.ProtoReflect.Descriptor instead.
+func (*CourseGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CourseGradesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseGradesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// weighted_average is in percent over the assessments the student has a score for,
+// graded_weight is the share of the total course weight those assessments make up
+type StudentGrade struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StudentId       string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId        string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term            string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	WeightedAverage float64                `protobuf:"fixed64,4,opt,name=weighted_average,json=weightedAverage,proto3" json:"weighted_average,omitempty"`
+	LetterGrade     string                 `protobuf:"bytes,5,opt,name=letter_grade,json=letterGrade,proto3" json:"letter_grade,omitempty"`
+	GradedWeight    float64                `protobuf:"fixed64,6,opt,name=graded_weight,json=gradedWeight,proto3" json:"graded_weight,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StudentGrade) Reset() {
+	*x = StudentGrade{}
+	mi := &file_grades_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGrade) ProtoMessage() {}
+
+func (x *StudentGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGrade.ProtoReflect.Descriptor instead.
+func (*StudentGrade) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StudentGrade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentGrade) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *StudentGrade) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *StudentGrade) GetWeightedAverage() float64 {
+	if x != nil {
+		return x.WeightedAverage
+	}
+	return 0
+}
+
+func (x *StudentGrade) GetLetterGrade() string {
+	if x != nil {
+		return x.LetterGrade
+	}
+	return ""
+}
+
+func (x *StudentGrade) GetGradedWeight() float64 {
+	if x != nil {
+		return x.GradedWeight
+	}
+	return 0
+}
+
+type CourseGrades struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Grades        []*StudentGrade        `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseGrades) Reset() {
+	*x = CourseGrades{}
+	mi := &file_grades_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseGrades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseGrades) ProtoMessage() {}
+
+func (x *CourseGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseGrades.ProtoReflect.Descriptor instead.
+func (*CourseGrades) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CourseGrades) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseGrades) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *CourseGrades) GetGrades() []*StudentGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+var File_grades_proto protoreflect.FileDescriptor
+
+const file_grades_proto_rawDesc = "" +
+	"\n" +
+	"\fgrades.proto\x12\x04main\x1a\x17validate/validate.proto\"\xad\x03\n" +
+	"\n" +
+	"Assessment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\bcourseId\x12-\n" +
+	"\x04name\x18\x03 \x01(\tB\x19\xfaB\x16r\x14\x18d2\x10^[A-Za-z0-9 -]*$R\x04name\x121\n" +
+	"\x04type\x18\x04 \x01(\tB\x1d\xfaB\x1ar\x18R\x00R\bhomeworkR\x04quizR\x04examR\x04type\x12&\n" +
+	"\x06weight\x18\x05 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x06weight\x12+\n" +
+	"\tmax_score\x18\x06 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\bmaxScore\x12A\n" +
+	"\bdue_date\x18\a \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\adueDate\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\n" +
+	" \x01(\tR\tdeletedBy\"A\n" +
+	"\vAssessments\x122\n" +
+	"\vassessments\x18\x01 \x03(\v2\x10.main.AssessmentR\vassessments\"?\n" +
+	"\rAssessmentIds\x12.\n" +
+	"\rassessmentIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\rassessmentIds\"S\n" +
+	"\x18DeleteAssessmentsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\x85\x01\n" +
+	"\x15GetAssessmentsRequest\x129\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\bcourseId\x121\n" +
+	"\x04type\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18R\x00R\bhomeworkR\x04quizR\x04examR\x04type\"\xf6\x01\n" +
+	"\x05Score\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassessment_id\x18\x02 \x01(\tR\fassessmentId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x04 \x01(\tR\tstudentId\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1b\n" +
+	"\tgraded_by\x18\a \x01(\tR\bgradedBy\x12\x1b\n" +
+	"\tgraded_at\x18\b \x01(\tR\bgradedAt\x12\x12\n" +
+	"\x04term\x18\t \x01(\tR\x04term\"-\n" +
+	"\x06Scores\x12#\n" +
+	"\x06scores\x18\x01 \x03(\v2\v.main.ScoreR\x06scores\"\x95\x01\n" +
+	"\fStudentScore\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12$\n" +
+	"\x05score\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05score\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\acomment\"\x8e\x01\n" +
+	"\x13RecordScoresRequest\x12A\n" +
+	"\rassessment_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\fassessmentId\x124\n" +
+	"\x06scores\x18\x02 \x03(\v2\x12.main.StudentScoreB\b\xfaB\x05\x92\x01\x02\b\x01R\x06scores\"\xf7\x01\n" +
+	"\x10GetScoresRequest\x12@\n" +
+	"\rassessment_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\fassessmentId\x128\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\bcourseId\x12:\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tstudentId\x12+\n" +
+	"\x04term\x18\x04 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\"\x8c\x01\n" +
+	"\x13CourseGradesRequest\x129\n" +
+	"\tcourse_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\bcourseId\x12:\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tstudentId\"\xd1\x01\n" +
+	"\fStudentGrade\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\x12)\n" +
+	"\x10weighted_average\x18\x04 \x01(\x01R\x0fweightedAverage\x12!\n" +
+	"\fletter_grade\x18\x05 \x01(\tR\vletterGrade\x12#\n" +
+	"\rgraded_weight\x18\x06 \x01(\x01R\fgradedWeight\"k\n" +
+	"\fCourseGrades\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\x12*\n" +
+	"\x06grades\x18\x03 \x03(\v2\x12.main.StudentGradeR\x06grades2\xbc\x03\n" +
+	"\rGradesService\x12@\n" +
+	"\x0eGetAssessments\x12\x1b.main.GetAssessmentsRequest\x1a\x11.main.Assessments\x126\n" +
+	"\x0eAddAssessments\x12\x11.main.Assessments\x1a\x11.main.Assessments\x129\n" +
+	"\x11UpdateAssessments\x12\x11.main.Assessments\x1a\x11.main.Assessments\x12H\n" +
+	"\x11DeleteAssessments\x12\x13.main.AssessmentIds\x1a\x1e.main.DeleteAssessmentsConfirm\x127\n" +
+	"\fRecordScores\x12\x19.main.RecordScoresRequest\x1a\f.main.Scores\x121\n" +
+	"\tGetScores\x12\x16.main.GetScoresRequest\x1a\f.main.Scores\x12@\n" +
+	"\x0fGetCourseGrades\x12\x19.main.CourseGradesRequest\x1a\x12.main.CourseGradesB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_grades_proto_rawDescOnce sync.Once
+	file_grades_proto_rawDescData []byte
+)
+
+func file_grades_proto_rawDescGZIP() []byte {
+	file_grades_proto_rawDescOnce.Do(func() {
+		file_grades_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_grades_proto_rawDesc), len(file_grades_proto_rawDesc)))
+	})
+	return file_grades_proto_rawDescData
+}
+
+var file_grades_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_grades_proto_goTypes = []any{
+	(*Assessment)(nil),               // 0: main.Assessment
+	(*Assessments)(nil),              // 1: main.Assessments
+	(*AssessmentIds)(nil),            // 2: main.AssessmentIds
+	(*DeleteAssessmentsConfirm)(nil), // 3: main.DeleteAssessmentsConfirm
+	(*GetAssessmentsRequest)(nil),    // 4: main.GetAssessmentsRequest
+	(*Score)(nil),                    // 5: main.Score
+	(*Scores)(nil),                   // 6: main.Scores
+	(*StudentScore)(nil),             // 7: main.StudentScore
+	(*RecordScoresRequest)(nil),      // 8: main.RecordScoresRequest
+	(*GetScoresRequest)(nil),         // 9: main.GetScoresRequest
+	(*CourseGradesRequest)(nil),      // 10: main.CourseGradesRequest
+	(*StudentGrade)(nil),             // 11: main.StudentGrade
+	(*CourseGrades)(nil),             // 12: main.CourseGrades
+}
+var file_grades_proto_depIdxs = []int32{
+	0,  // 0: main.Assessments.assessments:type_name -> main.Assessment
+	5,  // 1: main.Scores.scores:type_name -> main.Score
+	7,  // 2: main.RecordScoresRequest.scores:type_name -> main.StudentScore
+	11, // 3: main.CourseGrades.grades:type_name -> main.StudentGrade
+	4,  // 4: main.GradesService.GetAssessments:input_type -> main.GetAssessmentsRequest
+	1,  // 5: main.GradesService.AddAssessments:input_type -> main.Assessments
+	1,  // 6: main.GradesService.UpdateAssessments:input_type -> main.Assessments
+	2,  // 7: main.GradesService.DeleteAssessments:input_type -> main.AssessmentIds
+	8,  // 8: main.GradesService.RecordScores:input_type -> main.RecordScoresRequest
+	9,  // 9: main.GradesService.GetScores:input_type -> main.GetScoresRequest
+	10, // 10: main.GradesService.GetCourseGrades:input_type -> main.CourseGradesRequest
+	1,  // 11: main.GradesService.GetAssessments:output_type -> main.Assessments
+	1,  // 12: main.GradesService.AddAssessments:output_type -> main.Assessments
+	1,  // 13: main.GradesService.UpdateAssessments:output_type -> main.Assessments
+	3,  // 14: main.GradesService.DeleteAssessments:output_type -> main.DeleteAssessmentsConfirm
+	6,  // 15: main.GradesService.RecordScores:output_type -> main.Scores
+	6,  // 16: main.GradesService.GetScores:output_type -> main.Scores
+	12, // 17: main.GradesService.GetCourseGrades:output_type -> main.CourseGrades
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_grades_proto_init() }
+func file_grades_proto_init() {
+	if File_grades_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grades_proto_rawDesc), len(file_grades_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_grades_proto_goTypes,
+		DependencyIndexes: file_grades_proto_depIdxs,
+		MessageInfos:      file_grades_proto_msgTypes,
+	}.Build()
+	File_grades_proto = out.File
+	file_grades_proto_goTypes = nil
+	file_grades_proto_depIdxs = nil
+}
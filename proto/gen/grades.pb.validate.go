@@ -0,0 +1,1856 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Assessment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Assessment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Assessment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentMultiError, or
+// nil if none found.
+func (m *Assessment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Assessment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetCourseId() != "" {
+
+		if !_Assessment_CourseId_Pattern.MatchString(m.GetCourseId()) {
+			err := AssessmentValidationError{
+				field:  "CourseId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := AssessmentValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Assessment_Name_Pattern.MatchString(m.GetName()) {
+		err := AssessmentValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Assessment_Type_InLookup[m.GetType()]; !ok {
+		err := AssessmentValidationError{
+			field:  "Type",
+			reason: "value must be in list [ homework quiz exam]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeight() < 0 {
+		err := AssessmentValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxScore() < 0 {
+		err := AssessmentValidationError{
+			field:  "MaxScore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDueDate() != "" {
+
+		if !_Assessment_DueDate_Pattern.MatchString(m.GetDueDate()) {
+			err := AssessmentValidationError{
+				field:  "DueDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return AssessmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentMultiError is an error wrapping multiple validation errors
+// returned by Assessment.ValidateAll() if the designated constraints aren't met.
+type AssessmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentMultiError) AllErrors() []error { return m }
+
+// AssessmentValidationError is the validation error returned by
+// Assessment.Validate if the designated constraints aren't met.
+type AssessmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentValidationError) ErrorName() string { return "AssessmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentValidationError{}
+
+var _Assessment_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Assessment_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _Assessment_Type_InLookup = map[string]struct{}{
+	"":         {},
+	"homework": {},
+	"quiz":     {},
+	"exam":     {},
+}
+
+var _Assessment_DueDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on Assessments with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Assessments) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Assessments with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentsMultiError, or
+// nil if none found.
+func (m *Assessments) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Assessments) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAssessments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssessmentsValidationError{
+						field:  fmt.Sprintf("Assessments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssessmentsValidationError{
+						field:  fmt.Sprintf("Assessments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssessmentsValidationError{
+					field:  fmt.Sprintf("Assessments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssessmentsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentsMultiError is an error wrapping multiple validation errors
+// returned by Assessments.ValidateAll() if the designated constraints aren't met.
+type AssessmentsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentsMultiError) AllErrors() []error { return m }
+
+// AssessmentsValidationError is the validation error returned by
+// Assessments.Validate if the designated constraints aren't met.
+type AssessmentsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentsValidationError) ErrorName() string { return "AssessmentsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessments.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentsValidationError{}
+
+// Validate checks the field values on AssessmentIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AssessmentIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssessmentIds with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentIdsMultiError, or
+// nil if none found.
+func (m *AssessmentIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssessmentIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAssessmentIds()) < 1 {
+		err := AssessmentIdsValidationError{
+			field:  "AssessmentIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AssessmentIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentIdsMultiError is an error wrapping multiple validation errors
+// returned by AssessmentIds.ValidateAll() if the designated constraints
+// aren't met.
+type AssessmentIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentIdsMultiError) AllErrors() []error { return m }
+
+// AssessmentIdsValidationError is the validation error returned by
+// AssessmentIds.Validate if the designated constraints aren't met.
+type AssessmentIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentIdsValidationError) ErrorName() string { return "AssessmentIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessmentIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentIdsValidationError{}
+
+// Validate checks the field values on DeleteAssessmentsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAssessmentsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAssessmentsConfirm with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAssessmentsConfirmMultiError, or nil if none found.
+func (m *DeleteAssessmentsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAssessmentsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteAssessmentsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAssessmentsConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteAssessmentsConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAssessmentsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAssessmentsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAssessmentsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteAssessmentsConfirmValidationError is the validation error returned by
+// DeleteAssessmentsConfirm.Validate if the designated constraints aren't met.
+type DeleteAssessmentsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAssessmentsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAssessmentsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAssessmentsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAssessmentsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAssessmentsConfirmValidationError) ErrorName() string {
+	return "DeleteAssessmentsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAssessmentsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAssessmentsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAssessmentsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAssessmentsConfirmValidationError{}
+
+// Validate checks the field values on GetAssessmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAssessmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAssessmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAssessmentsRequestMultiError, or nil if none found.
+func (m *GetAssessmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAssessmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCourseId()) != 24 {
+		err := GetAssessmentsRequestValidationError{
+			field:  "CourseId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_GetAssessmentsRequest_CourseId_Pattern.MatchString(m.GetCourseId()) {
+		err := GetAssessmentsRequestValidationError{
+			field:  "CourseId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetAssessmentsRequest_Type_InLookup[m.GetType()]; !ok {
+		err := GetAssessmentsRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [ homework quiz exam]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAssessmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAssessmentsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAssessmentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAssessmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAssessmentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAssessmentsRequestMultiError) AllErrors() []error { return m }
+
+// GetAssessmentsRequestValidationError is the validation error returned by
+// GetAssessmentsRequest.Validate if the designated constraints aren't met.
+type GetAssessmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAssessmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAssessmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAssessmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAssessmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAssessmentsRequestValidationError) ErrorName() string {
+	return "GetAssessmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAssessmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAssessmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAssessmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAssessmentsRequestValidationError{}
+
+var _GetAssessmentsRequest_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetAssessmentsRequest_Type_InLookup = map[string]struct{}{
+	"":         {},
+	"homework": {},
+	"quiz":     {},
+	"exam":     {},
+}
+
+// Validate checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Score) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ScoreMultiError, or nil if none found.
+func (m *Score) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Score) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AssessmentId
+
+	// no validation rules for CourseId
+
+	// no validation rules for StudentId
+
+	// no validation rules for Score
+
+	// no validation rules for Comment
+
+	// no validation rules for GradedBy
+
+	// no validation rules for GradedAt
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return ScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoreMultiError is an error wrapping multiple validation errors returned by
+// Score.ValidateAll() if the designated constraints aren't met.
+type ScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoreMultiError) AllErrors() []error { return m }
+
+// ScoreValidationError is the validation error returned by Score.Validate if
+// the designated constraints aren't met.
+type ScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoreValidationError) ErrorName() string { return "ScoreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoreValidationError{}
+
+// Validate checks the field values on Scores with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Scores) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Scores with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ScoresMultiError, or nil if none found.
+func (m *Scores) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Scores) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScoresValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScoresValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScoresValidationError{
+					field:  fmt.Sprintf("Scores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScoresMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoresMultiError is an error wrapping multiple validation errors returned by
+// Scores.ValidateAll() if the designated constraints aren't met.
+type ScoresMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoresMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoresMultiError) AllErrors() []error { return m }
+
+// ScoresValidationError is the validation error returned by Scores.Validate if
+// the designated constraints aren't met.
+type ScoresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoresValidationError) ErrorName() string { return "ScoresValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScores.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoresValidationError{}
+
+// Validate checks the field values on StudentScore with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StudentScore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentScore with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StudentScoreMultiError, or
+// nil if none found.
+func (m *StudentScore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentScore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := StudentScoreValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_StudentScore_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := StudentScoreValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetScore() < 0 {
+		err := StudentScoreValidationError{
+			field:  "Score",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 500 {
+		err := StudentScoreValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StudentScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentScoreMultiError is an error wrapping multiple validation errors
+// returned by StudentScore.ValidateAll() if the designated constraints aren't met.
+type StudentScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentScoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentScoreMultiError) AllErrors() []error { return m }
+
+// StudentScoreValidationError is the validation error returned by
+// StudentScore.Validate if the designated constraints aren't met.
+type StudentScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentScoreValidationError) ErrorName() string { return "StudentScoreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudentScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentScoreValidationError{}
+
+var _StudentScore_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on RecordScoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordScoresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordScoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordScoresRequestMultiError, or nil if none found.
+func (m *RecordScoresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordScoresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAssessmentId()) != 24 {
+		err := RecordScoresRequestValidationError{
+			field:  "AssessmentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_RecordScoresRequest_AssessmentId_Pattern.MatchString(m.GetAssessmentId()) {
+		err := RecordScoresRequestValidationError{
+			field:  "AssessmentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScores()) < 1 {
+		err := RecordScoresRequestValidationError{
+			field:  "Scores",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecordScoresRequestValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecordScoresRequestValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordScoresRequestValidationError{
+					field:  fmt.Sprintf("Scores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecordScoresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordScoresRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordScoresRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecordScoresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordScoresRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordScoresRequestMultiError) AllErrors() []error { return m }
+
+// RecordScoresRequestValidationError is the validation error returned by
+// RecordScoresRequest.Validate if the designated constraints aren't met.
+type RecordScoresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordScoresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordScoresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordScoresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordScoresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordScoresRequestValidationError) ErrorName() string {
+	return "RecordScoresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordScoresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordScoresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordScoresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordScoresRequestValidationError{}
+
+var _RecordScoresRequest_AssessmentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on GetScoresRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetScoresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetScoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetScoresRequestMultiError, or nil if none found.
+func (m *GetScoresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetScoresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAssessmentId() != "" {
+
+		if !_GetScoresRequest_AssessmentId_Pattern.MatchString(m.GetAssessmentId()) {
+			err := GetScoresRequestValidationError{
+				field:  "AssessmentId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCourseId() != "" {
+
+		if !_GetScoresRequest_CourseId_Pattern.MatchString(m.GetCourseId()) {
+			err := GetScoresRequestValidationError{
+				field:  "CourseId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetStudentId() != "" {
+
+		if !_GetScoresRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+			err := GetScoresRequestValidationError{
+				field:  "StudentId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_GetScoresRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := GetScoresRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetScoresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetScoresRequestMultiError is an error wrapping multiple validation errors
+// returned by GetScoresRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetScoresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetScoresRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetScoresRequestMultiError) AllErrors() []error { return m }
+
+// GetScoresRequestValidationError is the validation error returned by
+// GetScoresRequest.Validate if the designated constraints aren't met.
+type GetScoresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetScoresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetScoresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetScoresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetScoresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetScoresRequestValidationError) ErrorName() string { return "GetScoresRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetScoresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetScoresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetScoresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetScoresRequestValidationError{}
+
+var _GetScoresRequest_AssessmentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetScoresRequest_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetScoresRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetScoresRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on CourseGradesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CourseGradesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseGradesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CourseGradesRequestMultiError, or nil if none found.
+func (m *CourseGradesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseGradesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCourseId()) != 24 {
+		err := CourseGradesRequestValidationError{
+			field:  "CourseId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_CourseGradesRequest_CourseId_Pattern.MatchString(m.GetCourseId()) {
+		err := CourseGradesRequestValidationError{
+			field:  "CourseId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStudentId() != "" {
+
+		if !_CourseGradesRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+			err := CourseGradesRequestValidationError{
+				field:  "StudentId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CourseGradesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseGradesRequestMultiError is an error wrapping multiple validation
+// errors returned by CourseGradesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CourseGradesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseGradesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseGradesRequestMultiError) AllErrors() []error { return m }
+
+// CourseGradesRequestValidationError is the validation error returned by
+// CourseGradesRequest.Validate if the designated constraints aren't met.
+type CourseGradesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseGradesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseGradesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseGradesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseGradesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseGradesRequestValidationError) ErrorName() string {
+	return "CourseGradesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CourseGradesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseGradesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseGradesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseGradesRequestValidationError{}
+
+var _CourseGradesRequest_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _CourseGradesRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on StudentGrade with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StudentGrade) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentGrade with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StudentGradeMultiError, or
+// nil if none found.
+func (m *StudentGrade) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentGrade) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StudentId
+
+	// no validation rules for CourseId
+
+	// no validation rules for Term
+
+	// no validation rules for WeightedAverage
+
+	// no validation rules for LetterGrade
+
+	// no validation rules for GradedWeight
+
+	if len(errors) > 0 {
+		return StudentGradeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentGradeMultiError is an error wrapping multiple validation errors
+// returned by StudentGrade.ValidateAll() if the designated constraints aren't met.
+type StudentGradeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentGradeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentGradeMultiError) AllErrors() []error { return m }
+
+// StudentGradeValidationError is the validation error returned by
+// StudentGrade.Validate if the designated constraints aren't met.
+type StudentGradeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentGradeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentGradeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentGradeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentGradeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentGradeValidationError) ErrorName() string { return "StudentGradeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudentGradeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentGrade.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentGradeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentGradeValidationError{}
+
+// Validate checks the field values on CourseGrades with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CourseGrades) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CourseGrades with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CourseGradesMultiError, or
+// nil if none found.
+func (m *CourseGrades) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CourseGrades) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CourseId
+
+	// no validation rules for Term
+
+	for idx, item := range m.GetGrades() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CourseGradesValidationError{
+						field:  fmt.Sprintf("Grades[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CourseGradesValidationError{
+						field:  fmt.Sprintf("Grades[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CourseGradesValidationError{
+					field:  fmt.Sprintf("Grades[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CourseGradesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CourseGradesMultiError is an error wrapping multiple validation errors
+// returned by CourseGrades.ValidateAll() if the designated constraints aren't met.
+type CourseGradesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CourseGradesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CourseGradesMultiError) AllErrors() []error { return m }
+
+// CourseGradesValidationError is the validation error returned by
+// CourseGrades.Validate if the designated constraints aren't met.
+type CourseGradesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CourseGradesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CourseGradesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CourseGradesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CourseGradesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CourseGradesValidationError) ErrorName() string { return "CourseGradesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CourseGradesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCourseGrades.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CourseGradesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CourseGradesValidationError{}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GradesService_GetAssessments_FullMethodName    = "/main.GradesService/GetAssessments"
+	GradesService_AddAssessments_FullMethodName    = "/main.GradesService/AddAssessments"
+	GradesService_UpdateAssessments_FullMethodName = "/main.GradesService/UpdateAssessments"
+	GradesService_DeleteAssessments_FullMethodName = "/main.GradesService/DeleteAssessments"
+	GradesService_RecordScores_FullMethodName      = "/main.GradesService/RecordScores"
+	GradesService_GetScores_FullMethodName         = "/main.GradesService/GetScores"
+	GradesService_GetCourseGrades_FullMethodName   = "/main.GradesService/GetCourseGrades"
+)
+
+// GradesServiceClient is the client API for GradesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GradesServiceClient interface {
+	GetAssessments(ctx context.Context, in *GetAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error)
+	AddAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error)
+	UpdateAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error)
+	DeleteAssessments(ctx context.Context, in *AssessmentIds, opts ...grpc.CallOption) (*DeleteAssessmentsConfirm, error)
+	RecordScores(ctx context.Context, in *RecordScoresRequest, opts ...grpc.CallOption) (*Scores, error)
+	GetScores(ctx context.Context, in *GetScoresRequest, opts ...grpc.CallOption) (*Scores, error)
+	GetCourseGrades(ctx context.Context, in *CourseGradesRequest, opts ...grpc.CallOption) (*CourseGrades, error)
+}
+
+type gradesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradesServiceClient(cc grpc.ClientConnInterface) GradesServiceClient {
+	return &gradesServiceClient{cc}
+}
+
+func (c *gradesServiceClient) GetAssessments(ctx context.Context, in *GetAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_GetAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) AddAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_AddAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) UpdateAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_UpdateAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) DeleteAssessments(ctx context.Context, in *AssessmentIds, opts ...grpc.CallOption) (*DeleteAssessmentsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssessmentsConfirm)
+	err := c.cc.Invoke(ctx, GradesService_DeleteAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) RecordScores(ctx context.Context, in *RecordScoresRequest, opts ...grpc.CallOption) (*Scores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scores)
+	err := c.cc.Invoke(ctx, GradesService_RecordScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetScores(ctx context.Context, in *GetScoresRequest, opts ...grpc.CallOption) (*Scores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scores)
+	err := c.cc.Invoke(ctx, GradesService_GetScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCourseGrades(ctx context.Context, in *CourseGradesRequest, opts ...grpc.CallOption) (*CourseGrades, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseGrades)
+	err := c.cc.Invoke(ctx, GradesService_GetCourseGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradesServiceServer is the server API for GradesService service.
+// All implementations must embed UnimplementedGradesServiceServer
+// for forward compatibility.
+type GradesServiceServer interface {
+	GetAssessments(context.Context, *GetAssessmentsRequest) (*Assessments, error)
+	AddAssessments(context.Context, *Assessments) (*Assessments, error)
+	UpdateAssessments(context.Context, *Assessments) (*Assessments, error)
+	DeleteAssessments(context.Context, *AssessmentIds) (*DeleteAssessmentsConfirm, error)
+	RecordScores(context.Context, *RecordScoresRequest) (*Scores, error)
+	GetScores(context.Context, *GetScoresRequest) (*Scores, error)
+	GetCourseGrades(context.Context, *CourseGradesRequest) (*CourseGrades, error)
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+// UnimplementedGradesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradesServiceServer struct{}
+
+func (UnimplementedGradesServiceServer) GetAssessments(context.Context, *GetAssessmentsRequest) (*Assessments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) AddAssessments(context.Context, *Assessments) (*Assessments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) UpdateAssessments(context.Context, *Assessments) (*Assessments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) DeleteAssessments(context.Context, *AssessmentIds) (*DeleteAssessmentsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) RecordScores(context.Context, *RecordScoresRequest) (*Scores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordScores not implemented")
+}
+func (UnimplementedGradesServiceServer) GetScores(context.Context, *GetScoresRequest) (*Scores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScores not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCourseGrades(context.Context, *CourseGradesRequest) (*CourseGrades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) mustEmbedUnimplementedGradesServiceServer() {}
+func (UnimplementedGradesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGradesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradesServiceServer will
+// result in compilation errors.
+type UnsafeGradesServiceServer interface {
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+func RegisterGradesServiceServer(s grpc.ServiceRegistrar, srv GradesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGradesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradesService_ServiceDesc, srv)
+}
+
+func _GradesService_GetAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetAssessments(ctx, req.(*GetAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_AddAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Assessments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).AddAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_AddAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).AddAssessments(ctx, req.(*Assessments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_UpdateAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Assessments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).UpdateAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_UpdateAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).UpdateAssessments(ctx, req.(*Assessments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_DeleteAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessmentIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).DeleteAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_DeleteAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).DeleteAssessments(ctx, req.(*AssessmentIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RecordScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RecordScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RecordScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RecordScores(ctx, req.(*RecordScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetScores(ctx, req.(*GetScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCourseGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseGrades(ctx, req.(*CourseGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradesService_ServiceDesc is the grpc.ServiceDesc for GradesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.GradesService",
+	HandlerType: (*GradesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAssessments",
+			Handler:    _GradesService_GetAssessments_Handler,
+		},
+		{
+			MethodName: "AddAssessments",
+			Handler:    _GradesService_AddAssessments_Handler,
+		},
+		{
+			MethodName: "UpdateAssessments",
+			Handler:    _GradesService_UpdateAssessments_Handler,
+		},
+		{
+			MethodName: "DeleteAssessments",
+			Handler:    _GradesService_DeleteAssessments_Handler,
+		},
+		{
+			MethodName: "RecordScores",
+			Handler:    _GradesService_RecordScores_Handler,
+		},
+		{
+			MethodName: "GetScores",
+			Handler:    _GradesService_GetScores_Handler,
+		},
+		{
+			MethodName: "GetCourseGrades",
+			Handler:    _GradesService_GetCourseGrades_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "grades.proto",
+}
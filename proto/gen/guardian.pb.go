@@ -0,0 +1,574 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: guardian.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a parent or guardian, siblings share the same guardian record
+type Guardian struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Relationship  string                 `protobuf:"bytes,4,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guardian) Reset() {
+	*x = Guardian{}
+	mi := &file_guardian_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardian) ProtoMessage() {}
+
+func (x *Guardian) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardian.ProtoReflect.Descriptor instead.
+func (*Guardian) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Guardian) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Guardian) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Guardian) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Guardian) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *Guardian) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Guardian) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Guardian) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Guardian) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Guardian) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type Guardians struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardians     []*Guardian            `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guardians) Reset() {
+	*x = Guardians{}
+	mi := &file_guardian_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guardians) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardians) ProtoMessage() {}
+
+func (x *Guardians) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardians.ProtoReflect.Descriptor instead.
+func (*Guardians) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Guardians) GetGuardians() []*Guardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+type GuardianIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianIds   []string               `protobuf:"bytes,1,rep,name=guardianIds,proto3" json:"guardianIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianIds) Reset() {
+	*x = GuardianIds{}
+	mi := &file_guardian_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianIds) ProtoMessage() {}
+
+func (x *GuardianIds) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianIds.ProtoReflect.Descriptor instead.
+func (*GuardianIds) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GuardianIds) GetGuardianIds() []string {
+	if x != nil {
+		return x.GuardianIds
+	}
+	return nil
+}
+
+type DeleteGuardiansConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuardiansConfirm) Reset() {
+	*x = DeleteGuardiansConfirm{}
+	mi := &file_guardian_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuardiansConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuardiansConfirm) ProtoMessage() {}
+
+func (x *DeleteGuardiansConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuardiansConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteGuardiansConfirm) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteGuardiansConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteGuardiansConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetGuardianRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Guardian       *Guardian              `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGuardianRequest) Reset() {
+	*x = GetGuardianRequest{}
+	mi := &file_guardian_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardianRequest) ProtoMessage() {}
+
+func (x *GetGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardianRequest.ProtoReflect.Descriptor instead.
+func (*GetGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGuardianRequest) GetGuardian() *Guardian {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *GetGuardianRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetGuardianRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetGuardianRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGuardianRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// links a guardian to a student. priority is the emergency contact order of the student's guardians, 1 is called
+// first. linking with priority 0 puts the guardian last, linking an already linked guardian changes its priority.
+type GuardianLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	GuardianId    string                 `protobuf:"bytes,2,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianLink) Reset() {
+	*x = GuardianLink{}
+	mi := &file_guardian_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianLink) ProtoMessage() {}
+
+func (x *GuardianLink) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianLink.ProtoReflect.Descriptor instead.
+func (*GuardianLink) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GuardianLink) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type UnlinkGuardianConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkGuardianConfirm) Reset() {
+	*x = UnlinkGuardianConfirm{}
+	mi := &file_guardian_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkGuardianConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkGuardianConfirm) ProtoMessage() {}
+
+func (x *UnlinkGuardianConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_guardian_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkGuardianConfirm.ProtoReflect.Descriptor instead.
+func (*UnlinkGuardianConfirm) Descriptor() ([]byte, []int) {
+	return file_guardian_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnlinkGuardianConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_guardian_proto protoreflect.FileDescriptor
+
+const file_guardian_proto_rawDesc = "" +
+	"\n" +
+	"\x0eguardian.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"\xe2\x02\n" +
+	"\bGuardian\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tB\x13\xfaB\x10r\x0e2\f^[A-Za-z ]*$R\tfirstName\x120\n" +
+	"\tlast_name\x18\x03 \x01(\tB\x13\xfaB\x10r\x0e2\f^[A-Za-z ]*$R\blastName\x127\n" +
+	"\frelationship\x18\x04 \x01(\tB\x13\xfaB\x10r\x0e2\f^[A-Za-z ]*$R\frelationship\x12-\n" +
+	"\x05phone\x18\x05 \x01(\tB\x17\xfaB\x14r\x122\x10^[+]?[0-9 ()-]*$R\x05phone\x12 \n" +
+	"\x05email\x18\x06 \x01(\tB\n" +
+	"\xfaB\ar\x05\xd0\x01\x01`\x01R\x05email\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tR\tdeletedBy\"9\n" +
+	"\tGuardians\x12,\n" +
+	"\tguardians\x18\x01 \x03(\v2\x0e.main.GuardianR\tguardians\"9\n" +
+	"\vGuardianIds\x12*\n" +
+	"\vguardianIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\vguardianIds\"Q\n" +
+	"\x16DeleteGuardiansConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xcb\x01\n" +
+	"\x12GetGuardianRequest\x12*\n" +
+	"\bguardian\x18\x01 \x01(\v2\x0e.main.GuardianR\bguardian\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\xaf\x01\n" +
+	"\fGuardianLink\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12=\n" +
+	"\vguardian_id\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\n" +
+	"guardianId\x12#\n" +
+	"\bpriority\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bpriority\"/\n" +
+	"\x15UnlinkGuardianConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status2\xf3\x02\n" +
+	"\x10GuardiansService\x129\n" +
+	"\fGetGuardians\x12\x18.main.GetGuardianRequest\x1a\x0f.main.Guardians\x120\n" +
+	"\fAddGuardians\x12\x0f.main.Guardians\x1a\x0f.main.Guardians\x123\n" +
+	"\x0fUpdateGuardians\x12\x0f.main.Guardians\x1a\x0f.main.Guardians\x12B\n" +
+	"\x0fDeleteGuardians\x12\x11.main.GuardianIds\x1a\x1c.main.DeleteGuardiansConfirm\x126\n" +
+	"\fLinkGuardian\x12\x12.main.GuardianLink\x1a\x12.main.GuardianLink\x12A\n" +
+	"\x0eUnlinkGuardian\x12\x12.main.GuardianLink\x1a\x1b.main.UnlinkGuardianConfirmB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_guardian_proto_rawDescOnce sync.Once
+	file_guardian_proto_rawDescData []byte
+)
+
+func file_guardian_proto_rawDescGZIP() []byte {
+	file_guardian_proto_rawDescOnce.Do(func() {
+		file_guardian_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guardian_proto_rawDesc), len(file_guardian_proto_rawDesc)))
+	})
+	return file_guardian_proto_rawDescData
+}
+
+var file_guardian_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_guardian_proto_goTypes = []any{
+	(*Guardian)(nil),               // 0: main.Guardian
+	(*Guardians)(nil),              // 1: main.Guardians
+	(*GuardianIds)(nil),            // 2: main.GuardianIds
+	(*DeleteGuardiansConfirm)(nil), // 3: main.DeleteGuardiansConfirm
+	(*GetGuardianRequest)(nil),     // 4: main.GetGuardianRequest
+	(*GuardianLink)(nil),           // 5: main.GuardianLink
+	(*UnlinkGuardianConfirm)(nil),  // 6: main.UnlinkGuardianConfirm
+	(*SortField)(nil),              // 7: main.SortField
+}
+var file_guardian_proto_depIdxs = []int32{
+	0, // 0: main.Guardians.guardians:type_name -> main.Guardian
+	0, // 1: main.GetGuardianRequest.guardian:type_name -> main.Guardian
+	7, // 2: main.GetGuardianRequest.sort_by:type_name -> main.SortField
+	4, // 3: main.GuardiansService.GetGuardians:input_type -> main.GetGuardianRequest
+	1, // 4: main.GuardiansService.AddGuardians:input_type -> main.Guardians
+	1, // 5: main.GuardiansService.UpdateGuardians:input_type -> main.Guardians
+	2, // 6: main.GuardiansService.DeleteGuardians:input_type -> main.GuardianIds
+	5, // 7: main.GuardiansService.LinkGuardian:input_type -> main.GuardianLink
+	5, // 8: main.GuardiansService.UnlinkGuardian:input_type -> main.GuardianLink
+	1, // 9: main.GuardiansService.GetGuardians:output_type -> main.Guardians
+	1, // 10: main.GuardiansService.AddGuardians:output_type -> main.Guardians
+	1, // 11: main.GuardiansService.UpdateGuardians:output_type -> main.Guardians
+	3, // 12: main.GuardiansService.DeleteGuardians:output_type -> main.DeleteGuardiansConfirm
+	5, // 13: main.GuardiansService.LinkGuardian:output_type -> main.GuardianLink
+	6, // 14: main.GuardiansService.UnlinkGuardian:output_type -> main.UnlinkGuardianConfirm
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_guardian_proto_init() }
+func file_guardian_proto_init() {
+	if File_guardian_proto != nil {
+		return
+	}
+	file_student_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardian_proto_rawDesc), len(file_guardian_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardian_proto_goTypes,
+		DependencyIndexes: file_guardian_proto_depIdxs,
+		MessageInfos:      file_guardian_proto_msgTypes,
+	}.Build()
+	File_guardian_proto = out.File
+	file_guardian_proto_goTypes = nil
+	file_guardian_proto_depIdxs = nil
+}
@@ -0,0 +1,1043 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: guardian.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Guardian with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Guardian) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Guardian with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuardianMultiError, or nil
+// if none found.
+func (m *Guardian) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Guardian) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_Guardian_FirstName_Pattern.MatchString(m.GetFirstName()) {
+		err := GuardianValidationError{
+			field:  "FirstName",
+			reason: "value does not match regex pattern \"^[A-Za-z ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Guardian_LastName_Pattern.MatchString(m.GetLastName()) {
+		err := GuardianValidationError{
+			field:  "LastName",
+			reason: "value does not match regex pattern \"^[A-Za-z ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Guardian_Relationship_Pattern.MatchString(m.GetRelationship()) {
+		err := GuardianValidationError{
+			field:  "Relationship",
+			reason: "value does not match regex pattern \"^[A-Za-z ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Guardian_Phone_Pattern.MatchString(m.GetPhone()) {
+		err := GuardianValidationError{
+			field:  "Phone",
+			reason: "value does not match regex pattern \"^[+]?[0-9 ()-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = GuardianValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return GuardianMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Guardian) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Guardian) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GuardianMultiError is an error wrapping multiple validation errors returned
+// by Guardian.ValidateAll() if the designated constraints aren't met.
+type GuardianMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuardianMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuardianMultiError) AllErrors() []error { return m }
+
+// GuardianValidationError is the validation error returned by
+// Guardian.Validate if the designated constraints aren't met.
+type GuardianValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuardianValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuardianValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuardianValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuardianValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuardianValidationError) ErrorName() string { return "GuardianValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuardianValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuardian.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuardianValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuardianValidationError{}
+
+var _Guardian_FirstName_Pattern = regexp.MustCompile("^[A-Za-z ]*$")
+
+var _Guardian_LastName_Pattern = regexp.MustCompile("^[A-Za-z ]*$")
+
+var _Guardian_Relationship_Pattern = regexp.MustCompile("^[A-Za-z ]*$")
+
+var _Guardian_Phone_Pattern = regexp.MustCompile("^[+]?[0-9 ()-]*$")
+
+// Validate checks the field values on Guardians with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Guardians) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Guardians with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuardiansMultiError, or nil
+// if none found.
+func (m *Guardians) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Guardians) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGuardians() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GuardiansValidationError{
+						field:  fmt.Sprintf("Guardians[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GuardiansValidationError{
+						field:  fmt.Sprintf("Guardians[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GuardiansValidationError{
+					field:  fmt.Sprintf("Guardians[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GuardiansMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuardiansMultiError is an error wrapping multiple validation errors returned
+// by Guardians.ValidateAll() if the designated constraints aren't met.
+type GuardiansMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuardiansMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuardiansMultiError) AllErrors() []error { return m }
+
+// GuardiansValidationError is the validation error returned by
+// Guardians.Validate if the designated constraints aren't met.
+type GuardiansValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuardiansValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuardiansValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuardiansValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuardiansValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuardiansValidationError) ErrorName() string { return "GuardiansValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuardiansValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuardians.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuardiansValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuardiansValidationError{}
+
+// Validate checks the field values on GuardianIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GuardianIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuardianIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuardianIdsMultiError, or
+// nil if none found.
+func (m *GuardianIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuardianIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetGuardianIds()) < 1 {
+		err := GuardianIdsValidationError{
+			field:  "GuardianIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GuardianIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuardianIdsMultiError is an error wrapping multiple validation errors
+// returned by GuardianIds.ValidateAll() if the designated constraints aren't met.
+type GuardianIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuardianIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuardianIdsMultiError) AllErrors() []error { return m }
+
+// GuardianIdsValidationError is the validation error returned by
+// GuardianIds.Validate if the designated constraints aren't met.
+type GuardianIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuardianIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuardianIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuardianIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuardianIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuardianIdsValidationError) ErrorName() string { return "GuardianIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuardianIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuardianIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuardianIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuardianIdsValidationError{}
+
+// Validate checks the field values on DeleteGuardiansConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteGuardiansConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteGuardiansConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteGuardiansConfirmMultiError, or nil if none found.
+func (m *DeleteGuardiansConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteGuardiansConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteGuardiansConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteGuardiansConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteGuardiansConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteGuardiansConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteGuardiansConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteGuardiansConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteGuardiansConfirmValidationError is the validation error returned by
+// DeleteGuardiansConfirm.Validate if the designated constraints aren't met.
+type DeleteGuardiansConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGuardiansConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGuardiansConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGuardiansConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGuardiansConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGuardiansConfirmValidationError) ErrorName() string {
+	return "DeleteGuardiansConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGuardiansConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGuardiansConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGuardiansConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGuardiansConfirmValidationError{}
+
+// Validate checks the field values on GetGuardianRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGuardianRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGuardianRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGuardianRequestMultiError, or nil if none found.
+func (m *GetGuardianRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGuardianRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGuardian()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetGuardianRequestValidationError{
+					field:  "Guardian",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetGuardianRequestValidationError{
+					field:  "Guardian",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGuardian()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetGuardianRequestValidationError{
+				field:  "Guardian",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetGuardianRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetGuardianRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetGuardianRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetGuardianRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGuardianRequestMultiError is an error wrapping multiple validation errors
+// returned by GetGuardianRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetGuardianRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGuardianRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGuardianRequestMultiError) AllErrors() []error { return m }
+
+// GetGuardianRequestValidationError is the validation error returned by
+// GetGuardianRequest.Validate if the designated constraints aren't met.
+type GetGuardianRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGuardianRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGuardianRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGuardianRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGuardianRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGuardianRequestValidationError) ErrorName() string {
+	return "GetGuardianRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGuardianRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGuardianRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGuardianRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGuardianRequestValidationError{}
+
+// Validate checks the field values on GuardianLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GuardianLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuardianLink with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuardianLinkMultiError, or
+// nil if none found.
+func (m *GuardianLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuardianLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := GuardianLinkValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_GuardianLink_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := GuardianLinkValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetGuardianId()) != 24 {
+		err := GuardianLinkValidationError{
+			field:  "GuardianId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_GuardianLink_GuardianId_Pattern.MatchString(m.GetGuardianId()) {
+		err := GuardianLinkValidationError{
+			field:  "GuardianId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPriority() < 0 {
+		err := GuardianLinkValidationError{
+			field:  "Priority",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GuardianLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuardianLinkMultiError is an error wrapping multiple validation errors
+// returned by GuardianLink.ValidateAll() if the designated constraints aren't met.
+type GuardianLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuardianLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuardianLinkMultiError) AllErrors() []error { return m }
+
+// GuardianLinkValidationError is the validation error returned by
+// GuardianLink.Validate if the designated constraints aren't met.
+type GuardianLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuardianLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuardianLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuardianLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuardianLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuardianLinkValidationError) ErrorName() string { return "GuardianLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuardianLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuardianLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuardianLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuardianLinkValidationError{}
+
+var _GuardianLink_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GuardianLink_GuardianId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on UnlinkGuardianConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlinkGuardianConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlinkGuardianConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlinkGuardianConfirmMultiError, or nil if none found.
+func (m *UnlinkGuardianConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlinkGuardianConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return UnlinkGuardianConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlinkGuardianConfirmMultiError is an error wrapping multiple validation
+// errors returned by UnlinkGuardianConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type UnlinkGuardianConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlinkGuardianConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlinkGuardianConfirmMultiError) AllErrors() []error { return m }
+
+// UnlinkGuardianConfirmValidationError is the validation error returned by
+// UnlinkGuardianConfirm.Validate if the designated constraints aren't met.
+type UnlinkGuardianConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlinkGuardianConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlinkGuardianConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlinkGuardianConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlinkGuardianConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlinkGuardianConfirmValidationError) ErrorName() string {
+	return "UnlinkGuardianConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlinkGuardianConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlinkGuardianConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlinkGuardianConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlinkGuardianConfirmValidationError{}
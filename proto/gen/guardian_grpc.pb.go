@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: guardian.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GuardiansService_GetGuardians_FullMethodName    = "/main.GuardiansService/GetGuardians"
+	GuardiansService_AddGuardians_FullMethodName    = "/main.GuardiansService/AddGuardians"
+	GuardiansService_UpdateGuardians_FullMethodName = "/main.GuardiansService/UpdateGuardians"
+	GuardiansService_DeleteGuardians_FullMethodName = "/main.GuardiansService/DeleteGuardians"
+	GuardiansService_LinkGuardian_FullMethodName    = "/main.GuardiansService/LinkGuardian"
+	GuardiansService_UnlinkGuardian_FullMethodName  = "/main.GuardiansService/UnlinkGuardian"
+)
+
+// GuardiansServiceClient is the client API for GuardiansService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuardiansServiceClient interface {
+	GetGuardians(ctx context.Context, in *GetGuardianRequest, opts ...grpc.CallOption) (*Guardians, error)
+	AddGuardians(ctx context.Context, in *Guardians, opts ...grpc.CallOption) (*Guardians, error)
+	UpdateGuardians(ctx context.Context, in *Guardians, opts ...grpc.CallOption) (*Guardians, error)
+	DeleteGuardians(ctx context.Context, in *GuardianIds, opts ...grpc.CallOption) (*DeleteGuardiansConfirm, error)
+	LinkGuardian(ctx context.Context, in *GuardianLink, opts ...grpc.CallOption) (*GuardianLink, error)
+	UnlinkGuardian(ctx context.Context, in *GuardianLink, opts ...grpc.CallOption) (*UnlinkGuardianConfirm, error)
+}
+
+type guardiansServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuardiansServiceClient(cc grpc.ClientConnInterface) GuardiansServiceClient {
+	return &guardiansServiceClient{cc}
+}
+
+func (c *guardiansServiceClient) GetGuardians(ctx context.Context, in *GetGuardianRequest, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_GetGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) AddGuardians(ctx context.Context, in *Guardians, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_AddGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) UpdateGuardians(ctx context.Context, in *Guardians, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_UpdateGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) DeleteGuardians(ctx context.Context, in *GuardianIds, opts ...grpc.CallOption) (*DeleteGuardiansConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGuardiansConfirm)
+	err := c.cc.Invoke(ctx, GuardiansService_DeleteGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) LinkGuardian(ctx context.Context, in *GuardianLink, opts ...grpc.CallOption) (*GuardianLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuardianLink)
+	err := c.cc.Invoke(ctx, GuardiansService_LinkGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) UnlinkGuardian(ctx context.Context, in *GuardianLink, opts ...grpc.CallOption) (*UnlinkGuardianConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkGuardianConfirm)
+	err := c.cc.Invoke(ctx, GuardiansService_UnlinkGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuardiansServiceServer is the server API for GuardiansService service.
+// All implementations must embed UnimplementedGuardiansServiceServer
+// for forward compatibility.
+type GuardiansServiceServer interface {
+	GetGuardians(context.Context, *GetGuardianRequest) (*Guardians, error)
+	AddGuardians(context.Context, *Guardians) (*Guardians, error)
+	UpdateGuardians(context.Context, *Guardians) (*Guardians, error)
+	DeleteGuardians(context.Context, *GuardianIds) (*DeleteGuardiansConfirm, error)
+	LinkGuardian(context.Context, *GuardianLink) (*GuardianLink, error)
+	UnlinkGuardian(context.Context, *GuardianLink) (*UnlinkGuardianConfirm, error)
+	mustEmbedUnimplementedGuardiansServiceServer()
+}
+
+// UnimplementedGuardiansServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuardiansServiceServer struct{}
+
+func (UnimplementedGuardiansServiceServer) GetGuardians(context.Context, *GetGuardianRequest) (*Guardians, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) AddGuardians(context.Context, *Guardians) (*Guardians, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) UpdateGuardians(context.Context, *Guardians) (*Guardians, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) DeleteGuardians(context.Context, *GuardianIds) (*DeleteGuardiansConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) LinkGuardian(context.Context, *GuardianLink) (*GuardianLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkGuardian not implemented")
+}
+func (UnimplementedGuardiansServiceServer) UnlinkGuardian(context.Context, *GuardianLink) (*UnlinkGuardianConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkGuardian not implemented")
+}
+func (UnimplementedGuardiansServiceServer) mustEmbedUnimplementedGuardiansServiceServer() {}
+func (UnimplementedGuardiansServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGuardiansServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuardiansServiceServer will
+// result in compilation errors.
+type UnsafeGuardiansServiceServer interface {
+	mustEmbedUnimplementedGuardiansServiceServer()
+}
+
+func RegisterGuardiansServiceServer(s grpc.ServiceRegistrar, srv GuardiansServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGuardiansServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuardiansService_ServiceDesc, srv)
+}
+
+func _GuardiansService_GetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).GetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_GetGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).GetGuardians(ctx, req.(*GetGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_AddGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Guardians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).AddGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_AddGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).AddGuardians(ctx, req.(*Guardians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_UpdateGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Guardians)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).UpdateGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_UpdateGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).UpdateGuardians(ctx, req.(*Guardians))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_DeleteGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).DeleteGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_DeleteGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).DeleteGuardians(ctx, req.(*GuardianIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_LinkGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).LinkGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_LinkGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).LinkGuardian(ctx, req.(*GuardianLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_UnlinkGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).UnlinkGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_UnlinkGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).UnlinkGuardian(ctx, req.(*GuardianLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuardiansService_ServiceDesc is the grpc.ServiceDesc for GuardiansService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuardiansService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.GuardiansService",
+	HandlerType: (*GuardiansServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGuardians",
+			Handler:    _GuardiansService_GetGuardians_Handler,
+		},
+		{
+			MethodName: "AddGuardians",
+			Handler:    _GuardiansService_AddGuardians_Handler,
+		},
+		{
+			MethodName: "UpdateGuardians",
+			Handler:    _GuardiansService_UpdateGuardians_Handler,
+		},
+		{
+			MethodName: "DeleteGuardians",
+			Handler:    _GuardiansService_DeleteGuardians_Handler,
+		},
+		{
+			MethodName: "LinkGuardian",
+			Handler:    _GuardiansService_LinkGuardian_Handler,
+		},
+		{
+			MethodName: "UnlinkGuardian",
+			Handler:    _GuardiansService_UnlinkGuardian_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian.proto",
+}
@@ -22,6 +22,126 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReassignClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromClass     string                 `protobuf:"bytes,1,opt,name=from_class,json=fromClass,proto3" json:"from_class,omitempty"`
+	ToClass       string                 `protobuf:"bytes,2,opt,name=to_class,json=toClass,proto3" json:"to_class,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignClassRequest) Reset() {
+	*x = ReassignClassRequest{}
+	mi := &file_main_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignClassRequest) ProtoMessage() {}
+
+func (x *ReassignClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignClassRequest.ProtoReflect.Descriptor instead.
+func (*ReassignClassRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReassignClassRequest) GetFromClass() string {
+	if x != nil {
+		return x.FromClass
+	}
+	return ""
+}
+
+func (x *ReassignClassRequest) GetToClass() string {
+	if x != nil {
+		return x.ToClass
+	}
+	return ""
+}
+
+func (x *ReassignClassRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type ReassignClassResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	StudentsMoved int32                  `protobuf:"varint,2,opt,name=students_moved,json=studentsMoved,proto3" json:"students_moved,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignClassResponse) Reset() {
+	*x = ReassignClassResponse{}
+	mi := &file_main_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignClassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignClassResponse) ProtoMessage() {}
+
+func (x *ReassignClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignClassResponse.ProtoReflect.Descriptor instead.
+func (*ReassignClassResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReassignClassResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ReassignClassResponse) GetStudentsMoved() int32 {
+	if x != nil {
+		return x.StudentsMoved
+	}
+	return 0
+}
+
+func (x *ReassignClassResponse) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
 type StudentCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -32,7 +152,7 @@ type StudentCount struct {
 
 func (x *StudentCount) Reset() {
 	*x = StudentCount{}
-	mi := &file_main_proto_msgTypes[0]
+	mi := &file_main_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +164,7 @@ func (x *StudentCount) String() string {
 func (*StudentCount) ProtoMessage() {}
 
 func (x *StudentCount) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[0]
+	mi := &file_main_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +177,7 @@ func (x *StudentCount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudentCount.ProtoReflect.Descriptor instead.
 func (*StudentCount) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{0}
+	return file_main_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *StudentCount) GetStatus() bool {
@@ -84,7 +204,7 @@ type DeleteTeacherConfirm struct {
 
 func (x *DeleteTeacherConfirm) Reset() {
 	*x = DeleteTeacherConfirm{}
-	mi := &file_main_proto_msgTypes[1]
+	mi := &file_main_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +216,7 @@ func (x *DeleteTeacherConfirm) String() string {
 func (*DeleteTeacherConfirm) ProtoMessage() {}
 
 func (x *DeleteTeacherConfirm) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[1]
+	mi := &file_main_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +229,7 @@ func (x *DeleteTeacherConfirm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeacherConfirm.ProtoReflect.Descriptor instead.
 func (*DeleteTeacherConfirm) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{1}
+	return file_main_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DeleteTeacherConfirm) GetStatus() string {
@@ -126,6 +246,58 @@ func (x *DeleteTeacherConfirm) GetDeletedIds() []string {
 	return nil
 }
 
+type RestoreTeacherConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTeacherConfirm) Reset() {
+	*x = RestoreTeacherConfirm{}
+	mi := &file_main_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTeacherConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTeacherConfirm) ProtoMessage() {}
+
+func (x *RestoreTeacherConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTeacherConfirm.ProtoReflect.Descriptor instead.
+func (*RestoreTeacherConfirm) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestoreTeacherConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreTeacherConfirm) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
 type TeacherId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -135,7 +307,7 @@ type TeacherId struct {
 
 func (x *TeacherId) Reset() {
 	*x = TeacherId{}
-	mi := &file_main_proto_msgTypes[2]
+	mi := &file_main_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +319,7 @@ func (x *TeacherId) String() string {
 func (*TeacherId) ProtoMessage() {}
 
 func (x *TeacherId) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[2]
+	mi := &file_main_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +332,7 @@ func (x *TeacherId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeacherId.ProtoReflect.Descriptor instead.
 func (*TeacherId) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{2}
+	return file_main_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TeacherId) GetId() string {
@@ -173,13 +345,15 @@ func (x *TeacherId) GetId() string {
 type TeacherIds struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeacherIds    []*TeacherId           `protobuf:"bytes,1,rep,name=teacherIds,proto3" json:"teacherIds,omitempty"`
+	ReassignTo    string                 `protobuf:"bytes,2,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TeacherIds) Reset() {
 	*x = TeacherIds{}
-	mi := &file_main_proto_msgTypes[3]
+	mi := &file_main_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +365,7 @@ func (x *TeacherIds) String() string {
 func (*TeacherIds) ProtoMessage() {}
 
 func (x *TeacherIds) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[3]
+	mi := &file_main_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +378,7 @@ func (x *TeacherIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeacherIds.ProtoReflect.Descriptor instead.
 func (*TeacherIds) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{3}
+	return file_main_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TeacherIds) GetTeacherIds() []*TeacherId {
@@ -214,19 +388,44 @@ func (x *TeacherIds) GetTeacherIds() []*TeacherId {
 	return nil
 }
 
+func (x *TeacherIds) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+func (x *TeacherIds) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type GetTeacherRequset struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teacher       *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
-	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	PageNum       uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Teacher        *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// teachers with their class and courses of an earlier term, the current term when empty
+	Term string `protobuf:"bytes,6,opt,name=term,proto3" json:"term,omitempty"`
+	// the next_page_token of the previous page, the first page when empty. a token only works with the sort it was
+	// made for. page_num is only used without a token and is kept for older clients
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// count every record matching the filter into total_size, an extra query
+	IncludeTotalSize bool `protobuf:"varint,8,opt,name=include_total_size,json=includeTotalSize,proto3" json:"include_total_size,omitempty"`
+	// filter expression like: last_name = "Sm*" AND class IN ("9A", "9B"), matched together with the fields set above.
+	// = (a trailing * matches a prefix), != < <= > >= : (contains, ignoring case), IN, AND, OR, NOT and parentheses
+	Filter        string `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTeacherRequset) Reset() {
 	*x = GetTeacherRequset{}
-	mi := &file_main_proto_msgTypes[4]
+	mi := &file_main_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +437,7 @@ func (x *GetTeacherRequset) String() string {
 func (*GetTeacherRequset) ProtoMessage() {}
 
 func (x *GetTeacherRequset) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[4]
+	mi := &file_main_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +450,7 @@ func (x *GetTeacherRequset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeacherRequset.ProtoReflect.Descriptor instead.
 func (*GetTeacherRequset) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{4}
+	return file_main_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetTeacherRequset) GetTeacher() *Teacher {
@@ -282,6 +481,41 @@ func (x *GetTeacherRequset) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *GetTeacherRequset) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetTeacherRequset) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *GetTeacherRequset) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTeacherRequset) GetIncludeTotalSize() bool {
+	if x != nil {
+		return x.IncludeTotalSize
+	}
+	return false
+}
+
+func (x *GetTeacherRequset) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type Teacher struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -290,13 +524,17 @@ type Teacher struct {
 	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	Class         string                 `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
 	Subject       string                 `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,8,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	ClassId       string                 `protobuf:"bytes,9,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Courses       []*CourseAssignment    `protobuf:"bytes,10,rep,name=courses,proto3" json:"courses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Teacher) Reset() {
 	*x = Teacher{}
-	mi := &file_main_proto_msgTypes[5]
+	mi := &file_main_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -308,7 +546,7 @@ func (x *Teacher) String() string {
 func (*Teacher) ProtoMessage() {}
 
 func (x *Teacher) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[5]
+	mi := &file_main_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +559,7 @@ func (x *Teacher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Teacher.ProtoReflect.Descriptor instead.
 func (*Teacher) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{5}
+	return file_main_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Teacher) GetId() string {
@@ -366,16 +604,48 @@ func (x *Teacher) GetSubject() string {
 	return ""
 }
 
+func (x *Teacher) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Teacher) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Teacher) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Teacher) GetCourses() []*CourseAssignment {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
 type Teachers struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teachers      []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Teachers []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// only set when include_total_size was asked for
+	TotalSize     int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Teachers) Reset() {
 	*x = Teachers{}
-	mi := &file_main_proto_msgTypes[6]
+	mi := &file_main_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +657,7 @@ func (x *Teachers) String() string {
 func (*Teachers) ProtoMessage() {}
 
 func (x *Teachers) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[6]
+	mi := &file_main_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +670,7 @@ func (x *Teachers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Teachers.ProtoReflect.Descriptor instead.
 func (*Teachers) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{6}
+	return file_main_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Teachers) GetTeachers() []*Teacher {
@@ -410,31 +680,242 @@ func (x *Teachers) GetTeachers() []*Teacher {
 	return nil
 }
 
+func (x *Teachers) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *Teachers) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// resume_token is the resume_token of the last event the client got, the watch continues after it
+type WatchTeachersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teacher       *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTeachersRequest) Reset() {
+	*x = WatchTeachersRequest{}
+	mi := &file_main_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTeachersRequest) ProtoMessage() {}
+
+func (x *WatchTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTeachersRequest.ProtoReflect.Descriptor instead.
+func (*WatchTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchTeachersRequest) GetTeacher() *Teacher {
+	if x != nil {
+		return x.Teacher
+	}
+	return nil
+}
+
+func (x *WatchTeachersRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// operation is created, updated, deleted or restored, deleted and restored are the soft delete and its undo
+type TeacherEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Teacher       *Teacher               `protobuf:"bytes,2,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeacherEvent) Reset() {
+	*x = TeacherEvent{}
+	mi := &file_main_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeacherEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeacherEvent) ProtoMessage() {}
+
+func (x *TeacherEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeacherEvent.ProtoReflect.Descriptor instead.
+func (*TeacherEvent) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TeacherEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *TeacherEvent) GetTeacher() *Teacher {
+	if x != nil {
+		return x.Teacher
+	}
+	return nil
+}
+
+func (x *TeacherEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *TeacherEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ExportTeachersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *GetTeacherRequset     `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Options       *ExportOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTeachersRequest) Reset() {
+	*x = ExportTeachersRequest{}
+	mi := &file_main_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTeachersRequest) ProtoMessage() {}
+
+func (x *ExportTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTeachersRequest.ProtoReflect.Descriptor instead.
+func (*ExportTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportTeachersRequest) GetQuery() *GetTeacherRequset {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExportTeachersRequest) GetOptions() *ExportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 var File_main_proto protoreflect.FileDescriptor
 
 const file_main_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"main.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"K\n" +
+	"main.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\x1a\fcourse.proto\"\xc0\x01\n" +
+	"\x14ReassignClassRequest\x127\n" +
+	"\n" +
+	"from_class\x18\x01 \x01(\tB\x18\xfaB\x15r\x13\x10\x012\x0f^[A-Za-z0-9 ]*$R\tfromClass\x123\n" +
+	"\bto_class\x18\x02 \x01(\tB\x18\xfaB\x15r\x13\x10\x012\x0f^[A-Za-z0-9 ]*$R\atoClass\x12:\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tteacherId\"u\n" +
+	"\x15ReassignClassResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12%\n" +
+	"\x0estudents_moved\x18\x02 \x01(\x05R\rstudentsMoved\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\"K\n" +
 	"\fStudentCount\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12#\n" +
 	"\rstudent_count\x18\x02 \x01(\x05R\fstudentCount\"O\n" +
 	"\x14DeleteTeacherConfirm\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"9\n" +
+	"deletedIds\"R\n" +
+	"\x15RestoreTeacherConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\"9\n" +
 	"\tTeacherId\x12,\n" +
-	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\"G\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\"\x9b\x01\n" +
 	"\n" +
 	"TeacherIds\x129\n" +
 	"\n" +
 	"teacherIds\x18\x01 \x03(\v2\x0f.main.TeacherIdB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
-	"teacherIds\"\x9e\x01\n" +
+	"teacherIds\x12<\n" +
+	"\vreassign_to\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\n" +
+	"reassignTo\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\xd9\x02\n" +
 	"\x11GetTeacherRequset\x12'\n" +
 	"\ateacher\x18\x01 \x01(\v2\r.main.TeacherR\ateacher\x12(\n" +
 	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
 	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xfe\x01\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\x12+\n" +
+	"\x04term\x18\x06 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12,\n" +
+	"\x12include_total_size\x18\b \x01(\bR\x10includeTotalSize\x12\x16\n" +
+	"\x06filter\x18\t \x01(\tR\x06filter\"\xa6\x03\n" +
 	"\aTeacher\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\n" +
@@ -442,16 +923,43 @@ const file_main_proto_rawDesc = "" +
 	"\tlast_name\x18\x03 \x01(\tB\x13\xfaB\x10r\x0e2\f^[A-Za-z ]*$R\blastName\x12\x1d\n" +
 	"\x05email\x18\x04 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12,\n" +
 	"\x05class\x18\x05 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x05class\x120\n" +
-	"\asubject\x18\x06 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\asubject\"5\n" +
+	"\asubject\x18\x06 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\asubject\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\b \x01(\tR\tdeletedBy\x126\n" +
+	"\bclass_id\x18\t \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x120\n" +
+	"\acourses\x18\n" +
+	" \x03(\v2\x16.main.CourseAssignmentR\acourses\"|\n" +
 	"\bTeachers\x12)\n" +
-	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers2\xee\x02\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"b\n" +
+	"\x14WatchTeachersRequest\x12'\n" +
+	"\ateacher\x18\x01 \x01(\v2\r.main.TeacherR\ateacher\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\x99\x01\n" +
+	"\fTeacherEvent\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12'\n" +
+	"\ateacher\x18\x02 \x01(\v2\r.main.TeacherR\ateacher\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"u\n" +
+	"\x15ExportTeachersRequest\x12-\n" +
+	"\x05query\x18\x01 \x01(\v2\x17.main.GetTeacherRequsetR\x05query\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.main.ExportOptionsR\aoptions2\xbe\x05\n" +
 	"\x0fTeachersService\x126\n" +
 	"\vGetTeachers\x12\x17.main.GetTeacherRequset\x1a\x0e.main.Teachers\x12-\n" +
 	"\vAddTeachers\x12\x0e.main.Teachers\x1a\x0e.main.Teachers\x120\n" +
 	"\x0eUpdateTeachers\x12\x0e.main.Teachers\x1a\x0e.main.Teachers\x12>\n" +
-	"\x0eDeleteTeachers\x12\x10.main.TeacherIds\x1a\x1a.main.DeleteTeacherConfirm\x12<\n" +
+	"\x0eDeleteTeachers\x12\x10.main.TeacherIds\x1a\x1a.main.DeleteTeacherConfirm\x12@\n" +
+	"\x0fRestoreTeachers\x12\x10.main.TeacherIds\x1a\x1b.main.RestoreTeacherConfirm\x12<\n" +
 	"\x19GetStudentsByClassTeacher\x12\x0f.main.TeacherId\x1a\x0e.main.Students\x12D\n" +
-	"\x1dGetStudentCountByClassTeacher\x12\x0f.main.TeacherId\x1a\x12.main.StudentCountB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+	"\x1dGetStudentCountByClassTeacher\x12\x0f.main.TeacherId\x1a\x12.main.StudentCount\x12H\n" +
+	"\rReassignClass\x12\x1a.main.ReassignClassRequest\x1a\x1b.main.ReassignClassResponse\x12A\n" +
+	"\rWatchTeachers\x12\x1a.main.WatchTeachersRequest\x1a\x12.main.TeacherEvent0\x01\x12;\n" +
+	"\x0eImportTeachers\x12\x13.main.ImportRequest\x1a\x12.main.ImportReport(\x01\x12B\n" +
+	"\x0eExportTeachers\x12\x1b.main.ExportTeachersRequest\x1a\x11.main.ExportChunk0\x01B\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_main_proto_rawDescOnce sync.Once
@@ -465,40 +973,66 @@ func file_main_proto_rawDescGZIP() []byte {
 	return file_main_proto_rawDescData
 }
 
-var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_main_proto_goTypes = []any{
-	(*StudentCount)(nil),         // 0: main.StudentCount
-	(*DeleteTeacherConfirm)(nil), // 1: main.DeleteTeacherConfirm
-	(*TeacherId)(nil),            // 2: main.TeacherId
-	(*TeacherIds)(nil),           // 3: main.TeacherIds
-	(*GetTeacherRequset)(nil),    // 4: main.GetTeacherRequset
-	(*Teacher)(nil),              // 5: main.Teacher
-	(*Teachers)(nil),             // 6: main.Teachers
-	(*SortField)(nil),            // 7: main.SortField
-	(*Students)(nil),             // 8: main.Students
+	(*ReassignClassRequest)(nil),  // 0: main.ReassignClassRequest
+	(*ReassignClassResponse)(nil), // 1: main.ReassignClassResponse
+	(*StudentCount)(nil),          // 2: main.StudentCount
+	(*DeleteTeacherConfirm)(nil),  // 3: main.DeleteTeacherConfirm
+	(*RestoreTeacherConfirm)(nil), // 4: main.RestoreTeacherConfirm
+	(*TeacherId)(nil),             // 5: main.TeacherId
+	(*TeacherIds)(nil),            // 6: main.TeacherIds
+	(*GetTeacherRequset)(nil),     // 7: main.GetTeacherRequset
+	(*Teacher)(nil),               // 8: main.Teacher
+	(*Teachers)(nil),              // 9: main.Teachers
+	(*WatchTeachersRequest)(nil),  // 10: main.WatchTeachersRequest
+	(*TeacherEvent)(nil),          // 11: main.TeacherEvent
+	(*ExportTeachersRequest)(nil), // 12: main.ExportTeachersRequest
+	(*SortField)(nil),             // 13: main.SortField
+	(*CourseAssignment)(nil),      // 14: main.CourseAssignment
+	(*ExportOptions)(nil),         // 15: main.ExportOptions
+	(*ImportRequest)(nil),         // 16: main.ImportRequest
+	(*Students)(nil),              // 17: main.Students
+	(*ImportReport)(nil),          // 18: main.ImportReport
+	(*ExportChunk)(nil),           // 19: main.ExportChunk
 }
 var file_main_proto_depIdxs = []int32{
-	2,  // 0: main.TeacherIds.teacherIds:type_name -> main.TeacherId
-	5,  // 1: main.GetTeacherRequset.teacher:type_name -> main.Teacher
-	7,  // 2: main.GetTeacherRequset.sort_by:type_name -> main.SortField
-	5,  // 3: main.Teachers.teachers:type_name -> main.Teacher
-	4,  // 4: main.TeachersService.GetTeachers:input_type -> main.GetTeacherRequset
-	6,  // 5: main.TeachersService.AddTeachers:input_type -> main.Teachers
-	6,  // 6: main.TeachersService.UpdateTeachers:input_type -> main.Teachers
-	3,  // 7: main.TeachersService.DeleteTeachers:input_type -> main.TeacherIds
-	2,  // 8: main.TeachersService.GetStudentsByClassTeacher:input_type -> main.TeacherId
-	2,  // 9: main.TeachersService.GetStudentCountByClassTeacher:input_type -> main.TeacherId
-	6,  // 10: main.TeachersService.GetTeachers:output_type -> main.Teachers
-	6,  // 11: main.TeachersService.AddTeachers:output_type -> main.Teachers
-	6,  // 12: main.TeachersService.UpdateTeachers:output_type -> main.Teachers
-	1,  // 13: main.TeachersService.DeleteTeachers:output_type -> main.DeleteTeacherConfirm
-	8,  // 14: main.TeachersService.GetStudentsByClassTeacher:output_type -> main.Students
-	0,  // 15: main.TeachersService.GetStudentCountByClassTeacher:output_type -> main.StudentCount
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	5,  // 0: main.TeacherIds.teacherIds:type_name -> main.TeacherId
+	8,  // 1: main.GetTeacherRequset.teacher:type_name -> main.Teacher
+	13, // 2: main.GetTeacherRequset.sort_by:type_name -> main.SortField
+	14, // 3: main.Teacher.courses:type_name -> main.CourseAssignment
+	8,  // 4: main.Teachers.teachers:type_name -> main.Teacher
+	8,  // 5: main.WatchTeachersRequest.teacher:type_name -> main.Teacher
+	8,  // 6: main.TeacherEvent.teacher:type_name -> main.Teacher
+	7,  // 7: main.ExportTeachersRequest.query:type_name -> main.GetTeacherRequset
+	15, // 8: main.ExportTeachersRequest.options:type_name -> main.ExportOptions
+	7,  // 9: main.TeachersService.GetTeachers:input_type -> main.GetTeacherRequset
+	9,  // 10: main.TeachersService.AddTeachers:input_type -> main.Teachers
+	9,  // 11: main.TeachersService.UpdateTeachers:input_type -> main.Teachers
+	6,  // 12: main.TeachersService.DeleteTeachers:input_type -> main.TeacherIds
+	6,  // 13: main.TeachersService.RestoreTeachers:input_type -> main.TeacherIds
+	5,  // 14: main.TeachersService.GetStudentsByClassTeacher:input_type -> main.TeacherId
+	5,  // 15: main.TeachersService.GetStudentCountByClassTeacher:input_type -> main.TeacherId
+	0,  // 16: main.TeachersService.ReassignClass:input_type -> main.ReassignClassRequest
+	10, // 17: main.TeachersService.WatchTeachers:input_type -> main.WatchTeachersRequest
+	16, // 18: main.TeachersService.ImportTeachers:input_type -> main.ImportRequest
+	12, // 19: main.TeachersService.ExportTeachers:input_type -> main.ExportTeachersRequest
+	9,  // 20: main.TeachersService.GetTeachers:output_type -> main.Teachers
+	9,  // 21: main.TeachersService.AddTeachers:output_type -> main.Teachers
+	9,  // 22: main.TeachersService.UpdateTeachers:output_type -> main.Teachers
+	3,  // 23: main.TeachersService.DeleteTeachers:output_type -> main.DeleteTeacherConfirm
+	4,  // 24: main.TeachersService.RestoreTeachers:output_type -> main.RestoreTeacherConfirm
+	17, // 25: main.TeachersService.GetStudentsByClassTeacher:output_type -> main.Students
+	2,  // 26: main.TeachersService.GetStudentCountByClassTeacher:output_type -> main.StudentCount
+	1,  // 27: main.TeachersService.ReassignClass:output_type -> main.ReassignClassResponse
+	11, // 28: main.TeachersService.WatchTeachers:output_type -> main.TeacherEvent
+	18, // 29: main.TeachersService.ImportTeachers:output_type -> main.ImportReport
+	19, // 30: main.TeachersService.ExportTeachers:output_type -> main.ExportChunk
+	20, // [20:31] is the sub-list for method output_type
+	9,  // [9:20] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_main_proto_init() }
@@ -507,13 +1041,14 @@ func file_main_proto_init() {
 		return
 	}
 	file_student_proto_init()
+	file_course_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_main_proto_rawDesc), len(file_main_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
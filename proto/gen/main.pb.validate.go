@@ -35,6 +35,281 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on ReassignClassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReassignClassRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReassignClassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReassignClassRequestMultiError, or nil if none found.
+func (m *ReassignClassRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReassignClassRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFromClass()) < 1 {
+		err := ReassignClassRequestValidationError{
+			field:  "FromClass",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReassignClassRequest_FromClass_Pattern.MatchString(m.GetFromClass()) {
+		err := ReassignClassRequestValidationError{
+			field:  "FromClass",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToClass()) < 1 {
+		err := ReassignClassRequestValidationError{
+			field:  "ToClass",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReassignClassRequest_ToClass_Pattern.MatchString(m.GetToClass()) {
+		err := ReassignClassRequestValidationError{
+			field:  "ToClass",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 ]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTeacherId() != "" {
+
+		if !_ReassignClassRequest_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+			err := ReassignClassRequestValidationError{
+				field:  "TeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReassignClassRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReassignClassRequestMultiError is an error wrapping multiple validation
+// errors returned by ReassignClassRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReassignClassRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReassignClassRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReassignClassRequestMultiError) AllErrors() []error { return m }
+
+// ReassignClassRequestValidationError is the validation error returned by
+// ReassignClassRequest.Validate if the designated constraints aren't met.
+type ReassignClassRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReassignClassRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReassignClassRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReassignClassRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReassignClassRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReassignClassRequestValidationError) ErrorName() string {
+	return "ReassignClassRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReassignClassRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReassignClassRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReassignClassRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReassignClassRequestValidationError{}
+
+var _ReassignClassRequest_FromClass_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
+
+var _ReassignClassRequest_ToClass_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
+
+var _ReassignClassRequest_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on ReassignClassResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReassignClassResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReassignClassResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReassignClassResponseMultiError, or nil if none found.
+func (m *ReassignClassResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReassignClassResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for StudentsMoved
+
+	// no validation rules for TeacherId
+
+	if len(errors) > 0 {
+		return ReassignClassResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReassignClassResponseMultiError is an error wrapping multiple validation
+// errors returned by ReassignClassResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReassignClassResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReassignClassResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReassignClassResponseMultiError) AllErrors() []error { return m }
+
+// ReassignClassResponseValidationError is the validation error returned by
+// ReassignClassResponse.Validate if the designated constraints aren't met.
+type ReassignClassResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReassignClassResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReassignClassResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReassignClassResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReassignClassResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReassignClassResponseValidationError) ErrorName() string {
+	return "ReassignClassResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReassignClassResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReassignClassResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReassignClassResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReassignClassResponseValidationError{}
+
 // Validate checks the field values on StudentCount with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -242,64 +517,44 @@ var _ interface {
 	ErrorName() string
 } = DeleteTeacherConfirmValidationError{}
 
-// Validate checks the field values on TeacherId with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TeacherId) Validate() error {
+// Validate checks the field values on RestoreTeacherConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreTeacherConfirm) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TeacherId with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TeacherIdMultiError, or nil
-// if none found.
-func (m *TeacherId) ValidateAll() error {
+// ValidateAll checks the field values on RestoreTeacherConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreTeacherConfirmMultiError, or nil if none found.
+func (m *RestoreTeacherConfirm) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TeacherId) validate(all bool) error {
+func (m *RestoreTeacherConfirm) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) != 24 {
-		err := TeacherIdValidationError{
-			field:  "Id",
-			reason: "value length must be 24 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-
-	}
-
-	if !_TeacherId_Id_Pattern.MatchString(m.GetId()) {
-		err := TeacherIdValidationError{
-			field:  "Id",
-			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return TeacherIdMultiError(errors)
+		return RestoreTeacherConfirmMultiError(errors)
 	}
 
 	return nil
 }
 
-// TeacherIdMultiError is an error wrapping multiple validation errors returned
-// by TeacherId.ValidateAll() if the designated constraints aren't met.
-type TeacherIdMultiError []error
+// RestoreTeacherConfirmMultiError is an error wrapping multiple validation
+// errors returned by RestoreTeacherConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreTeacherConfirmMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TeacherIdMultiError) Error() string {
+func (m RestoreTeacherConfirmMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -308,11 +563,11 @@ func (m TeacherIdMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TeacherIdMultiError) AllErrors() []error { return m }
+func (m RestoreTeacherConfirmMultiError) AllErrors() []error { return m }
 
-// TeacherIdValidationError is the validation error returned by
-// TeacherId.Validate if the designated constraints aren't met.
-type TeacherIdValidationError struct {
+// RestoreTeacherConfirmValidationError is the validation error returned by
+// RestoreTeacherConfirm.Validate if the designated constraints aren't met.
+type RestoreTeacherConfirmValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -320,22 +575,24 @@ type TeacherIdValidationError struct {
 }
 
 // Field function returns field value.
-func (e TeacherIdValidationError) Field() string { return e.field }
+func (e RestoreTeacherConfirmValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TeacherIdValidationError) Reason() string { return e.reason }
+func (e RestoreTeacherConfirmValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TeacherIdValidationError) Cause() error { return e.cause }
+func (e RestoreTeacherConfirmValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TeacherIdValidationError) Key() bool { return e.key }
+func (e RestoreTeacherConfirmValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TeacherIdValidationError) ErrorName() string { return "TeacherIdValidationError" }
+func (e RestoreTeacherConfirmValidationError) ErrorName() string {
+	return "RestoreTeacherConfirmValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TeacherIdValidationError) Error() string {
+func (e RestoreTeacherConfirmValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -347,14 +604,14 @@ func (e TeacherIdValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTeacherId.%s: %s%s",
+		"invalid %sRestoreTeacherConfirm.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TeacherIdValidationError{}
+var _ error = RestoreTeacherConfirmValidationError{}
 
 var _ interface {
 	Field() string
@@ -362,9 +619,131 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TeacherIdValidationError{}
+} = RestoreTeacherConfirmValidationError{}
 
-var _TeacherId_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+// Validate checks the field values on TeacherId with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeacherId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeacherId with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeacherIdMultiError, or nil
+// if none found.
+func (m *TeacherId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeacherId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := TeacherIdValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_TeacherId_Id_Pattern.MatchString(m.GetId()) {
+		err := TeacherIdValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TeacherIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeacherIdMultiError is an error wrapping multiple validation errors returned
+// by TeacherId.ValidateAll() if the designated constraints aren't met.
+type TeacherIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeacherIdMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeacherIdMultiError) AllErrors() []error { return m }
+
+// TeacherIdValidationError is the validation error returned by
+// TeacherId.Validate if the designated constraints aren't met.
+type TeacherIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeacherIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeacherIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeacherIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeacherIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeacherIdValidationError) ErrorName() string { return "TeacherIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeacherIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeacherId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeacherIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeacherIdValidationError{}
+
+var _TeacherId_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
 
 // Validate checks the field values on TeacherIds with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -433,6 +812,23 @@ func (m *TeacherIds) validate(all bool) error {
 
 	}
 
+	if m.GetReassignTo() != "" {
+
+		if !_TeacherIds_ReassignTo_Pattern.MatchString(m.GetReassignTo()) {
+			err := TeacherIdsValidationError{
+				field:  "ReassignTo",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Force
+
 	if len(errors) > 0 {
 		return TeacherIdsMultiError(errors)
 	}
@@ -510,6 +906,8 @@ var _ interface {
 	ErrorName() string
 } = TeacherIdsValidationError{}
 
+var _TeacherIds_ReassignTo_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on GetTeacherRequset with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -599,6 +997,25 @@ func (m *GetTeacherRequset) validate(all bool) error {
 
 	// no validation rules for PageSize
 
+	// no validation rules for IncludeDeleted
+
+	if !_GetTeacherRequset_Term_Pattern.MatchString(m.GetTerm()) {
+		err := GetTeacherRequsetValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotalSize
+
+	// no validation rules for Filter
+
 	if len(errors) > 0 {
 		return GetTeacherRequsetMultiError(errors)
 	}
@@ -679,6 +1096,8 @@ var _ interface {
 	ErrorName() string
 } = GetTeacherRequsetValidationError{}
 
+var _GetTeacherRequset_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
 // Validate checks the field values on Teacher with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -758,6 +1177,59 @@ func (m *Teacher) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if m.GetClassId() != "" {
+
+		if !_Teacher_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := TeacherValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetCourses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TeacherValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TeacherValidationError{
+						field:  fmt.Sprintf("Courses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TeacherValidationError{
+					field:  fmt.Sprintf("Courses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return TeacherMultiError(errors)
 	}
@@ -893,6 +1365,8 @@ var _Teacher_Class_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
 
 var _Teacher_Subject_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
 
+var _Teacher_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on Teachers with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -949,6 +1423,10 @@ func (m *Teachers) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
 	if len(errors) > 0 {
 		return TeachersMultiError(errors)
 	}
@@ -1025,3 +1503,430 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TeachersValidationError{}
+
+// Validate checks the field values on WatchTeachersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchTeachersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchTeachersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchTeachersRequestMultiError, or nil if none found.
+func (m *WatchTeachersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchTeachersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeacher()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchTeachersRequestValidationError{
+					field:  "Teacher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchTeachersRequestValidationError{
+					field:  "Teacher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeacher()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchTeachersRequestValidationError{
+				field:  "Teacher",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchTeachersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchTeachersRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchTeachersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchTeachersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchTeachersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchTeachersRequestMultiError) AllErrors() []error { return m }
+
+// WatchTeachersRequestValidationError is the validation error returned by
+// WatchTeachersRequest.Validate if the designated constraints aren't met.
+type WatchTeachersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchTeachersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchTeachersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchTeachersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchTeachersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchTeachersRequestValidationError) ErrorName() string {
+	return "WatchTeachersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchTeachersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchTeachersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchTeachersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchTeachersRequestValidationError{}
+
+// Validate checks the field values on TeacherEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeacherEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeacherEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeacherEventMultiError, or
+// nil if none found.
+func (m *TeacherEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeacherEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetTeacher()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeacherEventValidationError{
+					field:  "Teacher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeacherEventValidationError{
+					field:  "Teacher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeacher()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeacherEventValidationError{
+				field:  "Teacher",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	// no validation rules for OccurredAt
+
+	if len(errors) > 0 {
+		return TeacherEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeacherEventMultiError is an error wrapping multiple validation errors
+// returned by TeacherEvent.ValidateAll() if the designated constraints aren't met.
+type TeacherEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeacherEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeacherEventMultiError) AllErrors() []error { return m }
+
+// TeacherEventValidationError is the validation error returned by
+// TeacherEvent.Validate if the designated constraints aren't met.
+type TeacherEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeacherEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeacherEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeacherEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeacherEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeacherEventValidationError) ErrorName() string { return "TeacherEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeacherEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeacherEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeacherEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeacherEventValidationError{}
+
+// Validate checks the field values on ExportTeachersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTeachersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportTeachersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTeachersRequestMultiError, or nil if none found.
+func (m *ExportTeachersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportTeachersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportTeachersRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportTeachersRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportTeachersRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportTeachersRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportTeachersRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportTeachersRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportTeachersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportTeachersRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportTeachersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportTeachersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportTeachersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportTeachersRequestMultiError) AllErrors() []error { return m }
+
+// ExportTeachersRequestValidationError is the validation error returned by
+// ExportTeachersRequest.Validate if the designated constraints aren't met.
+type ExportTeachersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportTeachersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportTeachersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportTeachersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportTeachersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportTeachersRequestValidationError) ErrorName() string {
+	return "ExportTeachersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportTeachersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportTeachersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportTeachersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportTeachersRequestValidationError{}
@@ -23,8 +23,13 @@ const (
 	TeachersService_AddTeachers_FullMethodName                   = "/main.TeachersService/AddTeachers"
 	TeachersService_UpdateTeachers_FullMethodName                = "/main.TeachersService/UpdateTeachers"
 	TeachersService_DeleteTeachers_FullMethodName                = "/main.TeachersService/DeleteTeachers"
+	TeachersService_RestoreTeachers_FullMethodName               = "/main.TeachersService/RestoreTeachers"
 	TeachersService_GetStudentsByClassTeacher_FullMethodName     = "/main.TeachersService/GetStudentsByClassTeacher"
 	TeachersService_GetStudentCountByClassTeacher_FullMethodName = "/main.TeachersService/GetStudentCountByClassTeacher"
+	TeachersService_ReassignClass_FullMethodName                 = "/main.TeachersService/ReassignClass"
+	TeachersService_WatchTeachers_FullMethodName                 = "/main.TeachersService/WatchTeachers"
+	TeachersService_ImportTeachers_FullMethodName                = "/main.TeachersService/ImportTeachers"
+	TeachersService_ExportTeachers_FullMethodName                = "/main.TeachersService/ExportTeachers"
 )
 
 // TeachersServiceClient is the client API for TeachersService service.
@@ -35,8 +40,16 @@ type TeachersServiceClient interface {
 	AddTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error)
 	UpdateTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error)
 	DeleteTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*DeleteTeacherConfirm, error)
+	RestoreTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*RestoreTeacherConfirm, error)
 	GetStudentsByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*Students, error)
 	GetStudentCountByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*StudentCount, error)
+	ReassignClass(ctx context.Context, in *ReassignClassRequest, opts ...grpc.CallOption) (*ReassignClassResponse, error)
+	// streams the changes of the teachers matching the filter until the client disconnects
+	WatchTeachers(ctx context.Context, in *WatchTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TeacherEvent], error)
+	// imports the rows of a csv or json file, teachers whose email is already taken are skipped
+	ImportTeachers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportReport], error)
+	// streams the teachers matching the query as a csv, jsonl or xlsx file, the paging of the query is ignored
+	ExportTeachers(ctx context.Context, in *ExportTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 }
 
 type teachersServiceClient struct {
@@ -87,6 +100,16 @@ func (c *teachersServiceClient) DeleteTeachers(ctx context.Context, in *TeacherI
 	return out, nil
 }
 
+func (c *teachersServiceClient) RestoreTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*RestoreTeacherConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTeacherConfirm)
+	err := c.cc.Invoke(ctx, TeachersService_RestoreTeachers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*Students, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Students)
@@ -107,6 +130,67 @@ func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Contex
 	return out, nil
 }
 
+func (c *teachersServiceClient) ReassignClass(ctx context.Context, in *ReassignClassRequest, opts ...grpc.CallOption) (*ReassignClassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignClassResponse)
+	err := c.cc.Invoke(ctx, TeachersService_ReassignClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teachersServiceClient) WatchTeachers(ctx context.Context, in *WatchTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TeacherEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeachersService_ServiceDesc.Streams[0], TeachersService_WatchTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTeachersRequest, TeacherEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_WatchTeachersClient = grpc.ServerStreamingClient[TeacherEvent]
+
+func (c *teachersServiceClient) ImportTeachers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeachersService_ServiceDesc.Streams[1], TeachersService_ImportTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ImportReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ImportTeachersClient = grpc.ClientStreamingClient[ImportRequest, ImportReport]
+
+func (c *teachersServiceClient) ExportTeachers(ctx context.Context, in *ExportTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeachersService_ServiceDesc.Streams[2], TeachersService_ExportTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTeachersRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ExportTeachersClient = grpc.ServerStreamingClient[ExportChunk]
+
 // TeachersServiceServer is the server API for TeachersService service.
 // All implementations must embed UnimplementedTeachersServiceServer
 // for forward compatibility.
@@ -115,8 +199,16 @@ type TeachersServiceServer interface {
 	AddTeachers(context.Context, *Teachers) (*Teachers, error)
 	UpdateTeachers(context.Context, *Teachers) (*Teachers, error)
 	DeleteTeachers(context.Context, *TeacherIds) (*DeleteTeacherConfirm, error)
+	RestoreTeachers(context.Context, *TeacherIds) (*RestoreTeacherConfirm, error)
 	GetStudentsByClassTeacher(context.Context, *TeacherId) (*Students, error)
 	GetStudentCountByClassTeacher(context.Context, *TeacherId) (*StudentCount, error)
+	ReassignClass(context.Context, *ReassignClassRequest) (*ReassignClassResponse, error)
+	// streams the changes of the teachers matching the filter until the client disconnects
+	WatchTeachers(*WatchTeachersRequest, grpc.ServerStreamingServer[TeacherEvent]) error
+	// imports the rows of a csv or json file, teachers whose email is already taken are skipped
+	ImportTeachers(grpc.ClientStreamingServer[ImportRequest, ImportReport]) error
+	// streams the teachers matching the query as a csv, jsonl or xlsx file, the paging of the query is ignored
+	ExportTeachers(*ExportTeachersRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	mustEmbedUnimplementedTeachersServiceServer()
 }
 
@@ -139,12 +231,27 @@ func (UnimplementedTeachersServiceServer) UpdateTeachers(context.Context, *Teach
 func (UnimplementedTeachersServiceServer) DeleteTeachers(context.Context, *TeacherIds) (*DeleteTeacherConfirm, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeachers not implemented")
 }
+func (UnimplementedTeachersServiceServer) RestoreTeachers(context.Context, *TeacherIds) (*RestoreTeacherConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTeachers not implemented")
+}
 func (UnimplementedTeachersServiceServer) GetStudentsByClassTeacher(context.Context, *TeacherId) (*Students, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByClassTeacher not implemented")
 }
 func (UnimplementedTeachersServiceServer) GetStudentCountByClassTeacher(context.Context, *TeacherId) (*StudentCount, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCountByClassTeacher not implemented")
 }
+func (UnimplementedTeachersServiceServer) ReassignClass(context.Context, *ReassignClassRequest) (*ReassignClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignClass not implemented")
+}
+func (UnimplementedTeachersServiceServer) WatchTeachers(*WatchTeachersRequest, grpc.ServerStreamingServer[TeacherEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTeachers not implemented")
+}
+func (UnimplementedTeachersServiceServer) ImportTeachers(grpc.ClientStreamingServer[ImportRequest, ImportReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTeachers not implemented")
+}
+func (UnimplementedTeachersServiceServer) ExportTeachers(*ExportTeachersRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTeachers not implemented")
+}
 func (UnimplementedTeachersServiceServer) mustEmbedUnimplementedTeachersServiceServer() {}
 func (UnimplementedTeachersServiceServer) testEmbeddedByValue()                         {}
 
@@ -238,6 +345,24 @@ func _TeachersService_DeleteTeachers_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeachersService_RestoreTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).RestoreTeachers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_RestoreTeachers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).RestoreTeachers(ctx, req.(*TeacherIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TeachersService_GetStudentsByClassTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TeacherId)
 	if err := dec(in); err != nil {
@@ -274,6 +399,53 @@ func _TeachersService_GetStudentCountByClassTeacher_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeachersService_ReassignClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).ReassignClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_ReassignClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).ReassignClass(ctx, req.(*ReassignClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_WatchTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTeachersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TeachersServiceServer).WatchTeachers(m, &grpc.GenericServerStream[WatchTeachersRequest, TeacherEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_WatchTeachersServer = grpc.ServerStreamingServer[TeacherEvent]
+
+func _TeachersService_ImportTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TeachersServiceServer).ImportTeachers(&grpc.GenericServerStream[ImportRequest, ImportReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ImportTeachersServer = grpc.ClientStreamingServer[ImportRequest, ImportReport]
+
+func _TeachersService_ExportTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTeachersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TeachersServiceServer).ExportTeachers(m, &grpc.GenericServerStream[ExportTeachersRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ExportTeachersServer = grpc.ServerStreamingServer[ExportChunk]
+
 // TeachersService_ServiceDesc is the grpc.ServiceDesc for TeachersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,6 +469,10 @@ var TeachersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTeachers",
 			Handler:    _TeachersService_DeleteTeachers_Handler,
 		},
+		{
+			MethodName: "RestoreTeachers",
+			Handler:    _TeachersService_RestoreTeachers_Handler,
+		},
 		{
 			MethodName: "GetStudentsByClassTeacher",
 			Handler:    _TeachersService_GetStudentsByClassTeacher_Handler,
@@ -305,7 +481,27 @@ var TeachersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentCountByClassTeacher",
 			Handler:    _TeachersService_GetStudentCountByClassTeacher_Handler,
 		},
+		{
+			MethodName: "ReassignClass",
+			Handler:    _TeachersService_ReassignClass_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTeachers",
+			Handler:       _TeachersService_WatchTeachers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTeachers",
+			Handler:       _TeachersService_ImportTeachers_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportTeachers",
+			Handler:       _TeachersService_ExportTeachers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
 }
@@ -0,0 +1,924 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Event
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	// no validation rules for SubjectId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for ReadAt
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on Notifications with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notifications) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notifications with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationsMultiError, or
+// nil if none found.
+func (m *Notifications) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notifications) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationsValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationsValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationsValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return NotificationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationsMultiError is an error wrapping multiple validation errors
+// returned by Notifications.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationsMultiError) AllErrors() []error { return m }
+
+// NotificationsValidationError is the validation error returned by
+// Notifications.Validate if the designated constraints aren't met.
+type NotificationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationsValidationError) ErrorName() string { return "NotificationsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotifications.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationsValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnreadOnly
+
+	if _, ok := _ListNotificationsRequest_Event_InLookup[m.GetEvent()]; !ok {
+		err := ListNotificationsRequestValidationError{
+			field:  "Event",
+			reason: "value must be in list [ attendance.absent grade.recorded account.deactivated announcement.published]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageNumber
+
+	if m.GetPageSize() > 100 {
+		err := ListNotificationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+var _ListNotificationsRequest_Event_InLookup = map[string]struct{}{
+	"":                       {},
+	"attendance.absent":      {},
+	"grade.recorded":         {},
+	"account.deactivated":    {},
+	"announcement.published": {},
+}
+
+// Validate checks the field values on MarkReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadRequestMultiError, or nil if none found.
+func (m *MarkReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if !_MarkReadRequest_Ids_Pattern.MatchString(item) {
+			err := MarkReadRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MarkReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadRequestValidationError is the validation error returned by
+// MarkReadRequest.Validate if the designated constraints aren't met.
+type MarkReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadRequestValidationError) ErrorName() string { return "MarkReadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadRequestValidationError{}
+
+var _MarkReadRequest_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on MarkReadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadResponseMultiError, or nil if none found.
+func (m *MarkReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Marked
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return MarkReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadResponseMultiError is an error wrapping multiple validation errors
+// returned by MarkReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkReadResponseValidationError is the validation error returned by
+// MarkReadResponse.Validate if the designated constraints aren't met.
+type MarkReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadResponseValidationError) ErrorName() string { return "MarkReadResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadResponseValidationError{}
+
+// Validate checks the field values on SubscribeNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubscribeNotificationsRequestMultiError, or nil if none found.
+func (m *SubscribeNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeUnread
+
+	if len(errors) > 0 {
+		return SubscribeNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by SubscribeNotificationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SubscribeNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeNotificationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// SubscribeNotificationsRequestValidationError is the validation error
+// returned by SubscribeNotificationsRequest.Validate if the designated
+// constraints aren't met.
+type SubscribeNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeNotificationsRequestValidationError) ErrorName() string {
+	return "SubscribeNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscribeNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeNotificationsRequestValidationError{}
+
+// Validate checks the field values on NotificationPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationPreferences with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationPreferencesMultiError, or nil if none found.
+func (m *NotificationPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChannels() {
+		_, _ = idx, item
+
+		if _, ok := _NotificationPreferences_Channels_InLookup[item]; !ok {
+			err := NotificationPreferencesValidationError{
+				field:  fmt.Sprintf("Channels[%v]", idx),
+				reason: "value must be in list [in_app email webhook]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetWebhookUrl() != "" {
+
+		if uri, err := url.Parse(m.GetWebhookUrl()); err != nil {
+			err = NotificationPreferencesValidationError{
+				field:  "WebhookUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := NotificationPreferencesValidationError{
+				field:  "WebhookUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetMutedEvents() {
+		_, _ = idx, item
+
+		if _, ok := _NotificationPreferences_MutedEvents_InLookup[item]; !ok {
+			err := NotificationPreferencesValidationError{
+				field:  fmt.Sprintf("MutedEvents[%v]", idx),
+				reason: "value must be in list [attendance.absent grade.recorded account.deactivated announcement.published]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for WebhookSecret
+
+	if len(errors) > 0 {
+		return NotificationPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationPreferencesMultiError is an error wrapping multiple validation
+// errors returned by NotificationPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationPreferencesMultiError) AllErrors() []error { return m }
+
+// NotificationPreferencesValidationError is the validation error returned by
+// NotificationPreferences.Validate if the designated constraints aren't met.
+type NotificationPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferencesValidationError) ErrorName() string {
+	return "NotificationPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferencesValidationError{}
+
+var _NotificationPreferences_Channels_InLookup = map[string]struct{}{
+	"in_app":  {},
+	"email":   {},
+	"webhook": {},
+}
+
+var _NotificationPreferences_MutedEvents_InLookup = map[string]struct{}{
+	"attendance.absent":      {},
+	"grade.recorded":         {},
+	"account.deactivated":    {},
+	"announcement.published": {},
+}
@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: reports.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// format is pdf or html, html is used when it is empty
+// term defaults to the current term, from_date and to_date limit the attendance summary and default to the term dates
+type ReportCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	FromDate      string                 `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCardRequest) Reset() {
+	*x = ReportCardRequest{}
+	mi := &file_reports_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCardRequest) ProtoMessage() {}
+
+func (x *ReportCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reports_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCardRequest.ProtoReflect.Descriptor instead.
+func (*ReportCardRequest) Descriptor() ([]byte, []int) {
+	return file_reports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportCardRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ReportCardRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *ReportCardRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ReportCardRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ReportCardRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+// the report cards of every active student of the class in one zip, term and dates default as for a single report card
+type ClassReportCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	FromDate      string                 `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassReportCardsRequest) Reset() {
+	*x = ClassReportCardsRequest{}
+	mi := &file_reports_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassReportCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassReportCardsRequest) ProtoMessage() {}
+
+func (x *ClassReportCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reports_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassReportCardsRequest.ProtoReflect.Descriptor instead.
+func (*ClassReportCardsRequest) Descriptor() ([]byte, []int) {
+	return file_reports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClassReportCardsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassReportCardsRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *ClassReportCardsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ClassReportCardsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ClassReportCardsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type TranscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptRequest) Reset() {
+	*x = TranscriptRequest{}
+	mi := &file_reports_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptRequest) ProtoMessage() {}
+
+func (x *TranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reports_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptRequest.ProtoReflect.Descriptor instead.
+func (*TranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_reports_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TranscriptRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *TranscriptRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ReportDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDocument) Reset() {
+	*x = ReportDocument{}
+	mi := &file_reports_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDocument) ProtoMessage() {}
+
+func (x *ReportDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_reports_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDocument.ProtoReflect.Descriptor instead.
+func (*ReportDocument) Descriptor() ([]byte, []int) {
+	return file_reports_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReportDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ReportDocument) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// a comment printed on the report card, course_id empty is the class teacher comment
+type ReportCardComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term          string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	CourseId      string                 `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCardComment) Reset() {
+	*x = ReportCardComment{}
+	mi := &file_reports_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCardComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCardComment) ProtoMessage() {}
+
+func (x *ReportCardComment) ProtoReflect() protoreflect.Message {
+	mi := &file_reports_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCardComment.ProtoReflect.Descriptor instead.
+func (*ReportCardComment) Descriptor() ([]byte, []int) {
+	return file_reports_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReportCardComment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReportCardComment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ReportCardComment) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *ReportCardComment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ReportCardComment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReportCardComment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ReportCardComment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+var File_reports_proto protoreflect.FileDescriptor
+
+const file_reports_proto_rawDesc = "" +
+	"\n" +
+	"\rreports.proto\x12\x04main\x1a\x17validate/validate.proto\"\xaf\x02\n" +
+	"\x11ReportCardRequest\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12+\n" +
+	"\x04term\x18\x02 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\x12*\n" +
+	"\x06format\x18\x03 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x03pdfR\x04htmlR\x06format\x12C\n" +
+	"\tfrom_date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\bfromDate\x12?\n" +
+	"\ato_date\x18\x05 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x06toDate\"\xb1\x02\n" +
+	"\x17ClassReportCardsRequest\x127\n" +
+	"\bclass_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\aclassId\x12+\n" +
+	"\x04term\x18\x02 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\x12*\n" +
+	"\x06format\x18\x03 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x03pdfR\x04htmlR\x06format\x12C\n" +
+	"\tfrom_date\x18\x04 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\bfromDate\x12?\n" +
+	"\ato_date\x18\x05 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\x06toDate\"|\n" +
+	"\x11TranscriptRequest\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12*\n" +
+	"\x06format\x18\x02 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x03pdfR\x04htmlR\x06format\"j\n" +
+	"\x0eReportDocument\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\xa9\x02\n" +
+	"\x11ReportCardComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12-\n" +
+	"\x04term\x18\x03 \x01(\tB\x19\xfaB\x16r\x14\x10\x012\x10^[A-Za-z0-9 -]*$R\x04term\x128\n" +
+	"\tcourse_id\x18\x04 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\bcourseId\x12\"\n" +
+	"\acomment\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\acomment\x12\x1b\n" +
+	"\tauthor_id\x18\x06 \x01(\tR\bauthorId\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt2\xb5\x02\n" +
+	"\x0eReportsService\x12C\n" +
+	"\x12GenerateReportCard\x12\x17.main.ReportCardRequest\x1a\x14.main.ReportDocument\x12O\n" +
+	"\x18GenerateClassReportCards\x12\x1d.main.ClassReportCardsRequest\x1a\x14.main.ReportDocument\x12C\n" +
+	"\x12GenerateTranscript\x12\x17.main.TranscriptRequest\x1a\x14.main.ReportDocument\x12H\n" +
+	"\x14SetReportCardComment\x12\x17.main.ReportCardComment\x1a\x17.main.ReportCardCommentB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_reports_proto_rawDescOnce sync.Once
+	file_reports_proto_rawDescData []byte
+)
+
+func file_reports_proto_rawDescGZIP() []byte {
+	file_reports_proto_rawDescOnce.Do(func() {
+		file_reports_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reports_proto_rawDesc), len(file_reports_proto_rawDesc)))
+	})
+	return file_reports_proto_rawDescData
+}
+
+var file_reports_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_reports_proto_goTypes = []any{
+	(*ReportCardRequest)(nil),       // 0: main.ReportCardRequest
+	(*ClassReportCardsRequest)(nil), // 1: main.ClassReportCardsRequest
+	(*TranscriptRequest)(nil),       // 2: main.TranscriptRequest
+	(*ReportDocument)(nil),          // 3: main.ReportDocument
+	(*ReportCardComment)(nil),       // 4: main.ReportCardComment
+}
+var file_reports_proto_depIdxs = []int32{
+	0, // 0: main.ReportsService.GenerateReportCard:input_type -> main.ReportCardRequest
+	1, // 1: main.ReportsService.GenerateClassReportCards:input_type -> main.ClassReportCardsRequest
+	2, // 2: main.ReportsService.GenerateTranscript:input_type -> main.TranscriptRequest
+	4, // 3: main.ReportsService.SetReportCardComment:input_type -> main.ReportCardComment
+	3, // 4: main.ReportsService.GenerateReportCard:output_type -> main.ReportDocument
+	3, // 5: main.ReportsService.GenerateClassReportCards:output_type -> main.ReportDocument
+	3, // 6: main.ReportsService.GenerateTranscript:output_type -> main.ReportDocument
+	4, // 7: main.ReportsService.SetReportCardComment:output_type -> main.ReportCardComment
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_reports_proto_init() }
+func file_reports_proto_init() {
+	if File_reports_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reports_proto_rawDesc), len(file_reports_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reports_proto_goTypes,
+		DependencyIndexes: file_reports_proto_depIdxs,
+		MessageInfos:      file_reports_proto_msgTypes,
+	}.Build()
+	File_reports_proto = out.File
+	file_reports_proto_goTypes = nil
+	file_reports_proto_depIdxs = nil
+}
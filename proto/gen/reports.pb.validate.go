@@ -0,0 +1,853 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: reports.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReportCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportCardRequestMultiError, or nil if none found.
+func (m *ReportCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := ReportCardRequestValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ReportCardRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := ReportCardRequestValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReportCardRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := ReportCardRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReportCardRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ReportCardRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ pdf html]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromDate() != "" {
+
+		if !_ReportCardRequest_FromDate_Pattern.MatchString(m.GetFromDate()) {
+			err := ReportCardRequestValidationError{
+				field:  "FromDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetToDate() != "" {
+
+		if !_ReportCardRequest_ToDate_Pattern.MatchString(m.GetToDate()) {
+			err := ReportCardRequestValidationError{
+				field:  "ToDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReportCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportCardRequestMultiError is an error wrapping multiple validation errors
+// returned by ReportCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReportCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportCardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportCardRequestMultiError) AllErrors() []error { return m }
+
+// ReportCardRequestValidationError is the validation error returned by
+// ReportCardRequest.Validate if the designated constraints aren't met.
+type ReportCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportCardRequestValidationError) ErrorName() string {
+	return "ReportCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportCardRequestValidationError{}
+
+var _ReportCardRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ReportCardRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _ReportCardRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"pdf":  {},
+	"html": {},
+}
+
+var _ReportCardRequest_FromDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _ReportCardRequest_ToDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on ClassReportCardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClassReportCardsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassReportCardsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassReportCardsRequestMultiError, or nil if none found.
+func (m *ClassReportCardsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassReportCardsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClassId()) != 24 {
+		err := ClassReportCardsRequestValidationError{
+			field:  "ClassId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ClassReportCardsRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+		err := ClassReportCardsRequestValidationError{
+			field:  "ClassId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClassReportCardsRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := ClassReportCardsRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ClassReportCardsRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ClassReportCardsRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ pdf html]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromDate() != "" {
+
+		if !_ClassReportCardsRequest_FromDate_Pattern.MatchString(m.GetFromDate()) {
+			err := ClassReportCardsRequestValidationError{
+				field:  "FromDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetToDate() != "" {
+
+		if !_ClassReportCardsRequest_ToDate_Pattern.MatchString(m.GetToDate()) {
+			err := ClassReportCardsRequestValidationError{
+				field:  "ToDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassReportCardsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassReportCardsRequestMultiError is an error wrapping multiple validation
+// errors returned by ClassReportCardsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClassReportCardsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassReportCardsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassReportCardsRequestMultiError) AllErrors() []error { return m }
+
+// ClassReportCardsRequestValidationError is the validation error returned by
+// ClassReportCardsRequest.Validate if the designated constraints aren't met.
+type ClassReportCardsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassReportCardsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassReportCardsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassReportCardsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassReportCardsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassReportCardsRequestValidationError) ErrorName() string {
+	return "ClassReportCardsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClassReportCardsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassReportCardsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassReportCardsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassReportCardsRequestValidationError{}
+
+var _ClassReportCardsRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ClassReportCardsRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _ClassReportCardsRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"pdf":  {},
+	"html": {},
+}
+
+var _ClassReportCardsRequest_FromDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _ClassReportCardsRequest_ToDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on TranscriptRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TranscriptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TranscriptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TranscriptRequestMultiError, or nil if none found.
+func (m *TranscriptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TranscriptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := TranscriptRequestValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_TranscriptRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := TranscriptRequestValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TranscriptRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := TranscriptRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ pdf html]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TranscriptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TranscriptRequestMultiError is an error wrapping multiple validation errors
+// returned by TranscriptRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TranscriptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TranscriptRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TranscriptRequestMultiError) AllErrors() []error { return m }
+
+// TranscriptRequestValidationError is the validation error returned by
+// TranscriptRequest.Validate if the designated constraints aren't met.
+type TranscriptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TranscriptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TranscriptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TranscriptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TranscriptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TranscriptRequestValidationError) ErrorName() string {
+	return "TranscriptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TranscriptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTranscriptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TranscriptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TranscriptRequestValidationError{}
+
+var _TranscriptRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _TranscriptRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"pdf":  {},
+	"html": {},
+}
+
+// Validate checks the field values on ReportDocument with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportDocument) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportDocument with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportDocumentMultiError,
+// or nil if none found.
+func (m *ReportDocument) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportDocument) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ReportDocumentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportDocumentMultiError is an error wrapping multiple validation errors
+// returned by ReportDocument.ValidateAll() if the designated constraints
+// aren't met.
+type ReportDocumentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportDocumentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportDocumentMultiError) AllErrors() []error { return m }
+
+// ReportDocumentValidationError is the validation error returned by
+// ReportDocument.Validate if the designated constraints aren't met.
+type ReportDocumentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportDocumentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportDocumentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportDocumentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportDocumentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportDocumentValidationError) ErrorName() string { return "ReportDocumentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportDocumentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportDocument.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportDocumentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportDocumentValidationError{}
+
+// Validate checks the field values on ReportCardComment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportCardComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportCardComment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportCardCommentMultiError, or nil if none found.
+func (m *ReportCardComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportCardComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := ReportCardCommentValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ReportCardComment_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := ReportCardCommentValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTerm()) < 1 {
+		err := ReportCardCommentValidationError{
+			field:  "Term",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReportCardComment_Term_Pattern.MatchString(m.GetTerm()) {
+		err := ReportCardCommentValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCourseId() != "" {
+
+		if !_ReportCardComment_CourseId_Pattern.MatchString(m.GetCourseId()) {
+			err := ReportCardCommentValidationError{
+				field:  "CourseId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 2000 {
+		err := ReportCardCommentValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AuthorId
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return ReportCardCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportCardCommentMultiError is an error wrapping multiple validation errors
+// returned by ReportCardComment.ValidateAll() if the designated constraints
+// aren't met.
+type ReportCardCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportCardCommentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportCardCommentMultiError) AllErrors() []error { return m }
+
+// ReportCardCommentValidationError is the validation error returned by
+// ReportCardComment.Validate if the designated constraints aren't met.
+type ReportCardCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportCardCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportCardCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportCardCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportCardCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportCardCommentValidationError) ErrorName() string {
+	return "ReportCardCommentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportCardCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportCardComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportCardCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportCardCommentValidationError{}
+
+var _ReportCardComment_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ReportCardComment_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _ReportCardComment_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: reports.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReportsService_GenerateReportCard_FullMethodName       = "/main.ReportsService/GenerateReportCard"
+	ReportsService_GenerateClassReportCards_FullMethodName = "/main.ReportsService/GenerateClassReportCards"
+	ReportsService_GenerateTranscript_FullMethodName       = "/main.ReportsService/GenerateTranscript"
+	ReportsService_SetReportCardComment_FullMethodName     = "/main.ReportsService/SetReportCardComment"
+)
+
+// ReportsServiceClient is the client API for ReportsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReportsServiceClient interface {
+	GenerateReportCard(ctx context.Context, in *ReportCardRequest, opts ...grpc.CallOption) (*ReportDocument, error)
+	GenerateClassReportCards(ctx context.Context, in *ClassReportCardsRequest, opts ...grpc.CallOption) (*ReportDocument, error)
+	GenerateTranscript(ctx context.Context, in *TranscriptRequest, opts ...grpc.CallOption) (*ReportDocument, error)
+	SetReportCardComment(ctx context.Context, in *ReportCardComment, opts ...grpc.CallOption) (*ReportCardComment, error)
+}
+
+type reportsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportsServiceClient(cc grpc.ClientConnInterface) ReportsServiceClient {
+	return &reportsServiceClient{cc}
+}
+
+func (c *reportsServiceClient) GenerateReportCard(ctx context.Context, in *ReportCardRequest, opts ...grpc.CallOption) (*ReportDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDocument)
+	err := c.cc.Invoke(ctx, ReportsService_GenerateReportCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportsServiceClient) GenerateClassReportCards(ctx context.Context, in *ClassReportCardsRequest, opts ...grpc.CallOption) (*ReportDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDocument)
+	err := c.cc.Invoke(ctx, ReportsService_GenerateClassReportCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportsServiceClient) GenerateTranscript(ctx context.Context, in *TranscriptRequest, opts ...grpc.CallOption) (*ReportDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDocument)
+	err := c.cc.Invoke(ctx, ReportsService_GenerateTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportsServiceClient) SetReportCardComment(ctx context.Context, in *ReportCardComment, opts ...grpc.CallOption) (*ReportCardComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportCardComment)
+	err := c.cc.Invoke(ctx, ReportsService_SetReportCardComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportsServiceServer is the server API for ReportsService service.
+// All implementations must embed UnimplementedReportsServiceServer
+// for forward compatibility.
+type ReportsServiceServer interface {
+	GenerateReportCard(context.Context, *ReportCardRequest) (*ReportDocument, error)
+	GenerateClassReportCards(context.Context, *ClassReportCardsRequest) (*ReportDocument, error)
+	GenerateTranscript(context.Context, *TranscriptRequest) (*ReportDocument, error)
+	SetReportCardComment(context.Context, *ReportCardComment) (*ReportCardComment, error)
+	mustEmbedUnimplementedReportsServiceServer()
+}
+
+// UnimplementedReportsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportsServiceServer struct{}
+
+func (UnimplementedReportsServiceServer) GenerateReportCard(context.Context, *ReportCardRequest) (*ReportDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReportCard not implemented")
+}
+func (UnimplementedReportsServiceServer) GenerateClassReportCards(context.Context, *ClassReportCardsRequest) (*ReportDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateClassReportCards not implemented")
+}
+func (UnimplementedReportsServiceServer) GenerateTranscript(context.Context, *TranscriptRequest) (*ReportDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTranscript not implemented")
+}
+func (UnimplementedReportsServiceServer) SetReportCardComment(context.Context, *ReportCardComment) (*ReportCardComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReportCardComment not implemented")
+}
+func (UnimplementedReportsServiceServer) mustEmbedUnimplementedReportsServiceServer() {}
+func (UnimplementedReportsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeReportsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportsServiceServer will
+// result in compilation errors.
+type UnsafeReportsServiceServer interface {
+	mustEmbedUnimplementedReportsServiceServer()
+}
+
+func RegisterReportsServiceServer(s grpc.ServiceRegistrar, srv ReportsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReportsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportsService_ServiceDesc, srv)
+}
+
+func _ReportsService_GenerateReportCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServiceServer).GenerateReportCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportsService_GenerateReportCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServiceServer).GenerateReportCard(ctx, req.(*ReportCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportsService_GenerateClassReportCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassReportCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServiceServer).GenerateClassReportCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportsService_GenerateClassReportCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServiceServer).GenerateClassReportCards(ctx, req.(*ClassReportCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportsService_GenerateTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServiceServer).GenerateTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportsService_GenerateTranscript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServiceServer).GenerateTranscript(ctx, req.(*TranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportsService_SetReportCardComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCardComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServiceServer).SetReportCardComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportsService_SetReportCardComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServiceServer).SetReportCardComment(ctx, req.(*ReportCardComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportsService_ServiceDesc is the grpc.ServiceDesc for ReportsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.ReportsService",
+	HandlerType: (*ReportsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateReportCard",
+			Handler:    _ReportsService_GenerateReportCard_Handler,
+		},
+		{
+			MethodName: "GenerateClassReportCards",
+			Handler:    _ReportsService_GenerateClassReportCards_Handler,
+		},
+		{
+			MethodName: "GenerateTranscript",
+			Handler:    _ReportsService_GenerateTranscript_Handler,
+		},
+		{
+			MethodName: "SetReportCardComment",
+			Handler:    _ReportsService_SetReportCardComment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reports.proto",
+}
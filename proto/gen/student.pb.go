@@ -120,6 +120,58 @@ func (x *DeleteStudentsConfirm) GetDeletedIds() []string {
 	return nil
 }
 
+type RestoreStudentsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStudentsConfirm) Reset() {
+	*x = RestoreStudentsConfirm{}
+	mi := &file_student_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStudentsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStudentsConfirm) ProtoMessage() {}
+
+func (x *RestoreStudentsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStudentsConfirm.ProtoReflect.Descriptor instead.
+func (*RestoreStudentsConfirm) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RestoreStudentsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreStudentsConfirm) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
 type StudentIds struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentIds    []string               `protobuf:"bytes,1,rep,name=studentIds,proto3" json:"studentIds,omitempty"`
@@ -129,7 +181,7 @@ type StudentIds struct {
 
 func (x *StudentIds) Reset() {
 	*x = StudentIds{}
-	mi := &file_student_proto_msgTypes[1]
+	mi := &file_student_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +193,7 @@ func (x *StudentIds) String() string {
 func (*StudentIds) ProtoMessage() {}
 
 func (x *StudentIds) ProtoReflect() protoreflect.Message {
-	mi := &file_student_proto_msgTypes[1]
+	mi := &file_student_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +206,7 @@ func (x *StudentIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudentIds.ProtoReflect.Descriptor instead.
 func (*StudentIds) Descriptor() ([]byte, []int) {
-	return file_student_proto_rawDescGZIP(), []int{1}
+	return file_student_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *StudentIds) GetStudentIds() []string {
@@ -165,18 +217,31 @@ func (x *StudentIds) GetStudentIds() []string {
 }
 
 type GetStudentRequset struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
-	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	PageNum       uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Student        *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// students as they were in an earlier term, the current term when empty
+	Term string `protobuf:"bytes,6,opt,name=term,proto3" json:"term,omitempty"`
+	// embed the guardians of every student, ordered by emergency priority
+	IncludeGuardians bool `protobuf:"varint,7,opt,name=include_guardians,json=includeGuardians,proto3" json:"include_guardians,omitempty"`
+	// the next_page_token of the previous page, the first page when empty. a token only works with the sort it was
+	// made for. page_num is only used without a token and is kept for older clients
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// count every record matching the filter into total_size, an extra query
+	IncludeTotalSize bool `protobuf:"varint,9,opt,name=include_total_size,json=includeTotalSize,proto3" json:"include_total_size,omitempty"`
+	// filter expression like: last_name = "Sm*" AND class IN ("9A", "9B"), matched together with the fields set above.
+	// = (a trailing * matches a prefix), != < <= > >= : (contains, ignoring case), IN, AND, OR, NOT and parentheses
+	Filter        string `protobuf:"bytes,10,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentRequset) Reset() {
 	*x = GetStudentRequset{}
-	mi := &file_student_proto_msgTypes[2]
+	mi := &file_student_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -188,7 +253,7 @@ func (x *GetStudentRequset) String() string {
 func (*GetStudentRequset) ProtoMessage() {}
 
 func (x *GetStudentRequset) ProtoReflect() protoreflect.Message {
-	mi := &file_student_proto_msgTypes[2]
+	mi := &file_student_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,7 +266,7 @@ func (x *GetStudentRequset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentRequset.ProtoReflect.Descriptor instead.
 func (*GetStudentRequset) Descriptor() ([]byte, []int) {
-	return file_student_proto_rawDescGZIP(), []int{2}
+	return file_student_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetStudentRequset) GetStudent() *Student {
@@ -232,17 +297,62 @@ func (x *GetStudentRequset) GetPageSize() uint32 {
 	return 0
 }
 
+func (x *GetStudentRequset) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetStudentRequset) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *GetStudentRequset) GetIncludeGuardians() bool {
+	if x != nil {
+		return x.IncludeGuardians
+	}
+	return false
+}
+
+func (x *GetStudentRequset) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetStudentRequset) GetIncludeTotalSize() bool {
+	if x != nil {
+		return x.IncludeTotalSize
+	}
+	return false
+}
+
+func (x *GetStudentRequset) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type SortField struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Order         Order                  `protobuf:"varint,2,opt,name=order,proto3,enum=main.Order" json:"order,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// json name of the field like lastName, the proto name like last_name works too. Each list has the fields it can be
+	// sorted by and is sorted by one of them at a time. id sorts by the id, which is also the last sort key of every
+	// list, in the direction of the key before it
+	Field         string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Order         Order  `protobuf:"varint,2,opt,name=order,proto3,enum=main.Order" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SortField) Reset() {
 	*x = SortField{}
-	mi := &file_student_proto_msgTypes[3]
+	mi := &file_student_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +364,7 @@ func (x *SortField) String() string {
 func (*SortField) ProtoMessage() {}
 
 func (x *SortField) ProtoReflect() protoreflect.Message {
-	mi := &file_student_proto_msgTypes[3]
+	mi := &file_student_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +377,7 @@ func (x *SortField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortField.ProtoReflect.Descriptor instead.
 func (*SortField) Descriptor() ([]byte, []int) {
-	return file_student_proto_rawDescGZIP(), []int{3}
+	return file_student_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SortField) GetField() string {
@@ -285,19 +395,35 @@ func (x *SortField) GetOrder() Order {
 }
 
 type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Class         string                 `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName   string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName    string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email       string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Class       string                 `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+	DeletedAt   string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy   string                 `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	ClassId     string                 `protobuf:"bytes,8,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	GraduatedAt string                 `protobuf:"bytes,9,opt,name=graduated_at,json=graduatedAt,proto3" json:"graduated_at,omitempty"` // set by the year-end rollover
+	Guardians   []*StudentGuardian     `protobuf:"bytes,10,rep,name=guardians,proto3" json:"guardians,omitempty"`                       // only filled by GetStudents with include_guardians
+	DateOfBirth string                 `protobuf:"bytes,11,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Gender      string                 `protobuf:"bytes,12,opt,name=gender,proto3" json:"gender,omitempty"`
+	Address     string                 `protobuf:"bytes,13,opt,name=address,proto3" json:"address,omitempty"`
+	// generated from the configured pattern when empty, unique
+	AdmissionNumber string `protobuf:"bytes,14,opt,name=admission_number,json=admissionNumber,proto3" json:"admission_number,omitempty"`
+	// active when empty on create, graduated is only set by the year-end rollover.
+	// GetStudents only lists active students unless a status is asked for
+	EnrollmentStatus string `protobuf:"bytes,15,opt,name=enrollment_status,json=enrollmentStatus,proto3" json:"enrollment_status,omitempty"`
+	AdmissionDate    string `protobuf:"bytes,16,opt,name=admission_date,json=admissionDate,proto3" json:"admission_date,omitempty"`
+	// only visible to admins and managers
+	MedicalNotes  string `protobuf:"bytes,17,opt,name=medical_notes,json=medicalNotes,proto3" json:"medical_notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Student) Reset() {
 	*x = Student{}
-	mi := &file_student_proto_msgTypes[4]
+	mi := &file_student_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +435,7 @@ func (x *Student) String() string {
 func (*Student) ProtoMessage() {}
 
 func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_student_proto_msgTypes[4]
+	mi := &file_student_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +448,7 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Student.ProtoReflect.Descriptor instead.
 func (*Student) Descriptor() ([]byte, []int) {
-	return file_student_proto_rawDescGZIP(), []int{4}
+	return file_student_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Student) GetId() string {
@@ -360,16 +486,205 @@ func (x *Student) GetClass() string {
 	return ""
 }
 
-type Students struct {
+func (x *Student) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Student) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Student) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Student) GetGraduatedAt() string {
+	if x != nil {
+		return x.GraduatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetGuardians() []*StudentGuardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *Student) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Student) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Student) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Student) GetAdmissionNumber() string {
+	if x != nil {
+		return x.AdmissionNumber
+	}
+	return ""
+}
+
+func (x *Student) GetEnrollmentStatus() string {
+	if x != nil {
+		return x.EnrollmentStatus
+	}
+	return ""
+}
+
+func (x *Student) GetAdmissionDate() string {
+	if x != nil {
+		return x.AdmissionDate
+	}
+	return ""
+}
+
+func (x *Student) GetMedicalNotes() string {
+	if x != nil {
+		return x.MedicalNotes
+	}
+	return ""
+}
+
+// a guardian as seen from one of their students
+type StudentGuardian struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Relationship  string                 `protobuf:"bytes,4,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Priority      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGuardian) Reset() {
+	*x = StudentGuardian{}
+	mi := &file_student_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGuardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGuardian) ProtoMessage() {}
+
+func (x *StudentGuardian) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGuardian.ProtoReflect.Descriptor instead.
+func (*StudentGuardian) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StudentGuardian) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StudentGuardian) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type Students struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Students []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// only set when include_total_size was asked for
+	TotalSize     int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Students) Reset() {
 	*x = Students{}
-	mi := &file_student_proto_msgTypes[5]
+	mi := &file_student_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -381,7 +696,7 @@ func (x *Students) String() string {
 func (*Students) ProtoMessage() {}
 
 func (x *Students) ProtoReflect() protoreflect.Message {
-	mi := &file_student_proto_msgTypes[5]
+	mi := &file_student_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +709,7 @@ func (x *Students) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Students.ProtoReflect.Descriptor instead.
 func (*Students) Descriptor() ([]byte, []int) {
-	return file_student_proto_rawDescGZIP(), []int{5}
+	return file_student_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Students) GetStudents() []*Student {
@@ -404,45 +719,671 @@ func (x *Students) GetStudents() []*Student {
 	return nil
 }
 
-var File_student_proto protoreflect.FileDescriptor
+func (x *Students) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_student_proto_rawDesc = "" +
-	"\n" +
-	"\rstudent.proto\x12\x04main\x1a\x17validate/validate.proto\"P\n" +
-	"\x15DeleteStudentsConfirm\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
-	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\",\n" +
-	"\n" +
-	"StudentIds\x12\x1e\n" +
-	"\n" +
-	"studentIds\x18\x01 \x03(\tR\n" +
-	"studentIds\"\x9e\x01\n" +
-	"\x11GetStudentRequset\x12'\n" +
-	"\astudent\x18\x01 \x01(\v2\r.main.StudentR\astudent\x12(\n" +
-	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
-	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"D\n" +
-	"\tSortField\x12\x14\n" +
-	"\x05field\x18\x01 \x01(\tR\x05field\x12!\n" +
-	"\x05order\x18\x02 \x01(\x0e2\v.main.OrderR\x05order\"\xcc\x01\n" +
-	"\aStudent\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
-	"\n" +
+func (x *Students) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// resume_token is the resume_token of the last event the client got, the watch continues after it
+type WatchStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStudentsRequest) Reset() {
+	*x = WatchStudentsRequest{}
+	mi := &file_student_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStudentsRequest) ProtoMessage() {}
+
+func (x *WatchStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStudentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchStudentsRequest) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *WatchStudentsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// operation is created, updated, deleted or restored, deleted and restored are the soft delete and its undo
+type StudentEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Student       *Student               `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentEvent) Reset() {
+	*x = StudentEvent{}
+	mi := &file_student_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentEvent) ProtoMessage() {}
+
+func (x *StudentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentEvent.ProtoReflect.Descriptor instead.
+func (*StudentEvent) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StudentEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *StudentEvent) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *StudentEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StudentEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// one row of an import file, the options are read from the first message
+type ImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// validates every row without writing anything
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// column of the file -> field like "e-mail" -> "email", "-" drops the column. other columns have to be field names
+	ColumnMapping map[string]string `protobuf:"bytes,2,rep,name=column_mapping,json=columnMapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Row           map[string]string `protobuf:"bytes,3,rep,name=row,proto3" json:"row,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// line of the row in the file for the report, rows are numbered in the order they arrive when 0
+	RowNumber     uint32 `protobuf:"varint,4,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_student_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportRequest) GetColumnMapping() map[string]string {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetRow() map[string]string {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetRowNumber() uint32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+// status is imported, valid (dry run), invalid, duplicate or failed when the batch of the row could not be written
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowNumber     uint32                 `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_student_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportRowResult) GetRowNumber() uint32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Imported      uint32                 `protobuf:"varint,3,opt,name=imported,proto3" json:"imported,omitempty"`
+	Valid         uint32                 `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Rejected      uint32                 `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	mi := &file_student_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportReport) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportReport) GetImported() uint32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetValid() uint32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportReport) GetRejected() uint32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ImportReport) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ExportStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *GetStudentRequset     `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Options       *ExportOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStudentsRequest) Reset() {
+	*x = ExportStudentsRequest{}
+	mi := &file_student_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStudentsRequest) ProtoMessage() {}
+
+func (x *ExportStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportStudentsRequest) GetQuery() *GetStudentRequset {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExportStudentsRequest) GetOptions() *ExportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// format and columns of an export
+type ExportOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv when empty
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// field names in the order of the columns, the fields of the entity when empty
+	Columns       []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOptions) Reset() {
+	*x = ExportOptions{}
+	mi := &file_student_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOptions) ProtoMessage() {}
+
+func (x *ExportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOptions.ProtoReflect.Descriptor instead.
+func (*ExportOptions) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportOptions) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// a piece of an exported file, the first one carries the file name and content type
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_student_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_student_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_student_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_student_proto protoreflect.FileDescriptor
+
+const file_student_proto_rawDesc = "" +
+	"\n" +
+	"\rstudent.proto\x12\x04main\x1a\x17validate/validate.proto\"P\n" +
+	"\x15DeleteStudentsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"S\n" +
+	"\x16RestoreStudentsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\",\n" +
+	"\n" +
+	"StudentIds\x12\x1e\n" +
+	"\n" +
+	"studentIds\x18\x01 \x03(\tR\n" +
+	"studentIds\"\x86\x03\n" +
+	"\x11GetStudentRequset\x12'\n" +
+	"\astudent\x18\x01 \x01(\v2\r.main.StudentR\astudent\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\x12+\n" +
+	"\x04term\x18\x06 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\x12+\n" +
+	"\x11include_guardians\x18\a \x01(\bR\x10includeGuardians\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12,\n" +
+	"\x12include_total_size\x18\t \x01(\bR\x10includeTotalSize\x12\x16\n" +
+	"\x06filter\x18\n" +
+	" \x01(\tR\x06filter\"D\n" +
+	"\tSortField\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12!\n" +
+	"\x05order\x18\x02 \x01(\x0e2\v.main.OrderR\x05order\"\xd9\x06\n" +
+	"\aStudent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\n" +
 	"first_name\x18\x02 \x01(\tB\x13\xfaB\x10r\x0e2\f^[A-Za-z ]*$R\tfirstName\x120\n" +
 	"\tlast_name\x18\x03 \x01(\tB\x13\xfaB\x10r\x0e2\f^[A-Za-z ]*$R\blastName\x12\x1d\n" +
 	"\x05email\x18\x04 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12,\n" +
-	"\x05class\x18\x05 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x05class\"5\n" +
+	"\x05class\x18\x05 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x05class\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\a \x01(\tR\tdeletedBy\x126\n" +
+	"\bclass_id\x18\b \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12!\n" +
+	"\fgraduated_at\x18\t \x01(\tR\vgraduatedAt\x123\n" +
+	"\tguardians\x18\n" +
+	" \x03(\v2\x15.main.StudentGuardianR\tguardians\x12J\n" +
+	"\rdate_of_birth\x18\v \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\vdateOfBirth\x124\n" +
+	"\x06gender\x18\f \x01(\tB\x1c\xfaB\x19r\x17R\x00R\x06femaleR\x04maleR\x05otherR\x06gender\x12\x18\n" +
+	"\aaddress\x18\r \x01(\tR\aaddress\x12B\n" +
+	"\x10admission_number\x18\x0e \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9/-]*$R\x0fadmissionNumber\x12_\n" +
+	"\x11enrollment_status\x18\x0f \x01(\tB2\xfaB/r-R\x00R\x06activeR\vtransferredR\tgraduatedR\twithdrawnR\x10enrollmentStatus\x12M\n" +
+	"\x0eadmission_date\x18\x10 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\radmissionDate\x12-\n" +
+	"\rmedical_notes\x18\x11 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\fmedicalNotes\"\xf4\x01\n" +
+	"\x0fStudentGuardian\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\"\n" +
+	"\frelationship\x18\x04 \x01(\tR\frelationship\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\"|\n" +
 	"\bStudents\x12)\n" +
-	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents*\x1a\n" +
+	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"b\n" +
+	"\x14WatchStudentsRequest\x12'\n" +
+	"\astudent\x18\x01 \x01(\v2\r.main.StudentR\astudent\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\x99\x01\n" +
+	"\fStudentEvent\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12'\n" +
+	"\astudent\x18\x02 \x01(\v2\r.main.StudentR\astudent\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"\xc0\x02\n" +
+	"\rImportRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12M\n" +
+	"\x0ecolumn_mapping\x18\x02 \x03(\v2&.main.ImportRequest.ColumnMappingEntryR\rcolumnMapping\x12.\n" +
+	"\x03row\x18\x03 \x03(\v2\x1c.main.ImportRequest.RowEntryR\x03row\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x04 \x01(\rR\trowNumber\x1a@\n" +
+	"\x12ColumnMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a6\n" +
+	"\bRowEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x01\n" +
+	"\x0fImportRowResult\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x01 \x01(\rR\trowNumber\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xb6\x01\n" +
+	"\fImportReport\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1a\n" +
+	"\bimported\x18\x03 \x01(\rR\bimported\x12\x14\n" +
+	"\x05valid\x18\x04 \x01(\rR\x05valid\x12\x1a\n" +
+	"\brejected\x18\x05 \x01(\rR\brejected\x12)\n" +
+	"\x04rows\x18\x06 \x03(\v2\x15.main.ImportRowResultR\x04rows\"u\n" +
+	"\x15ExportStudentsRequest\x12-\n" +
+	"\x05query\x18\x01 \x01(\v2\x17.main.GetStudentRequsetR\x05query\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.main.ExportOptionsR\aoptions\"\\\n" +
+	"\rExportOptions\x121\n" +
+	"\x06format\x18\x01 \x01(\tB\x19\xfaB\x16r\x14R\x00R\x03csvR\x05jsonlR\x04xlsxR\x06format\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\"a\n" +
+	"\vExportChunk\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data*\x1a\n" +
 	"\x05Order\x12\a\n" +
 	"\x03ASC\x10\x00\x12\b\n" +
-	"\x04DESC\x10\x012\xeb\x01\n" +
+	"\x04DESC\x10\x012\xf2\x03\n" +
 	"\x0fStudentsService\x126\n" +
 	"\vGetStudents\x12\x17.main.GetStudentRequset\x1a\x0e.main.Students\x12-\n" +
 	"\vAddStudents\x12\x0e.main.Students\x1a\x0e.main.Students\x120\n" +
 	"\x0eUpdateStudents\x12\x0e.main.Students\x1a\x0e.main.Students\x12?\n" +
-	"\x0eDeleteStudents\x12\x10.main.StudentIds\x1a\x1b.main.DeleteStudentsConfirmB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+	"\x0eDeleteStudents\x12\x10.main.StudentIds\x1a\x1b.main.DeleteStudentsConfirm\x12A\n" +
+	"\x0fRestoreStudents\x12\x10.main.StudentIds\x1a\x1c.main.RestoreStudentsConfirm\x12A\n" +
+	"\rWatchStudents\x12\x1a.main.WatchStudentsRequest\x1a\x12.main.StudentEvent0\x01\x12;\n" +
+	"\x0eImportStudents\x12\x13.main.ImportRequest\x1a\x12.main.ImportReport(\x01\x12B\n" +
+	"\x0eExportStudents\x12\x1b.main.ExportStudentsRequest\x1a\x11.main.ExportChunk0\x01B\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_student_proto_rawDescOnce sync.Once
@@ -457,34 +1398,62 @@ func file_student_proto_rawDescGZIP() []byte {
 }
 
 var file_student_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_student_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_student_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_student_proto_goTypes = []any{
-	(Order)(0),                    // 0: main.Order
-	(*DeleteStudentsConfirm)(nil), // 1: main.DeleteStudentsConfirm
-	(*StudentIds)(nil),            // 2: main.StudentIds
-	(*GetStudentRequset)(nil),     // 3: main.GetStudentRequset
-	(*SortField)(nil),             // 4: main.SortField
-	(*Student)(nil),               // 5: main.Student
-	(*Students)(nil),              // 6: main.Students
+	(Order)(0),                     // 0: main.Order
+	(*DeleteStudentsConfirm)(nil),  // 1: main.DeleteStudentsConfirm
+	(*RestoreStudentsConfirm)(nil), // 2: main.RestoreStudentsConfirm
+	(*StudentIds)(nil),             // 3: main.StudentIds
+	(*GetStudentRequset)(nil),      // 4: main.GetStudentRequset
+	(*SortField)(nil),              // 5: main.SortField
+	(*Student)(nil),                // 6: main.Student
+	(*StudentGuardian)(nil),        // 7: main.StudentGuardian
+	(*Students)(nil),               // 8: main.Students
+	(*WatchStudentsRequest)(nil),   // 9: main.WatchStudentsRequest
+	(*StudentEvent)(nil),           // 10: main.StudentEvent
+	(*ImportRequest)(nil),          // 11: main.ImportRequest
+	(*ImportRowResult)(nil),        // 12: main.ImportRowResult
+	(*ImportReport)(nil),           // 13: main.ImportReport
+	(*ExportStudentsRequest)(nil),  // 14: main.ExportStudentsRequest
+	(*ExportOptions)(nil),          // 15: main.ExportOptions
+	(*ExportChunk)(nil),            // 16: main.ExportChunk
+	nil,                            // 17: main.ImportRequest.ColumnMappingEntry
+	nil,                            // 18: main.ImportRequest.RowEntry
 }
 var file_student_proto_depIdxs = []int32{
-	5, // 0: main.GetStudentRequset.student:type_name -> main.Student
-	4, // 1: main.GetStudentRequset.sort_by:type_name -> main.SortField
-	0, // 2: main.SortField.order:type_name -> main.Order
-	5, // 3: main.Students.students:type_name -> main.Student
-	3, // 4: main.StudentsService.GetStudents:input_type -> main.GetStudentRequset
-	6, // 5: main.StudentsService.AddStudents:input_type -> main.Students
-	6, // 6: main.StudentsService.UpdateStudents:input_type -> main.Students
-	2, // 7: main.StudentsService.DeleteStudents:input_type -> main.StudentIds
-	6, // 8: main.StudentsService.GetStudents:output_type -> main.Students
-	6, // 9: main.StudentsService.AddStudents:output_type -> main.Students
-	6, // 10: main.StudentsService.UpdateStudents:output_type -> main.Students
-	1, // 11: main.StudentsService.DeleteStudents:output_type -> main.DeleteStudentsConfirm
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: main.GetStudentRequset.student:type_name -> main.Student
+	5,  // 1: main.GetStudentRequset.sort_by:type_name -> main.SortField
+	0,  // 2: main.SortField.order:type_name -> main.Order
+	7,  // 3: main.Student.guardians:type_name -> main.StudentGuardian
+	6,  // 4: main.Students.students:type_name -> main.Student
+	6,  // 5: main.WatchStudentsRequest.student:type_name -> main.Student
+	6,  // 6: main.StudentEvent.student:type_name -> main.Student
+	17, // 7: main.ImportRequest.column_mapping:type_name -> main.ImportRequest.ColumnMappingEntry
+	18, // 8: main.ImportRequest.row:type_name -> main.ImportRequest.RowEntry
+	12, // 9: main.ImportReport.rows:type_name -> main.ImportRowResult
+	4,  // 10: main.ExportStudentsRequest.query:type_name -> main.GetStudentRequset
+	15, // 11: main.ExportStudentsRequest.options:type_name -> main.ExportOptions
+	4,  // 12: main.StudentsService.GetStudents:input_type -> main.GetStudentRequset
+	8,  // 13: main.StudentsService.AddStudents:input_type -> main.Students
+	8,  // 14: main.StudentsService.UpdateStudents:input_type -> main.Students
+	3,  // 15: main.StudentsService.DeleteStudents:input_type -> main.StudentIds
+	3,  // 16: main.StudentsService.RestoreStudents:input_type -> main.StudentIds
+	9,  // 17: main.StudentsService.WatchStudents:input_type -> main.WatchStudentsRequest
+	11, // 18: main.StudentsService.ImportStudents:input_type -> main.ImportRequest
+	14, // 19: main.StudentsService.ExportStudents:input_type -> main.ExportStudentsRequest
+	8,  // 20: main.StudentsService.GetStudents:output_type -> main.Students
+	8,  // 21: main.StudentsService.AddStudents:output_type -> main.Students
+	8,  // 22: main.StudentsService.UpdateStudents:output_type -> main.Students
+	1,  // 23: main.StudentsService.DeleteStudents:output_type -> main.DeleteStudentsConfirm
+	2,  // 24: main.StudentsService.RestoreStudents:output_type -> main.RestoreStudentsConfirm
+	10, // 25: main.StudentsService.WatchStudents:output_type -> main.StudentEvent
+	13, // 26: main.StudentsService.ImportStudents:output_type -> main.ImportReport
+	16, // 27: main.StudentsService.ExportStudents:output_type -> main.ExportChunk
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_student_proto_init() }
@@ -498,7 +1467,7 @@ func file_student_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_student_proto_rawDesc), len(file_student_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
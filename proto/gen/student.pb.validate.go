@@ -139,6 +139,110 @@ var _ interface {
 	ErrorName() string
 } = DeleteStudentsConfirmValidationError{}
 
+// Validate checks the field values on RestoreStudentsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreStudentsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreStudentsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreStudentsConfirmMultiError, or nil if none found.
+func (m *RestoreStudentsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreStudentsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RestoreStudentsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreStudentsConfirmMultiError is an error wrapping multiple validation
+// errors returned by RestoreStudentsConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreStudentsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreStudentsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreStudentsConfirmMultiError) AllErrors() []error { return m }
+
+// RestoreStudentsConfirmValidationError is the validation error returned by
+// RestoreStudentsConfirm.Validate if the designated constraints aren't met.
+type RestoreStudentsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreStudentsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreStudentsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreStudentsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreStudentsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreStudentsConfirmValidationError) ErrorName() string {
+	return "RestoreStudentsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreStudentsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreStudentsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreStudentsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreStudentsConfirmValidationError{}
+
 // Validate checks the field values on StudentIds with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -327,6 +431,27 @@ func (m *GetStudentRequset) validate(all bool) error {
 
 	// no validation rules for PageSize
 
+	// no validation rules for IncludeDeleted
+
+	if !_GetStudentRequset_Term_Pattern.MatchString(m.GetTerm()) {
+		err := GetStudentRequsetValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeGuardians
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotalSize
+
+	// no validation rules for Filter
+
 	if len(errors) > 0 {
 		return GetStudentRequsetMultiError(errors)
 	}
@@ -407,6 +532,8 @@ var _ interface {
 	ErrorName() string
 } = GetStudentRequsetValidationError{}
 
+var _GetStudentRequset_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
 // Validate checks the field values on SortField with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -578,6 +705,137 @@ func (m *Student) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if m.GetClassId() != "" {
+
+		if !_Student_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := StudentValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for GraduatedAt
+
+	for idx, item := range m.GetGuardians() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StudentValidationError{
+						field:  fmt.Sprintf("Guardians[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StudentValidationError{
+						field:  fmt.Sprintf("Guardians[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StudentValidationError{
+					field:  fmt.Sprintf("Guardians[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetDateOfBirth() != "" {
+
+		if !_Student_DateOfBirth_Pattern.MatchString(m.GetDateOfBirth()) {
+			err := StudentValidationError{
+				field:  "DateOfBirth",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _Student_Gender_InLookup[m.GetGender()]; !ok {
+		err := StudentValidationError{
+			field:  "Gender",
+			reason: "value must be in list [ female male other]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Address
+
+	if !_Student_AdmissionNumber_Pattern.MatchString(m.GetAdmissionNumber()) {
+		err := StudentValidationError{
+			field:  "AdmissionNumber",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9/-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Student_EnrollmentStatus_InLookup[m.GetEnrollmentStatus()]; !ok {
+		err := StudentValidationError{
+			field:  "EnrollmentStatus",
+			reason: "value must be in list [ active transferred graduated withdrawn]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAdmissionDate() != "" {
+
+		if !_Student_AdmissionDate_Pattern.MatchString(m.GetAdmissionDate()) {
+			err := StudentValidationError{
+				field:  "AdmissionDate",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetMedicalNotes()) > 2000 {
+		err := StudentValidationError{
+			field:  "MedicalNotes",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return StudentMultiError(errors)
 	}
@@ -711,75 +969,81 @@ var _Student_LastName_Pattern = regexp.MustCompile("^[A-Za-z ]*$")
 
 var _Student_Class_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
 
-// Validate checks the field values on Students with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Students) Validate() error {
+var _Student_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Student_DateOfBirth_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _Student_Gender_InLookup = map[string]struct{}{
+	"":       {},
+	"female": {},
+	"male":   {},
+	"other":  {},
+}
+
+var _Student_AdmissionNumber_Pattern = regexp.MustCompile("^[A-Za-z0-9/-]*$")
+
+var _Student_EnrollmentStatus_InLookup = map[string]struct{}{
+	"":            {},
+	"active":      {},
+	"transferred": {},
+	"graduated":   {},
+	"withdrawn":   {},
+}
+
+var _Student_AdmissionDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on StudentGuardian with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StudentGuardian) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Students with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StudentsMultiError, or nil
-// if none found.
-func (m *Students) ValidateAll() error {
+// ValidateAll checks the field values on StudentGuardian with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StudentGuardianMultiError, or nil if none found.
+func (m *StudentGuardian) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Students) validate(all bool) error {
+func (m *StudentGuardian) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStudents() {
-		_, _ = idx, item
+	// no validation rules for GuardianId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StudentsValidationError{
-						field:  fmt.Sprintf("Students[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StudentsValidationError{
-						field:  fmt.Sprintf("Students[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StudentsValidationError{
-					field:  fmt.Sprintf("Students[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for FirstName
 
-	}
+	// no validation rules for LastName
+
+	// no validation rules for Relationship
+
+	// no validation rules for Phone
+
+	// no validation rules for Email
+
+	// no validation rules for Address
+
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return StudentsMultiError(errors)
+		return StudentGuardianMultiError(errors)
 	}
 
 	return nil
 }
 
-// StudentsMultiError is an error wrapping multiple validation errors returned
-// by Students.ValidateAll() if the designated constraints aren't met.
-type StudentsMultiError []error
+// StudentGuardianMultiError is an error wrapping multiple validation errors
+// returned by StudentGuardian.ValidateAll() if the designated constraints
+// aren't met.
+type StudentGuardianMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StudentsMultiError) Error() string {
+func (m StudentGuardianMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -788,11 +1052,11 @@ func (m StudentsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StudentsMultiError) AllErrors() []error { return m }
+func (m StudentGuardianMultiError) AllErrors() []error { return m }
 
-// StudentsValidationError is the validation error returned by
-// Students.Validate if the designated constraints aren't met.
-type StudentsValidationError struct {
+// StudentGuardianValidationError is the validation error returned by
+// StudentGuardian.Validate if the designated constraints aren't met.
+type StudentGuardianValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -800,22 +1064,22 @@ type StudentsValidationError struct {
 }
 
 // Field function returns field value.
-func (e StudentsValidationError) Field() string { return e.field }
+func (e StudentGuardianValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StudentsValidationError) Reason() string { return e.reason }
+func (e StudentGuardianValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StudentsValidationError) Cause() error { return e.cause }
+func (e StudentGuardianValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StudentsValidationError) Key() bool { return e.key }
+func (e StudentGuardianValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StudentsValidationError) ErrorName() string { return "StudentsValidationError" }
+func (e StudentGuardianValidationError) ErrorName() string { return "StudentGuardianValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StudentsValidationError) Error() string {
+func (e StudentGuardianValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -827,14 +1091,14 @@ func (e StudentsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStudents.%s: %s%s",
+		"invalid %sStudentGuardian.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StudentsValidationError{}
+var _ error = StudentGuardianValidationError{}
 
 var _ interface {
 	Field() string
@@ -842,4 +1106,1152 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StudentsValidationError{}
+} = StudentGuardianValidationError{}
+
+// Validate checks the field values on Students with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Students) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Students with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StudentsMultiError, or nil
+// if none found.
+func (m *Students) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Students) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStudents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StudentsValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StudentsValidationError{
+						field:  fmt.Sprintf("Students[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StudentsValidationError{
+					field:  fmt.Sprintf("Students[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return StudentsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentsMultiError is an error wrapping multiple validation errors returned
+// by Students.ValidateAll() if the designated constraints aren't met.
+type StudentsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentsMultiError) AllErrors() []error { return m }
+
+// StudentsValidationError is the validation error returned by
+// Students.Validate if the designated constraints aren't met.
+type StudentsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentsValidationError) ErrorName() string { return "StudentsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudentsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudents.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentsValidationError{}
+
+// Validate checks the field values on WatchStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchStudentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchStudentsRequestMultiError, or nil if none found.
+func (m *WatchStudentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchStudentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStudent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchStudentsRequestValidationError{
+					field:  "Student",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchStudentsRequestValidationError{
+					field:  "Student",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStudent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchStudentsRequestValidationError{
+				field:  "Student",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchStudentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchStudentsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchStudentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchStudentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchStudentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchStudentsRequestMultiError) AllErrors() []error { return m }
+
+// WatchStudentsRequestValidationError is the validation error returned by
+// WatchStudentsRequest.Validate if the designated constraints aren't met.
+type WatchStudentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchStudentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchStudentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchStudentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchStudentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchStudentsRequestValidationError) ErrorName() string {
+	return "WatchStudentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchStudentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchStudentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchStudentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchStudentsRequestValidationError{}
+
+// Validate checks the field values on StudentEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StudentEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StudentEventMultiError, or
+// nil if none found.
+func (m *StudentEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetStudent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StudentEventValidationError{
+					field:  "Student",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StudentEventValidationError{
+					field:  "Student",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStudent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StudentEventValidationError{
+				field:  "Student",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	// no validation rules for OccurredAt
+
+	if len(errors) > 0 {
+		return StudentEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentEventMultiError is an error wrapping multiple validation errors
+// returned by StudentEvent.ValidateAll() if the designated constraints aren't met.
+type StudentEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentEventMultiError) AllErrors() []error { return m }
+
+// StudentEventValidationError is the validation error returned by
+// StudentEvent.Validate if the designated constraints aren't met.
+type StudentEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentEventValidationError) ErrorName() string { return "StudentEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudentEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentEventValidationError{}
+
+// Validate checks the field values on ImportRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportRequestMultiError, or
+// nil if none found.
+func (m *ImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	// no validation rules for ColumnMapping
+
+	// no validation rules for Row
+
+	// no validation rules for RowNumber
+
+	if len(errors) > 0 {
+		return ImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRequestMultiError) AllErrors() []error { return m }
+
+// ImportRequestValidationError is the validation error returned by
+// ImportRequest.Validate if the designated constraints aren't met.
+type ImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRequestValidationError) ErrorName() string { return "ImportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRequestValidationError{}
+
+// Validate checks the field values on ImportRowResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportRowResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRowResultMultiError, or nil if none found.
+func (m *ImportRowResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RowNumber
+
+	// no validation rules for Status
+
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ImportRowResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowResultMultiError is an error wrapping multiple validation errors
+// returned by ImportRowResult.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowResultMultiError) AllErrors() []error { return m }
+
+// ImportRowResultValidationError is the validation error returned by
+// ImportRowResult.Validate if the designated constraints aren't met.
+type ImportRowResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowResultValidationError) ErrorName() string { return "ImportRowResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowResultValidationError{}
+
+// Validate checks the field values on ImportReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportReportMultiError, or
+// nil if none found.
+func (m *ImportReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	// no validation rules for Total
+
+	// no validation rules for Imported
+
+	// no validation rules for Valid
+
+	// no validation rules for Rejected
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportReportValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportReportValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportReportValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportReportMultiError is an error wrapping multiple validation errors
+// returned by ImportReport.ValidateAll() if the designated constraints aren't met.
+type ImportReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportReportMultiError) AllErrors() []error { return m }
+
+// ImportReportValidationError is the validation error returned by
+// ImportReport.Validate if the designated constraints aren't met.
+type ImportReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportReportValidationError) ErrorName() string { return "ImportReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportReportValidationError{}
+
+// Validate checks the field values on ExportStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportStudentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportStudentsRequestMultiError, or nil if none found.
+func (m *ExportStudentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportStudentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportStudentsRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportStudentsRequestValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportStudentsRequestValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportStudentsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportStudentsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportStudentsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportStudentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportStudentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportStudentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportStudentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportStudentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportStudentsRequestMultiError) AllErrors() []error { return m }
+
+// ExportStudentsRequestValidationError is the validation error returned by
+// ExportStudentsRequest.Validate if the designated constraints aren't met.
+type ExportStudentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportStudentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportStudentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportStudentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportStudentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportStudentsRequestValidationError) ErrorName() string {
+	return "ExportStudentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportStudentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportStudentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportStudentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportStudentsRequestValidationError{}
+
+// Validate checks the field values on ExportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportOptionsMultiError, or
+// nil if none found.
+func (m *ExportOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportOptions_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportOptionsValidationError{
+			field:  "Format",
+			reason: "value must be in list [ csv jsonl xlsx]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportOptionsMultiError is an error wrapping multiple validation errors
+// returned by ExportOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ExportOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportOptionsMultiError) AllErrors() []error { return m }
+
+// ExportOptionsValidationError is the validation error returned by
+// ExportOptions.Validate if the designated constraints aren't met.
+type ExportOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOptionsValidationError) ErrorName() string { return "ExportOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOptionsValidationError{}
+
+var _ExportOptions_Format_InLookup = map[string]struct{}{
+	"":      {},
+	"csv":   {},
+	"jsonl": {},
+	"xlsx":  {},
+}
+
+// Validate checks the field values on ExportChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportChunkMultiError, or
+// nil if none found.
+func (m *ExportChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ExportChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportChunkMultiError is an error wrapping multiple validation errors
+// returned by ExportChunk.ValidateAll() if the designated constraints aren't met.
+type ExportChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportChunkMultiError) AllErrors() []error { return m }
+
+// ExportChunkValidationError is the validation error returned by
+// ExportChunk.Validate if the designated constraints aren't met.
+type ExportChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportChunkValidationError) ErrorName() string { return "ExportChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportChunkValidationError{}
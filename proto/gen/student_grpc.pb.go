@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StudentsService_GetStudents_FullMethodName    = "/main.StudentsService/GetStudents"
-	StudentsService_AddStudents_FullMethodName    = "/main.StudentsService/AddStudents"
-	StudentsService_UpdateStudents_FullMethodName = "/main.StudentsService/UpdateStudents"
-	StudentsService_DeleteStudents_FullMethodName = "/main.StudentsService/DeleteStudents"
+	StudentsService_GetStudents_FullMethodName     = "/main.StudentsService/GetStudents"
+	StudentsService_AddStudents_FullMethodName     = "/main.StudentsService/AddStudents"
+	StudentsService_UpdateStudents_FullMethodName  = "/main.StudentsService/UpdateStudents"
+	StudentsService_DeleteStudents_FullMethodName  = "/main.StudentsService/DeleteStudents"
+	StudentsService_RestoreStudents_FullMethodName = "/main.StudentsService/RestoreStudents"
+	StudentsService_WatchStudents_FullMethodName   = "/main.StudentsService/WatchStudents"
+	StudentsService_ImportStudents_FullMethodName  = "/main.StudentsService/ImportStudents"
+	StudentsService_ExportStudents_FullMethodName  = "/main.StudentsService/ExportStudents"
 )
 
 // StudentsServiceClient is the client API for StudentsService service.
@@ -33,6 +37,13 @@ type StudentsServiceClient interface {
 	AddStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error)
 	UpdateStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error)
 	DeleteStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*DeleteStudentsConfirm, error)
+	RestoreStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*RestoreStudentsConfirm, error)
+	// streams the changes of the students matching the filter until the client disconnects
+	WatchStudents(ctx context.Context, in *WatchStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StudentEvent], error)
+	// imports the rows of a csv or json file, students whose email is already taken are skipped
+	ImportStudents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportReport], error)
+	// streams the students matching the query as a csv, jsonl or xlsx file, the paging of the query is ignored
+	ExportStudents(ctx context.Context, in *ExportStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 }
 
 type studentsServiceClient struct {
@@ -83,6 +94,67 @@ func (c *studentsServiceClient) DeleteStudents(ctx context.Context, in *StudentI
 	return out, nil
 }
 
+func (c *studentsServiceClient) RestoreStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*RestoreStudentsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreStudentsConfirm)
+	err := c.cc.Invoke(ctx, StudentsService_RestoreStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentsServiceClient) WatchStudents(ctx context.Context, in *WatchStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StudentEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StudentsService_ServiceDesc.Streams[0], StudentsService_WatchStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStudentsRequest, StudentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsService_WatchStudentsClient = grpc.ServerStreamingClient[StudentEvent]
+
+func (c *studentsServiceClient) ImportStudents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StudentsService_ServiceDesc.Streams[1], StudentsService_ImportStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ImportReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsService_ImportStudentsClient = grpc.ClientStreamingClient[ImportRequest, ImportReport]
+
+func (c *studentsServiceClient) ExportStudents(ctx context.Context, in *ExportStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StudentsService_ServiceDesc.Streams[2], StudentsService_ExportStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportStudentsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsService_ExportStudentsClient = grpc.ServerStreamingClient[ExportChunk]
+
 // StudentsServiceServer is the server API for StudentsService service.
 // All implementations must embed UnimplementedStudentsServiceServer
 // for forward compatibility.
@@ -91,6 +163,13 @@ type StudentsServiceServer interface {
 	AddStudents(context.Context, *Students) (*Students, error)
 	UpdateStudents(context.Context, *Students) (*Students, error)
 	DeleteStudents(context.Context, *StudentIds) (*DeleteStudentsConfirm, error)
+	RestoreStudents(context.Context, *StudentIds) (*RestoreStudentsConfirm, error)
+	// streams the changes of the students matching the filter until the client disconnects
+	WatchStudents(*WatchStudentsRequest, grpc.ServerStreamingServer[StudentEvent]) error
+	// imports the rows of a csv or json file, students whose email is already taken are skipped
+	ImportStudents(grpc.ClientStreamingServer[ImportRequest, ImportReport]) error
+	// streams the students matching the query as a csv, jsonl or xlsx file, the paging of the query is ignored
+	ExportStudents(*ExportStudentsRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	mustEmbedUnimplementedStudentsServiceServer()
 }
 
@@ -113,6 +192,18 @@ func (UnimplementedStudentsServiceServer) UpdateStudents(context.Context, *Stude
 func (UnimplementedStudentsServiceServer) DeleteStudents(context.Context, *StudentIds) (*DeleteStudentsConfirm, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudents not implemented")
 }
+func (UnimplementedStudentsServiceServer) RestoreStudents(context.Context, *StudentIds) (*RestoreStudentsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStudents not implemented")
+}
+func (UnimplementedStudentsServiceServer) WatchStudents(*WatchStudentsRequest, grpc.ServerStreamingServer[StudentEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStudents not implemented")
+}
+func (UnimplementedStudentsServiceServer) ImportStudents(grpc.ClientStreamingServer[ImportRequest, ImportReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportStudents not implemented")
+}
+func (UnimplementedStudentsServiceServer) ExportStudents(*ExportStudentsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStudents not implemented")
+}
 func (UnimplementedStudentsServiceServer) mustEmbedUnimplementedStudentsServiceServer() {}
 func (UnimplementedStudentsServiceServer) testEmbeddedByValue()                         {}
 
@@ -206,6 +297,53 @@ func _StudentsService_DeleteStudents_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentsService_RestoreStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentsServiceServer).RestoreStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentsService_RestoreStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentsServiceServer).RestoreStudents(ctx, req.(*StudentIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentsService_WatchStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStudentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudentsServiceServer).WatchStudents(m, &grpc.GenericServerStream[WatchStudentsRequest, StudentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsService_WatchStudentsServer = grpc.ServerStreamingServer[StudentEvent]
+
+func _StudentsService_ImportStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StudentsServiceServer).ImportStudents(&grpc.GenericServerStream[ImportRequest, ImportReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsService_ImportStudentsServer = grpc.ClientStreamingServer[ImportRequest, ImportReport]
+
+func _StudentsService_ExportStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStudentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudentsServiceServer).ExportStudents(m, &grpc.GenericServerStream[ExportStudentsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsService_ExportStudentsServer = grpc.ServerStreamingServer[ExportChunk]
+
 // StudentsService_ServiceDesc is the grpc.ServiceDesc for StudentsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +367,27 @@ var StudentsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStudents",
 			Handler:    _StudentsService_DeleteStudents_Handler,
 		},
+		{
+			MethodName: "RestoreStudents",
+			Handler:    _StudentsService_RestoreStudents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStudents",
+			Handler:       _StudentsService_WatchStudents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportStudents",
+			Handler:       _StudentsService_ImportStudents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportStudents",
+			Handler:       _StudentsService_ExportStudents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "student.proto",
 }
@@ -0,0 +1,944 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: timetable.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a weekly session of a course. day is 1 (monday) to 7 (sunday).
+// either period or start_time and end_time (HH:MM) are given, a period gets its times from the configured periods.
+// teacher_id, class_id and term are copied from the course.
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Period        int32                  `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Room          string                 `protobuf:"bytes,7,opt,name=room,proto3" json:"room,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,8,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,9,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Term          string                 `protobuf:"bytes,10,opt,name=term,proto3" json:"term,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,12,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_timetable_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Session) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *Session) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *Session) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Session) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Session) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *Session) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Session) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Session) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Session) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Session) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type Sessions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sessions) Reset() {
+	*x = Sessions{}
+	mi := &file_timetable_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sessions) ProtoMessage() {}
+
+func (x *Sessions) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sessions.ProtoReflect.Descriptor instead.
+func (*Sessions) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Sessions) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SessionIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionIds    []string               `protobuf:"bytes,1,rep,name=sessionIds,proto3" json:"sessionIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionIds) Reset() {
+	*x = SessionIds{}
+	mi := &file_timetable_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionIds) ProtoMessage() {}
+
+func (x *SessionIds) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionIds.ProtoReflect.Descriptor instead.
+func (*SessionIds) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SessionIds) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+type DeleteSessionsConfirm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionsConfirm) Reset() {
+	*x = DeleteSessionsConfirm{}
+	mi := &file_timetable_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionsConfirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionsConfirm) ProtoMessage() {}
+
+func (x *DeleteSessionsConfirm) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionsConfirm.ProtoReflect.Descriptor instead.
+func (*DeleteSessionsConfirm) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteSessionsConfirm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteSessionsConfirm) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GetSessionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Session        *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	SortBy         []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNum        uint32                 `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_timetable_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSessionRequest) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *GetSessionRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetSessionRequest) GetPageNum() uint32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetSessionRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSessionRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// id is a teacher or class id, term defaults to the current term
+type WeekRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeekRequest) Reset() {
+	*x = WeekRequest{}
+	mi := &file_timetable_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekRequest) ProtoMessage() {}
+
+func (x *WeekRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekRequest.ProtoReflect.Descriptor instead.
+func (*WeekRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WeekRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WeekRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type Week struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Days          []*WeekDay             `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Week) Reset() {
+	*x = Week{}
+	mi := &file_timetable_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Week) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Week) ProtoMessage() {}
+
+func (x *Week) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Week.ProtoReflect.Descriptor instead.
+func (*Week) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Week) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Week) GetDays() []*WeekDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// the sessions of a day ordered by start time
+type WeekDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Sessions      []*Session             `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeekDay) Reset() {
+	*x = WeekDay{}
+	mi := &file_timetable_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekDay) ProtoMessage() {}
+
+func (x *WeekDay) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekDay.ProtoReflect.Descriptor instead.
+func (*WeekDay) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WeekDay) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *WeekDay) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// the rooms without a session at the time, either period or start_time and end_time are given
+type FreeRoomsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Period        int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Term          string                 `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeRoomsRequest) Reset() {
+	*x = FreeRoomsRequest{}
+	mi := &file_timetable_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeRoomsRequest) ProtoMessage() {}
+
+func (x *FreeRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeRoomsRequest.ProtoReflect.Descriptor instead.
+func (*FreeRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FreeRoomsRequest) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *FreeRoomsRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *FreeRoomsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *FreeRoomsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *FreeRoomsRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type FreeRooms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []string               `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeRooms) Reset() {
+	*x = FreeRooms{}
+	mi := &file_timetable_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeRooms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeRooms) ProtoMessage() {}
+
+func (x *FreeRooms) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeRooms.ProtoReflect.Descriptor instead.
+func (*FreeRooms) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FreeRooms) GetRooms() []string {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// exactly one of teacher_id, class_id or room, term defaults to the current term
+type CalendarExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Room          string                 `protobuf:"bytes,3,opt,name=room,proto3" json:"room,omitempty"`
+	Term          string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarExportRequest) Reset() {
+	*x = CalendarExportRequest{}
+	mi := &file_timetable_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarExportRequest) ProtoMessage() {}
+
+func (x *CalendarExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarExportRequest.ProtoReflect.Descriptor instead.
+func (*CalendarExportRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CalendarExportRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CalendarExportRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *CalendarExportRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CalendarExportRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type CalendarFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFile) Reset() {
+	*x = CalendarFile{}
+	mi := &file_timetable_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFile) ProtoMessage() {}
+
+func (x *CalendarFile) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFile.ProtoReflect.Descriptor instead.
+func (*CalendarFile) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CalendarFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CalendarFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CalendarFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_timetable_proto protoreflect.FileDescriptor
+
+const file_timetable_proto_rawDesc = "" +
+	"\n" +
+	"\x0ftimetable.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\rstudent.proto\"\xda\x03\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\tcourse_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\bcourseId\x12\x1b\n" +
+	"\x03day\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\a(\x00R\x03day\x12\x1f\n" +
+	"\x06period\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06period\x12H\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tB)\xfaB&r$2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$\xd0\x01\x01R\tstartTime\x12D\n" +
+	"\bend_time\x18\x06 \x01(\tB)\xfaB&r$2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$\xd0\x01\x01R\aendTime\x12+\n" +
+	"\x04room\x18\a \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04room\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\b \x01(\tR\tteacherId\x12\x19\n" +
+	"\bclass_id\x18\t \x01(\tR\aclassId\x12\x12\n" +
+	"\x04term\x18\n" +
+	" \x01(\tR\x04term\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\f \x01(\tR\tdeletedBy\"5\n" +
+	"\bSessions\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.main.SessionR\bsessions\"6\n" +
+	"\n" +
+	"SessionIds\x12(\n" +
+	"\n" +
+	"sessionIds\x18\x01 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
+	"sessionIds\"P\n" +
+	"\x15DeleteSessionsConfirm\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xc7\x01\n" +
+	"\x11GetSessionRequest\x12'\n" +
+	"\asession\x18\x01 \x01(\v2\r.main.SessionR\asession\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\rR\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"h\n" +
+	"\vWeekRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12+\n" +
+	"\x04term\x18\x02 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\"=\n" +
+	"\x04Week\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12!\n" +
+	"\x04days\x18\x02 \x03(\v2\r.main.WeekDayR\x04days\"F\n" +
+	"\aWeekDay\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12)\n" +
+	"\bsessions\x18\x02 \x03(\v2\r.main.SessionR\bsessions\"\x8d\x02\n" +
+	"\x10FreeRoomsRequest\x12\x1b\n" +
+	"\x03day\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\a(\x01R\x03day\x12\x1f\n" +
+	"\x06period\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06period\x12H\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tB)\xfaB&r$2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$\xd0\x01\x01R\tstartTime\x12D\n" +
+	"\bend_time\x18\x04 \x01(\tB)\xfaB&r$2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$\xd0\x01\x01R\aendTime\x12+\n" +
+	"\x04term\x18\x05 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\"!\n" +
+	"\tFreeRooms\x12\x14\n" +
+	"\x05rooms\x18\x01 \x03(\tR\x05rooms\"\xe5\x01\n" +
+	"\x15CalendarExportRequest\x12:\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tteacherId\x126\n" +
+	"\bclass_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12+\n" +
+	"\x04room\x18\x03 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04room\x12+\n" +
+	"\x04term\x18\x04 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04term\"h\n" +
+	"\fCalendarFile\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent2\xc8\x03\n" +
+	"\x10TimetableService\x126\n" +
+	"\vGetSessions\x12\x17.main.GetSessionRequest\x1a\x0e.main.Sessions\x12-\n" +
+	"\vAddSessions\x12\x0e.main.Sessions\x1a\x0e.main.Sessions\x120\n" +
+	"\x0eUpdateSessions\x12\x0e.main.Sessions\x1a\x0e.main.Sessions\x12?\n" +
+	"\x0eDeleteSessions\x12\x10.main.SessionIds\x1a\x1b.main.DeleteSessionsConfirm\x12/\n" +
+	"\x0eGetTeacherWeek\x12\x11.main.WeekRequest\x1a\n" +
+	".main.Week\x12-\n" +
+	"\fGetClassWeek\x12\x11.main.WeekRequest\x1a\n" +
+	".main.Week\x127\n" +
+	"\fGetFreeRooms\x12\x16.main.FreeRoomsRequest\x1a\x0f.main.FreeRooms\x12A\n" +
+	"\x0eExportCalendar\x12\x1b.main.CalendarExportRequest\x1a\x12.main.CalendarFileB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_timetable_proto_rawDescOnce sync.Once
+	file_timetable_proto_rawDescData []byte
+)
+
+func file_timetable_proto_rawDescGZIP() []byte {
+	file_timetable_proto_rawDescOnce.Do(func() {
+		file_timetable_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_timetable_proto_rawDesc), len(file_timetable_proto_rawDesc)))
+	})
+	return file_timetable_proto_rawDescData
+}
+
+var file_timetable_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_timetable_proto_goTypes = []any{
+	(*Session)(nil),               // 0: main.Session
+	(*Sessions)(nil),              // 1: main.Sessions
+	(*SessionIds)(nil),            // 2: main.SessionIds
+	(*DeleteSessionsConfirm)(nil), // 3: main.DeleteSessionsConfirm
+	(*GetSessionRequest)(nil),     // 4: main.GetSessionRequest
+	(*WeekRequest)(nil),           // 5: main.WeekRequest
+	(*Week)(nil),                  // 6: main.Week
+	(*WeekDay)(nil),               // 7: main.WeekDay
+	(*FreeRoomsRequest)(nil),      // 8: main.FreeRoomsRequest
+	(*FreeRooms)(nil),             // 9: main.FreeRooms
+	(*CalendarExportRequest)(nil), // 10: main.CalendarExportRequest
+	(*CalendarFile)(nil),          // 11: main.CalendarFile
+	(*SortField)(nil),             // 12: main.SortField
+}
+var file_timetable_proto_depIdxs = []int32{
+	0,  // 0: main.Sessions.sessions:type_name -> main.Session
+	0,  // 1: main.GetSessionRequest.session:type_name -> main.Session
+	12, // 2: main.GetSessionRequest.sort_by:type_name -> main.SortField
+	7,  // 3: main.Week.days:type_name -> main.WeekDay
+	0,  // 4: main.WeekDay.sessions:type_name -> main.Session
+	4,  // 5: main.TimetableService.GetSessions:input_type -> main.GetSessionRequest
+	1,  // 6: main.TimetableService.AddSessions:input_type -> main.Sessions
+	1,  // 7: main.TimetableService.UpdateSessions:input_type -> main.Sessions
+	2,  // 8: main.TimetableService.DeleteSessions:input_type -> main.SessionIds
+	5,  // 9: main.TimetableService.GetTeacherWeek:input_type -> main.WeekRequest
+	5,  // 10: main.TimetableService.GetClassWeek:input_type -> main.WeekRequest
+	8,  // 11: main.TimetableService.GetFreeRooms:input_type -> main.FreeRoomsRequest
+	10, // 12: main.TimetableService.ExportCalendar:input_type -> main.CalendarExportRequest
+	1,  // 13: main.TimetableService.GetSessions:output_type -> main.Sessions
+	1,  // 14: main.TimetableService.AddSessions:output_type -> main.Sessions
+	1,  // 15: main.TimetableService.UpdateSessions:output_type -> main.Sessions
+	3,  // 16: main.TimetableService.DeleteSessions:output_type -> main.DeleteSessionsConfirm
+	6,  // 17: main.TimetableService.GetTeacherWeek:output_type -> main.Week
+	6,  // 18: main.TimetableService.GetClassWeek:output_type -> main.Week
+	9,  // 19: main.TimetableService.GetFreeRooms:output_type -> main.FreeRooms
+	11, // 20: main.TimetableService.ExportCalendar:output_type -> main.CalendarFile
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_timetable_proto_init() }
+func file_timetable_proto_init() {
+	if File_timetable_proto != nil {
+		return
+	}
+	file_student_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timetable_proto_rawDesc), len(file_timetable_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timetable_proto_goTypes,
+		DependencyIndexes: file_timetable_proto_depIdxs,
+		MessageInfos:      file_timetable_proto_msgTypes,
+	}.Build()
+	File_timetable_proto = out.File
+	file_timetable_proto_goTypes = nil
+	file_timetable_proto_depIdxs = nil
+}
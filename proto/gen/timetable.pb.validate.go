@@ -0,0 +1,1690 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: timetable.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetCourseId() != "" {
+
+		if !_Session_CourseId_Pattern.MatchString(m.GetCourseId()) {
+			err := SessionValidationError{
+				field:  "CourseId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetDay(); val < 0 || val > 7 {
+		err := SessionValidationError{
+			field:  "Day",
+			reason: "value must be inside range [0, 7]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPeriod() < 0 {
+		err := SessionValidationError{
+			field:  "Period",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() != "" {
+
+		if !_Session_StartTime_Pattern.MatchString(m.GetStartTime()) {
+			err := SessionValidationError{
+				field:  "StartTime",
+				reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndTime() != "" {
+
+		if !_Session_EndTime_Pattern.MatchString(m.GetEndTime()) {
+			err := SessionValidationError{
+				field:  "EndTime",
+				reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_Session_Room_Pattern.MatchString(m.GetRoom()) {
+		err := SessionValidationError{
+			field:  "Room",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TeacherId
+
+	// no validation rules for ClassId
+
+	// no validation rules for Term
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+var _Session_CourseId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Session_StartTime_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _Session_EndTime_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _Session_Room_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on Sessions with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Sessions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Sessions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionsMultiError, or nil
+// if none found.
+func (m *Sessions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Sessions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SessionsValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SessionsValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SessionsValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SessionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionsMultiError is an error wrapping multiple validation errors returned
+// by Sessions.ValidateAll() if the designated constraints aren't met.
+type SessionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionsMultiError) AllErrors() []error { return m }
+
+// SessionsValidationError is the validation error returned by
+// Sessions.Validate if the designated constraints aren't met.
+type SessionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionsValidationError) ErrorName() string { return "SessionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionsValidationError{}
+
+// Validate checks the field values on SessionIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionIdsMultiError, or
+// nil if none found.
+func (m *SessionIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSessionIds()) < 1 {
+		err := SessionIdsValidationError{
+			field:  "SessionIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SessionIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionIdsMultiError is an error wrapping multiple validation errors
+// returned by SessionIds.ValidateAll() if the designated constraints aren't met.
+type SessionIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionIdsMultiError) AllErrors() []error { return m }
+
+// SessionIdsValidationError is the validation error returned by
+// SessionIds.Validate if the designated constraints aren't met.
+type SessionIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionIdsValidationError) ErrorName() string { return "SessionIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionIdsValidationError{}
+
+// Validate checks the field values on DeleteSessionsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionsConfirm with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionsConfirmMultiError, or nil if none found.
+func (m *DeleteSessionsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteSessionsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionsConfirmMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionsConfirm.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteSessionsConfirmValidationError is the validation error returned by
+// DeleteSessionsConfirm.Validate if the designated constraints aren't met.
+type DeleteSessionsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionsConfirmValidationError) ErrorName() string {
+	return "DeleteSessionsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionsConfirmValidationError{}
+
+// Validate checks the field values on GetSessionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSessionRequestMultiError, or nil if none found.
+func (m *GetSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSessionRequestValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSessionRequestValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSessionRequestValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSessionRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSessionRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSessionRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSessionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSessionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSessionRequestMultiError) AllErrors() []error { return m }
+
+// GetSessionRequestValidationError is the validation error returned by
+// GetSessionRequest.Validate if the designated constraints aren't met.
+type GetSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSessionRequestValidationError) ErrorName() string {
+	return "GetSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSessionRequestValidationError{}
+
+// Validate checks the field values on WeekRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WeekRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WeekRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WeekRequestMultiError, or
+// nil if none found.
+func (m *WeekRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WeekRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := WeekRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_WeekRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := WeekRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WeekRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := WeekRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WeekRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeekRequestMultiError is an error wrapping multiple validation errors
+// returned by WeekRequest.ValidateAll() if the designated constraints aren't met.
+type WeekRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeekRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeekRequestMultiError) AllErrors() []error { return m }
+
+// WeekRequestValidationError is the validation error returned by
+// WeekRequest.Validate if the designated constraints aren't met.
+type WeekRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeekRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeekRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeekRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeekRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeekRequestValidationError) ErrorName() string { return "WeekRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeekRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeekRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeekRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeekRequestValidationError{}
+
+var _WeekRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _WeekRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on Week with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Week) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Week with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WeekMultiError, or nil if none found.
+func (m *Week) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Week) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Term
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WeekValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WeekValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WeekValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WeekMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeekMultiError is an error wrapping multiple validation errors returned by
+// Week.ValidateAll() if the designated constraints aren't met.
+type WeekMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeekMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeekMultiError) AllErrors() []error { return m }
+
+// WeekValidationError is the validation error returned by Week.Validate if the
+// designated constraints aren't met.
+type WeekValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeekValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeekValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeekValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeekValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeekValidationError) ErrorName() string { return "WeekValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeekValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeek.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeekValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeekValidationError{}
+
+// Validate checks the field values on WeekDay with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WeekDay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WeekDay with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WeekDayMultiError, or nil if none found.
+func (m *WeekDay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WeekDay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WeekDayValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WeekDayValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WeekDayValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WeekDayMultiError(errors)
+	}
+
+	return nil
+}
+
+// WeekDayMultiError is an error wrapping multiple validation errors returned
+// by WeekDay.ValidateAll() if the designated constraints aren't met.
+type WeekDayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WeekDayMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WeekDayMultiError) AllErrors() []error { return m }
+
+// WeekDayValidationError is the validation error returned by WeekDay.Validate
+// if the designated constraints aren't met.
+type WeekDayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WeekDayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WeekDayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WeekDayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WeekDayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WeekDayValidationError) ErrorName() string { return "WeekDayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WeekDayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWeekDay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WeekDayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WeekDayValidationError{}
+
+// Validate checks the field values on FreeRoomsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FreeRoomsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreeRoomsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreeRoomsRequestMultiError, or nil if none found.
+func (m *FreeRoomsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreeRoomsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetDay(); val < 1 || val > 7 {
+		err := FreeRoomsRequestValidationError{
+			field:  "Day",
+			reason: "value must be inside range [1, 7]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPeriod() < 0 {
+		err := FreeRoomsRequestValidationError{
+			field:  "Period",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() != "" {
+
+		if !_FreeRoomsRequest_StartTime_Pattern.MatchString(m.GetStartTime()) {
+			err := FreeRoomsRequestValidationError{
+				field:  "StartTime",
+				reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndTime() != "" {
+
+		if !_FreeRoomsRequest_EndTime_Pattern.MatchString(m.GetEndTime()) {
+			err := FreeRoomsRequestValidationError{
+				field:  "EndTime",
+				reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_FreeRoomsRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := FreeRoomsRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FreeRoomsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreeRoomsRequestMultiError is an error wrapping multiple validation errors
+// returned by FreeRoomsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FreeRoomsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreeRoomsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreeRoomsRequestMultiError) AllErrors() []error { return m }
+
+// FreeRoomsRequestValidationError is the validation error returned by
+// FreeRoomsRequest.Validate if the designated constraints aren't met.
+type FreeRoomsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreeRoomsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreeRoomsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreeRoomsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreeRoomsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreeRoomsRequestValidationError) ErrorName() string { return "FreeRoomsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreeRoomsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreeRoomsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreeRoomsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreeRoomsRequestValidationError{}
+
+var _FreeRoomsRequest_StartTime_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _FreeRoomsRequest_EndTime_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _FreeRoomsRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on FreeRooms with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FreeRooms) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreeRooms with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FreeRoomsMultiError, or nil
+// if none found.
+func (m *FreeRooms) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreeRooms) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FreeRoomsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreeRoomsMultiError is an error wrapping multiple validation errors returned
+// by FreeRooms.ValidateAll() if the designated constraints aren't met.
+type FreeRoomsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreeRoomsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreeRoomsMultiError) AllErrors() []error { return m }
+
+// FreeRoomsValidationError is the validation error returned by
+// FreeRooms.Validate if the designated constraints aren't met.
+type FreeRoomsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreeRoomsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreeRoomsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreeRoomsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreeRoomsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreeRoomsValidationError) ErrorName() string { return "FreeRoomsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreeRoomsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreeRooms.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreeRoomsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreeRoomsValidationError{}
+
+// Validate checks the field values on CalendarExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalendarExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalendarExportRequestMultiError, or nil if none found.
+func (m *CalendarExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTeacherId() != "" {
+
+		if !_CalendarExportRequest_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+			err := CalendarExportRequestValidationError{
+				field:  "TeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClassId() != "" {
+
+		if !_CalendarExportRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := CalendarExportRequestValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_CalendarExportRequest_Room_Pattern.MatchString(m.GetRoom()) {
+		err := CalendarExportRequestValidationError{
+			field:  "Room",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CalendarExportRequest_Term_Pattern.MatchString(m.GetTerm()) {
+		err := CalendarExportRequestValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CalendarExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarExportRequestMultiError is an error wrapping multiple validation
+// errors returned by CalendarExportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CalendarExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarExportRequestMultiError) AllErrors() []error { return m }
+
+// CalendarExportRequestValidationError is the validation error returned by
+// CalendarExportRequest.Validate if the designated constraints aren't met.
+type CalendarExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarExportRequestValidationError) ErrorName() string {
+	return "CalendarExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalendarExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarExportRequestValidationError{}
+
+var _CalendarExportRequest_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _CalendarExportRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _CalendarExportRequest_Room_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _CalendarExportRequest_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on CalendarFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalendarFile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarFile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalendarFileMultiError, or
+// nil if none found.
+func (m *CalendarFile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarFile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return CalendarFileMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarFileMultiError is an error wrapping multiple validation errors
+// returned by CalendarFile.ValidateAll() if the designated constraints aren't met.
+type CalendarFileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarFileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarFileMultiError) AllErrors() []error { return m }
+
+// CalendarFileValidationError is the validation error returned by
+// CalendarFile.Validate if the designated constraints aren't met.
+type CalendarFileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarFileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarFileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarFileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarFileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarFileValidationError) ErrorName() string { return "CalendarFileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarFileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarFileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarFileValidationError{}
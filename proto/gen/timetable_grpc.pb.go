@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: timetable.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimetableService_GetSessions_FullMethodName    = "/main.TimetableService/GetSessions"
+	TimetableService_AddSessions_FullMethodName    = "/main.TimetableService/AddSessions"
+	TimetableService_UpdateSessions_FullMethodName = "/main.TimetableService/UpdateSessions"
+	TimetableService_DeleteSessions_FullMethodName = "/main.TimetableService/DeleteSessions"
+	TimetableService_GetTeacherWeek_FullMethodName = "/main.TimetableService/GetTeacherWeek"
+	TimetableService_GetClassWeek_FullMethodName   = "/main.TimetableService/GetClassWeek"
+	TimetableService_GetFreeRooms_FullMethodName   = "/main.TimetableService/GetFreeRooms"
+	TimetableService_ExportCalendar_FullMethodName = "/main.TimetableService/ExportCalendar"
+)
+
+// TimetableServiceClient is the client API for TimetableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimetableServiceClient interface {
+	GetSessions(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Sessions, error)
+	AddSessions(ctx context.Context, in *Sessions, opts ...grpc.CallOption) (*Sessions, error)
+	UpdateSessions(ctx context.Context, in *Sessions, opts ...grpc.CallOption) (*Sessions, error)
+	DeleteSessions(ctx context.Context, in *SessionIds, opts ...grpc.CallOption) (*DeleteSessionsConfirm, error)
+	GetTeacherWeek(ctx context.Context, in *WeekRequest, opts ...grpc.CallOption) (*Week, error)
+	GetClassWeek(ctx context.Context, in *WeekRequest, opts ...grpc.CallOption) (*Week, error)
+	GetFreeRooms(ctx context.Context, in *FreeRoomsRequest, opts ...grpc.CallOption) (*FreeRooms, error)
+	ExportCalendar(ctx context.Context, in *CalendarExportRequest, opts ...grpc.CallOption) (*CalendarFile, error)
+}
+
+type timetableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimetableServiceClient(cc grpc.ClientConnInterface) TimetableServiceClient {
+	return &timetableServiceClient{cc}
+}
+
+func (c *timetableServiceClient) GetSessions(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Sessions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, TimetableService_GetSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) AddSessions(ctx context.Context, in *Sessions, opts ...grpc.CallOption) (*Sessions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, TimetableService_AddSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) UpdateSessions(ctx context.Context, in *Sessions, opts ...grpc.CallOption) (*Sessions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, TimetableService_UpdateSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) DeleteSessions(ctx context.Context, in *SessionIds, opts ...grpc.CallOption) (*DeleteSessionsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSessionsConfirm)
+	err := c.cc.Invoke(ctx, TimetableService_DeleteSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetTeacherWeek(ctx context.Context, in *WeekRequest, opts ...grpc.CallOption) (*Week, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Week)
+	err := c.cc.Invoke(ctx, TimetableService_GetTeacherWeek_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetClassWeek(ctx context.Context, in *WeekRequest, opts ...grpc.CallOption) (*Week, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Week)
+	err := c.cc.Invoke(ctx, TimetableService_GetClassWeek_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetFreeRooms(ctx context.Context, in *FreeRoomsRequest, opts ...grpc.CallOption) (*FreeRooms, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreeRooms)
+	err := c.cc.Invoke(ctx, TimetableService_GetFreeRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ExportCalendar(ctx context.Context, in *CalendarExportRequest, opts ...grpc.CallOption) (*CalendarFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFile)
+	err := c.cc.Invoke(ctx, TimetableService_ExportCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimetableServiceServer is the server API for TimetableService service.
+// All implementations must embed UnimplementedTimetableServiceServer
+// for forward compatibility.
+type TimetableServiceServer interface {
+	GetSessions(context.Context, *GetSessionRequest) (*Sessions, error)
+	AddSessions(context.Context, *Sessions) (*Sessions, error)
+	UpdateSessions(context.Context, *Sessions) (*Sessions, error)
+	DeleteSessions(context.Context, *SessionIds) (*DeleteSessionsConfirm, error)
+	GetTeacherWeek(context.Context, *WeekRequest) (*Week, error)
+	GetClassWeek(context.Context, *WeekRequest) (*Week, error)
+	GetFreeRooms(context.Context, *FreeRoomsRequest) (*FreeRooms, error)
+	ExportCalendar(context.Context, *CalendarExportRequest) (*CalendarFile, error)
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+// UnimplementedTimetableServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimetableServiceServer struct{}
+
+func (UnimplementedTimetableServiceServer) GetSessions(context.Context, *GetSessionRequest) (*Sessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedTimetableServiceServer) AddSessions(context.Context, *Sessions) (*Sessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessions not implemented")
+}
+func (UnimplementedTimetableServiceServer) UpdateSessions(context.Context, *Sessions) (*Sessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSessions not implemented")
+}
+func (UnimplementedTimetableServiceServer) DeleteSessions(context.Context, *SessionIds) (*DeleteSessionsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessions not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetTeacherWeek(context.Context, *WeekRequest) (*Week, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherWeek not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetClassWeek(context.Context, *WeekRequest) (*Week, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassWeek not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetFreeRooms(context.Context, *FreeRoomsRequest) (*FreeRooms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreeRooms not implemented")
+}
+func (UnimplementedTimetableServiceServer) ExportCalendar(context.Context, *CalendarExportRequest) (*CalendarFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCalendar not implemented")
+}
+func (UnimplementedTimetableServiceServer) mustEmbedUnimplementedTimetableServiceServer() {}
+func (UnimplementedTimetableServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTimetableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimetableServiceServer will
+// result in compilation errors.
+type UnsafeTimetableServiceServer interface {
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+func RegisterTimetableServiceServer(s grpc.ServiceRegistrar, srv TimetableServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimetableServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimetableService_ServiceDesc, srv)
+}
+
+func _TimetableService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetSessions(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_AddSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Sessions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).AddSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_AddSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).AddSessions(ctx, req.(*Sessions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_UpdateSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Sessions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).UpdateSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_UpdateSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).UpdateSessions(ctx, req.(*Sessions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_DeleteSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).DeleteSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_DeleteSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).DeleteSessions(ctx, req.(*SessionIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetTeacherWeek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WeekRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetTeacherWeek(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetTeacherWeek_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetTeacherWeek(ctx, req.(*WeekRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetClassWeek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WeekRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetClassWeek(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetClassWeek_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetClassWeek(ctx, req.(*WeekRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetFreeRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreeRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetFreeRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetFreeRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetFreeRooms(ctx, req.(*FreeRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ExportCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ExportCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ExportCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ExportCalendar(ctx, req.(*CalendarExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimetableService_ServiceDesc is the grpc.ServiceDesc for TimetableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimetableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.TimetableService",
+	HandlerType: (*TimetableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSessions",
+			Handler:    _TimetableService_GetSessions_Handler,
+		},
+		{
+			MethodName: "AddSessions",
+			Handler:    _TimetableService_AddSessions_Handler,
+		},
+		{
+			MethodName: "UpdateSessions",
+			Handler:    _TimetableService_UpdateSessions_Handler,
+		},
+		{
+			MethodName: "DeleteSessions",
+			Handler:    _TimetableService_DeleteSessions_Handler,
+		},
+		{
+			MethodName: "GetTeacherWeek",
+			Handler:    _TimetableService_GetTeacherWeek_Handler,
+		},
+		{
+			MethodName: "GetClassWeek",
+			Handler:    _TimetableService_GetClassWeek_Handler,
+		},
+		{
+			MethodName: "GetFreeRooms",
+			Handler:    _TimetableService_GetFreeRooms_Handler,
+		},
+		{
+			MethodName: "ExportCalendar",
+			Handler:    _TimetableService_ExportCalendar_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timetable.proto",
+}
@@ -0,0 +1,1507 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: webhook.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WebhookEndpoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookEndpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookEndpoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookEndpointMultiError, or nil if none found.
+func (m *WebhookEndpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookEndpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := WebhookEndpointValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUrl() != "" {
+
+		if uri, err := url.Parse(m.GetUrl()); err != nil {
+			err = WebhookEndpointValidationError{
+				field:  "Url",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := WebhookEndpointValidationError{
+				field:  "Url",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Secret
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for DeletedBy
+
+	if len(errors) > 0 {
+		return WebhookEndpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookEndpointMultiError is an error wrapping multiple validation errors
+// returned by WebhookEndpoint.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookEndpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookEndpointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookEndpointMultiError) AllErrors() []error { return m }
+
+// WebhookEndpointValidationError is the validation error returned by
+// WebhookEndpoint.Validate if the designated constraints aren't met.
+type WebhookEndpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookEndpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookEndpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookEndpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookEndpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookEndpointValidationError) ErrorName() string { return "WebhookEndpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookEndpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookEndpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookEndpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookEndpointValidationError{}
+
+// Validate checks the field values on WebhookEndpoints with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookEndpoints) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookEndpoints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookEndpointsMultiError, or nil if none found.
+func (m *WebhookEndpoints) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookEndpoints) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEndpoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookEndpointsValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookEndpointsValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookEndpointsValidationError{
+					field:  fmt.Sprintf("Endpoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WebhookEndpointsMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookEndpointsMultiError is an error wrapping multiple validation errors
+// returned by WebhookEndpoints.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookEndpointsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookEndpointsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookEndpointsMultiError) AllErrors() []error { return m }
+
+// WebhookEndpointsValidationError is the validation error returned by
+// WebhookEndpoints.Validate if the designated constraints aren't met.
+type WebhookEndpointsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookEndpointsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookEndpointsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookEndpointsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookEndpointsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookEndpointsValidationError) ErrorName() string { return "WebhookEndpointsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookEndpointsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookEndpoints.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookEndpointsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookEndpointsValidationError{}
+
+// Validate checks the field values on WebhookEndpointIds with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookEndpointIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookEndpointIds with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookEndpointIdsMultiError, or nil if none found.
+func (m *WebhookEndpointIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookEndpointIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetEndpointIds()) < 1 {
+		err := WebhookEndpointIdsValidationError{
+			field:  "EndpointIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WebhookEndpointIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookEndpointIdsMultiError is an error wrapping multiple validation errors
+// returned by WebhookEndpointIds.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookEndpointIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookEndpointIdsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookEndpointIdsMultiError) AllErrors() []error { return m }
+
+// WebhookEndpointIdsValidationError is the validation error returned by
+// WebhookEndpointIds.Validate if the designated constraints aren't met.
+type WebhookEndpointIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookEndpointIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookEndpointIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookEndpointIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookEndpointIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookEndpointIdsValidationError) ErrorName() string {
+	return "WebhookEndpointIdsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookEndpointIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookEndpointIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookEndpointIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookEndpointIdsValidationError{}
+
+// Validate checks the field values on DeleteWebhookEndpointsConfirm with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookEndpointsConfirm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookEndpointsConfirm with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteWebhookEndpointsConfirmMultiError, or nil if none found.
+func (m *DeleteWebhookEndpointsConfirm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookEndpointsConfirm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteWebhookEndpointsConfirmMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookEndpointsConfirmMultiError is an error wrapping multiple
+// validation errors returned by DeleteWebhookEndpointsConfirm.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteWebhookEndpointsConfirmMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookEndpointsConfirmMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookEndpointsConfirmMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookEndpointsConfirmValidationError is the validation error
+// returned by DeleteWebhookEndpointsConfirm.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookEndpointsConfirmValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookEndpointsConfirmValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookEndpointsConfirmValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookEndpointsConfirmValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookEndpointsConfirmValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookEndpointsConfirmValidationError) ErrorName() string {
+	return "DeleteWebhookEndpointsConfirmValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookEndpointsConfirmValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookEndpointsConfirm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookEndpointsConfirmValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookEndpointsConfirmValidationError{}
+
+// Validate checks the field values on GetWebhookEndpointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookEndpointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookEndpointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWebhookEndpointsRequestMultiError, or nil if none found.
+func (m *GetWebhookEndpointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookEndpointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEndpoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWebhookEndpointsRequestValidationError{
+					field:  "Endpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWebhookEndpointsRequestValidationError{
+					field:  "Endpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndpoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWebhookEndpointsRequestValidationError{
+				field:  "Endpoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWebhookEndpointsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWebhookEndpointsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWebhookEndpointsRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetWebhookEndpointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookEndpointsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookEndpointsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetWebhookEndpointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookEndpointsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookEndpointsRequestMultiError) AllErrors() []error { return m }
+
+// GetWebhookEndpointsRequestValidationError is the validation error returned
+// by GetWebhookEndpointsRequest.Validate if the designated constraints aren't met.
+type GetWebhookEndpointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookEndpointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookEndpointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookEndpointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookEndpointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookEndpointsRequestValidationError) ErrorName() string {
+	return "GetWebhookEndpointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookEndpointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookEndpointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookEndpointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookEndpointsRequestValidationError{}
+
+// Validate checks the field values on RotateWebhookSecretRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateWebhookSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateWebhookSecretRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateWebhookSecretRequestMultiError, or nil if none found.
+func (m *RotateWebhookSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateWebhookSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := RotateWebhookSecretRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_RotateWebhookSecretRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := RotateWebhookSecretRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateWebhookSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateWebhookSecretRequestMultiError is an error wrapping multiple
+// validation errors returned by RotateWebhookSecretRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RotateWebhookSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateWebhookSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateWebhookSecretRequestMultiError) AllErrors() []error { return m }
+
+// RotateWebhookSecretRequestValidationError is the validation error returned
+// by RotateWebhookSecretRequest.Validate if the designated constraints aren't met.
+type RotateWebhookSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateWebhookSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateWebhookSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateWebhookSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateWebhookSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateWebhookSecretRequestValidationError) ErrorName() string {
+	return "RotateWebhookSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateWebhookSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateWebhookSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateWebhookSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateWebhookSecretRequestValidationError{}
+
+var _RotateWebhookSecretRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on WebhookAttempt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookAttempt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookAttempt with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookAttemptMultiError,
+// or nil if none found.
+func (m *WebhookAttempt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookAttempt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AttemptedAt
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Error
+
+	// no validation rules for DurationMs
+
+	if len(errors) > 0 {
+		return WebhookAttemptMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookAttemptMultiError is an error wrapping multiple validation errors
+// returned by WebhookAttempt.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookAttemptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookAttemptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookAttemptMultiError) AllErrors() []error { return m }
+
+// WebhookAttemptValidationError is the validation error returned by
+// WebhookAttempt.Validate if the designated constraints aren't met.
+type WebhookAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookAttemptValidationError) ErrorName() string { return "WebhookAttemptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookAttemptValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EndpointId
+
+	// no validation rules for EventId
+
+	// no validation rules for Event
+
+	// no validation rules for Payload
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for NextAttemptAt
+
+	// no validation rules for LastError
+
+	// no validation rules for DeliveredAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for ReplayOf
+
+	for idx, item := range m.GetAttemptLog() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookDeliveryValidationError{
+						field:  fmt.Sprintf("AttemptLog[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookDeliveryValidationError{
+						field:  fmt.Sprintf("AttemptLog[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookDeliveryValidationError{
+					field:  fmt.Sprintf("AttemptLog[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on WebhookDeliveries with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDeliveries) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDeliveries with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveriesMultiError, or nil if none found.
+func (m *WebhookDeliveries) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDeliveries) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookDeliveriesValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookDeliveriesValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookDeliveriesValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveriesMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveriesMultiError is an error wrapping multiple validation errors
+// returned by WebhookDeliveries.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveriesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveriesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveriesMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveriesValidationError is the validation error returned by
+// WebhookDeliveries.Validate if the designated constraints aren't met.
+type WebhookDeliveriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveriesValidationError) ErrorName() string {
+	return "WebhookDeliveriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDeliveries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveriesValidationError{}
+
+// Validate checks the field values on ListDeliveriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeliveriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeliveriesRequestMultiError, or nil if none found.
+func (m *ListDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEndpointId() != "" {
+
+		if !_ListDeliveriesRequest_EndpointId_Pattern.MatchString(m.GetEndpointId()) {
+			err := ListDeliveriesRequestValidationError{
+				field:  "EndpointId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _ListDeliveriesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListDeliveriesRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ pending delivering delivered failed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Event
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListDeliveriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeliveriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeliveriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeliveriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeliveriesRequestValidationError is the validation error returned by
+// ListDeliveriesRequest.Validate if the designated constraints aren't met.
+type ListDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeliveriesRequestValidationError) ErrorName() string {
+	return "ListDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeliveriesRequestValidationError{}
+
+var _ListDeliveriesRequest_EndpointId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ListDeliveriesRequest_Status_InLookup = map[string]struct{}{
+	"":           {},
+	"pending":    {},
+	"delivering": {},
+	"delivered":  {},
+	"failed":     {},
+}
+
+// Validate checks the field values on ReplayDeliveryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeliveryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeliveryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeliveryRequestMultiError, or nil if none found.
+func (m *ReplayDeliveryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeliveryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := ReplayDeliveryRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ReplayDeliveryRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := ReplayDeliveryRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReplayDeliveryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeliveryRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeliveryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeliveryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeliveryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeliveryRequestMultiError) AllErrors() []error { return m }
+
+// ReplayDeliveryRequestValidationError is the validation error returned by
+// ReplayDeliveryRequest.Validate if the designated constraints aren't met.
+type ReplayDeliveryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeliveryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeliveryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeliveryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeliveryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeliveryRequestValidationError) ErrorName() string {
+	return "ReplayDeliveryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeliveryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeliveryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeliveryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeliveryRequestValidationError{}
+
+var _ReplayDeliveryRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.5
+// source: webhook.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WebhooksService_GetWebhookEndpoints_FullMethodName    = "/main.WebhooksService/GetWebhookEndpoints"
+	WebhooksService_AddWebhookEndpoints_FullMethodName    = "/main.WebhooksService/AddWebhookEndpoints"
+	WebhooksService_UpdateWebhookEndpoints_FullMethodName = "/main.WebhooksService/UpdateWebhookEndpoints"
+	WebhooksService_DeleteWebhookEndpoints_FullMethodName = "/main.WebhooksService/DeleteWebhookEndpoints"
+	WebhooksService_RotateWebhookSecret_FullMethodName    = "/main.WebhooksService/RotateWebhookSecret"
+	WebhooksService_ListDeliveries_FullMethodName         = "/main.WebhooksService/ListDeliveries"
+	WebhooksService_ReplayDelivery_FullMethodName         = "/main.WebhooksService/ReplayDelivery"
+)
+
+// WebhooksServiceClient is the client API for WebhooksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// endpoints of other systems that get the changes of students, teachers, classes, subjects, courses and guardians
+type WebhooksServiceClient interface {
+	GetWebhookEndpoints(ctx context.Context, in *GetWebhookEndpointsRequest, opts ...grpc.CallOption) (*WebhookEndpoints, error)
+	AddWebhookEndpoints(ctx context.Context, in *WebhookEndpoints, opts ...grpc.CallOption) (*WebhookEndpoints, error)
+	UpdateWebhookEndpoints(ctx context.Context, in *WebhookEndpoints, opts ...grpc.CallOption) (*WebhookEndpoints, error)
+	DeleteWebhookEndpoints(ctx context.Context, in *WebhookEndpointIds, opts ...grpc.CallOption) (*DeleteWebhookEndpointsConfirm, error)
+	RotateWebhookSecret(ctx context.Context, in *RotateWebhookSecretRequest, opts ...grpc.CallOption) (*WebhookEndpoint, error)
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveries, error)
+	ReplayDelivery(ctx context.Context, in *ReplayDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+}
+
+type webhooksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhooksServiceClient(cc grpc.ClientConnInterface) WebhooksServiceClient {
+	return &webhooksServiceClient{cc}
+}
+
+func (c *webhooksServiceClient) GetWebhookEndpoints(ctx context.Context, in *GetWebhookEndpointsRequest, opts ...grpc.CallOption) (*WebhookEndpoints, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookEndpoints)
+	err := c.cc.Invoke(ctx, WebhooksService_GetWebhookEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) AddWebhookEndpoints(ctx context.Context, in *WebhookEndpoints, opts ...grpc.CallOption) (*WebhookEndpoints, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookEndpoints)
+	err := c.cc.Invoke(ctx, WebhooksService_AddWebhookEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) UpdateWebhookEndpoints(ctx context.Context, in *WebhookEndpoints, opts ...grpc.CallOption) (*WebhookEndpoints, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookEndpoints)
+	err := c.cc.Invoke(ctx, WebhooksService_UpdateWebhookEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) DeleteWebhookEndpoints(ctx context.Context, in *WebhookEndpointIds, opts ...grpc.CallOption) (*DeleteWebhookEndpointsConfirm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookEndpointsConfirm)
+	err := c.cc.Invoke(ctx, WebhooksService_DeleteWebhookEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) RotateWebhookSecret(ctx context.Context, in *RotateWebhookSecretRequest, opts ...grpc.CallOption) (*WebhookEndpoint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookEndpoint)
+	err := c.cc.Invoke(ctx, WebhooksService_RotateWebhookSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveries)
+	err := c.cc.Invoke(ctx, WebhooksService_ListDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) ReplayDelivery(ctx context.Context, in *ReplayDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, WebhooksService_ReplayDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhooksServiceServer is the server API for WebhooksService service.
+// All implementations must embed UnimplementedWebhooksServiceServer
+// for forward compatibility.
+//
+// endpoints of other systems that get the changes of students, teachers, classes, subjects, courses and guardians
+type WebhooksServiceServer interface {
+	GetWebhookEndpoints(context.Context, *GetWebhookEndpointsRequest) (*WebhookEndpoints, error)
+	AddWebhookEndpoints(context.Context, *WebhookEndpoints) (*WebhookEndpoints, error)
+	UpdateWebhookEndpoints(context.Context, *WebhookEndpoints) (*WebhookEndpoints, error)
+	DeleteWebhookEndpoints(context.Context, *WebhookEndpointIds) (*DeleteWebhookEndpointsConfirm, error)
+	RotateWebhookSecret(context.Context, *RotateWebhookSecretRequest) (*WebhookEndpoint, error)
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*WebhookDeliveries, error)
+	ReplayDelivery(context.Context, *ReplayDeliveryRequest) (*WebhookDelivery, error)
+	mustEmbedUnimplementedWebhooksServiceServer()
+}
+
+// UnimplementedWebhooksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebhooksServiceServer struct{}
+
+func (UnimplementedWebhooksServiceServer) GetWebhookEndpoints(context.Context, *GetWebhookEndpointsRequest) (*WebhookEndpoints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookEndpoints not implemented")
+}
+func (UnimplementedWebhooksServiceServer) AddWebhookEndpoints(context.Context, *WebhookEndpoints) (*WebhookEndpoints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhookEndpoints not implemented")
+}
+func (UnimplementedWebhooksServiceServer) UpdateWebhookEndpoints(context.Context, *WebhookEndpoints) (*WebhookEndpoints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhookEndpoints not implemented")
+}
+func (UnimplementedWebhooksServiceServer) DeleteWebhookEndpoints(context.Context, *WebhookEndpointIds) (*DeleteWebhookEndpointsConfirm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookEndpoints not implemented")
+}
+func (UnimplementedWebhooksServiceServer) RotateWebhookSecret(context.Context, *RotateWebhookSecretRequest) (*WebhookEndpoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateWebhookSecret not implemented")
+}
+func (UnimplementedWebhooksServiceServer) ListDeliveries(context.Context, *ListDeliveriesRequest) (*WebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (UnimplementedWebhooksServiceServer) ReplayDelivery(context.Context, *ReplayDeliveryRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDelivery not implemented")
+}
+func (UnimplementedWebhooksServiceServer) mustEmbedUnimplementedWebhooksServiceServer() {}
+func (UnimplementedWebhooksServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWebhooksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhooksServiceServer will
+// result in compilation errors.
+type UnsafeWebhooksServiceServer interface {
+	mustEmbedUnimplementedWebhooksServiceServer()
+}
+
+func RegisterWebhooksServiceServer(s grpc.ServiceRegistrar, srv WebhooksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWebhooksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WebhooksService_ServiceDesc, srv)
+}
+
+func _WebhooksService_GetWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).GetWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_GetWebhookEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).GetWebhookEndpoints(ctx, req.(*GetWebhookEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_AddWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookEndpoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).AddWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_AddWebhookEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).AddWebhookEndpoints(ctx, req.(*WebhookEndpoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_UpdateWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookEndpoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).UpdateWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_UpdateWebhookEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).UpdateWebhookEndpoints(ctx, req.(*WebhookEndpoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_DeleteWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookEndpointIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).DeleteWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_DeleteWebhookEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).DeleteWebhookEndpoints(ctx, req.(*WebhookEndpointIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_RotateWebhookSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateWebhookSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).RotateWebhookSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_RotateWebhookSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).RotateWebhookSecret(ctx, req.(*RotateWebhookSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_ReplayDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).ReplayDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_ReplayDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).ReplayDelivery(ctx, req.(*ReplayDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhooksService_ServiceDesc is the grpc.ServiceDesc for WebhooksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhooksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.WebhooksService",
+	HandlerType: (*WebhooksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWebhookEndpoints",
+			Handler:    _WebhooksService_GetWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "AddWebhookEndpoints",
+			Handler:    _WebhooksService_AddWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "UpdateWebhookEndpoints",
+			Handler:    _WebhooksService_UpdateWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookEndpoints",
+			Handler:    _WebhooksService_DeleteWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "RotateWebhookSecret",
+			Handler:    _WebhooksService_RotateWebhookSecret_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _WebhooksService_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayDelivery",
+			Handler:    _WebhooksService_ReplayDelivery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "webhook.proto",
+}